@@ -1,8 +1,13 @@
 package chinaip
 
+//go:generate go run .. chinaip update -o db.bin
+
 import (
+	_ "embed"
+	"net/netip"
 	"strconv"
 	"strings"
+	"sync/atomic"
 )
 
 type Error string
@@ -30,23 +35,48 @@ func IP2Int(ip string) (uint32, error) {
 	return ret, nil
 }
 
+// dbBlob is the builtin China IP list in the binary format of Set.
+//
+//go:embed db.bin
+var dbBlob []byte
+
+// db stores the *Set currently used by Contains and Lookup.
+var db atomic.Value
+
+func init() {
+	s := &Set{}
+	if err := s.UnmarshalBinary(dbBlob); err != nil {
+		panic("chinaip: corrupted builtin database: " + err.Error())
+	}
+	db.Store(s)
+}
+
+// Current returns the China IP database in use.
+func Current() *Set {
+	return db.Load().(*Set)
+}
+
+// Replace replaces the China IP database in use, e.g. with a newer list.
+func Replace(s *Set) {
+	db.Store(s)
+}
+
+// Contains returns whether an IPv4 address belongs to China.
+// It does not allocate.
+func Contains(addr netip.Addr) bool {
+	return Current().Contains(addr)
+}
+
+// Lookup returns the largest China prefix which contains `addr`.
+func Lookup(addr netip.Addr) (netip.Prefix, bool) {
+	return Current().Lookup(addr)
+}
+
 // IsChinaIP returns whether an IPv4 address belongs to China
 func IsChinaIP(ip string) bool {
-	var i, err = IP2Int(ip)
+	addr, err := netip.ParseAddr(ip)
 	if err != nil {
 		return false
 	}
-	var l = 0
-	var r = len(chinaIPs) - 1
-	for l <= r {
-		var mid = int((l + r) / 2)
-		if i < chinaIPs[mid][0] {
-			r = mid - 1
-		} else if i > chinaIPs[mid][1] {
-			l = mid + 1
-		} else {
-			return true
-		}
-	}
-	return false
+	return Contains(addr)
 }
@@ -0,0 +1,34 @@
+package chinaip
+
+import "net/netip"
+
+// Classifier decides whether an address is local, i.e. whether the fast
+// upstream can be trusted for the domains resolving to it.
+type Classifier interface {
+	Contains(addr netip.Addr) bool
+}
+
+// ClassifierFunc is an adapter to allow the use of ordinary functions as
+// Classifier, e.g. ClassifierFunc(chinaip.Contains).
+type ClassifierFunc func(addr netip.Addr) bool
+
+// Contains calls f(addr).
+func (f ClassifierFunc) Contains(addr netip.Addr) bool {
+	return f(addr)
+}
+
+type union []Classifier
+
+// Union returns a Classifier containing the addresses in any of `cs`.
+func Union(cs ...Classifier) Classifier {
+	return union(cs)
+}
+
+func (u union) Contains(addr netip.Addr) bool {
+	for _, c := range u {
+		if c.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
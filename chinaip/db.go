@@ -1,6070 +0,0 @@
-package chinaip
-
-var chinaIPs = [][]uint32{
-	{16777472, 16777727},     // 1.0.1.0/24
-	{16777728, 16778239},     // 1.0.2.0/23
-	{16779264, 16781311},     // 1.0.8.0/21
-	{16785408, 16793599},     // 1.0.32.0/19
-	{16842752, 16843007},     // 1.1.0.0/24
-	{16843264, 16843775},     // 1.1.2.0/23
-	{16843776, 16844799},     // 1.1.4.0/22
-	{16844800, 16846847},     // 1.1.8.0/21
-	{16846848, 16850943},     // 1.1.16.0/20
-	{16850944, 16859135},     // 1.1.32.0/19
-	{16908288, 16908799},     // 1.2.0.0/23
-	{16908800, 16909055},     // 1.2.2.0/24
-	{16909312, 16910335},     // 1.2.4.0/22
-	{16910336, 16912383},     // 1.2.8.0/21
-	{16912384, 16916479},     // 1.2.16.0/20
-	{16916480, 16924671},     // 1.2.32.0/19
-	{16924672, 16941055},     // 1.2.64.0/18
-	{16973824, 17039359},     // 1.3.0.0/16
-	{17039616, 17039871},     // 1.4.1.0/24
-	{17039872, 17040383},     // 1.4.2.0/23
-	{17040384, 17041407},     // 1.4.4.0/22
-	{17041408, 17043455},     // 1.4.8.0/21
-	{17043456, 17047551},     // 1.4.16.0/20
-	{17047552, 17055743},     // 1.4.32.0/19
-	{17055744, 17072127},     // 1.4.64.0/18
-	{17301504, 17367039},     // 1.8.0.0/16
-	{17432576, 17434623},     // 1.10.0.0/21
-	{17434624, 17435135},     // 1.10.8.0/23
-	{17435392, 17435647},     // 1.10.11.0/24
-	{17435648, 17436671},     // 1.10.12.0/22
-	{17436672, 17440767},     // 1.10.16.0/20
-	{17440768, 17448959},     // 1.10.32.0/19
-	{17448960, 17465343},     // 1.10.64.0/18
-	{17563648, 17825791},     // 1.12.0.0/14
-	{17989632, 17989887},     // 1.18.128.0/24
-	{18350080, 18874367},     // 1.24.0.0/13
-	{19726336, 19791871},     // 1.45.0.0/16
-	{19922944, 20185087},     // 1.48.0.0/14
-	{20447232, 20971519},     // 1.56.0.0/13
-	{21233664, 21495807},     // 1.68.0.0/14
-	{22020096, 23068671},     // 1.80.0.0/12
-	{24379392, 24510463},     // 1.116.0.0/15
-	{24510720, 24510975},     // 1.118.1.0/24
-	{24510976, 24511487},     // 1.118.2.0/23
-	{24511488, 24512511},     // 1.118.4.0/22
-	{24512512, 24514559},     // 1.118.8.0/21
-	{24514560, 24518655},     // 1.118.16.0/20
-	{24518912, 24519167},     // 1.118.33.0/24
-	{24519168, 24519679},     // 1.118.34.0/23
-	{24519680, 24520703},     // 1.118.36.0/22
-	{24520704, 24522751},     // 1.118.40.0/21
-	{24522752, 24526847},     // 1.118.48.0/20
-	{24526848, 24543231},     // 1.118.64.0/18
-	{24543232, 24575999},     // 1.118.128.0/17
-	{24576000, 24641535},     // 1.119.0.0/16
-	{28573696, 28835839},     // 1.180.0.0/14
-	{28835840, 28966911},     // 1.184.0.0/15
-	{29097984, 29360127},     // 1.188.0.0/14
-	{29360128, 29884415},     // 1.192.0.0/13
-	{30015488, 30146559},     // 1.202.0.0/15
-	{30146560, 30408703},     // 1.204.0.0/14
-	{50714112, 50714623},     // 3.5.214.0/23
-	{142606336, 146800639},     // 8.128.0.0/10
-	{234881024, 234883071},     // 14.0.0.0/21
-	{234884096, 234885119},     // 14.0.12.0/22
-	{234946560, 234947583},     // 14.1.0.0/22
-	{234952704, 234953727},     // 14.1.24.0/22
-	{234974208, 234975231},     // 14.1.108.0/22
-	{235929600, 236978175},     // 14.16.0.0/12
-	{241598464, 241599487},     // 14.102.128.0/22
-	{241611776, 241612799},     // 14.102.180.0/22
-	{241631232, 241696767},     // 14.103.0.0/16
-	{241696768, 242221055},     // 14.104.0.0/13
-	{242221056, 243269631},     // 14.112.0.0/12
-	{243400704, 243531775},     // 14.130.0.0/15
-	{243662848, 243793919},     // 14.134.0.0/15
-	{244318208, 245366783},     // 14.144.0.0/12
-	{247479296, 247480319},     // 14.192.60.0/22
-	{247483392, 247484415},     // 14.192.76.0/22
-	{247726080, 247857151},     // 14.196.0.0/15
-	{248250368, 248381439},     // 14.204.0.0/15
-	{248512512, 249561087},     // 14.208.0.0/12
-	{266742016, 266742271},     // 15.230.41.0/24
-	{266744064, 266744319},     // 15.230.49.0/24
-	{266767616, 266767871},     // 15.230.141.0/24
-	{344694784, 344698879},     // 20.139.160.0/20
-	{351928064, 351928319},     // 20.249.255.0/24
-	{351993856, 351994879},     // 20.251.0.0/22
-	{453017600, 453018623},     // 27.0.128.0/22
-	{453018624, 453018879},     // 27.0.132.0/24
-	{453019136, 453019647},     // 27.0.134.0/23
-	{453025792, 453027839},     // 27.0.160.0/21
-	{453032960, 453033983},     // 27.0.188.0/22
-	{453037056, 453038079},     // 27.0.204.0/22
-	{453038080, 453040127},     // 27.0.208.0/21
-	{453509120, 454033407},     // 27.8.0.0/13
-	{454033408, 455081983},     // 27.16.0.0/12
-	{455272448, 455274495},     // 27.34.232.0/21
-	{455344128, 455606271},     // 27.36.0.0/14
-	{455606272, 456130559},     // 27.40.0.0/13
-	{456271872, 456273919},     // 27.50.40.0/21
-	{456294400, 456327167},     // 27.50.128.0/17
-	{456542208, 456544255},     // 27.54.72.0/21
-	{456562688, 456564735},     // 27.54.152.0/21
-	{456572928, 456589311},     // 27.54.192.0/18
-	{459460608, 459464703},     // 27.98.208.0/20
-	{459464704, 459472895},     // 27.98.224.0/19
-	{459505664, 459538431},     // 27.99.128.0/17
-	{459735040, 459800575},     // 27.103.0.0/16
-	{459964416, 459980799},     // 27.106.128.0/18
-	{459983872, 459984895},     // 27.106.204.0/22
-	{460136448, 460144639},     // 27.109.32.0/19
-	{460160000, 460161023},     // 27.109.124.0/22
-	{460324864, 460341247},     // 27.112.0.0/18
-	{460345344, 460349439},     // 27.112.80.0/20
-	{460353536, 460355583},     // 27.112.112.0/21
-	{460423168, 460439551},     // 27.113.128.0/18
-	{460521472, 460554239},     // 27.115.0.0/17
-	{460598272, 460599295},     // 27.116.44.0/22
-	{460933120, 460935167},     // 27.121.72.0/21
-	{460945408, 460947455},     // 27.121.120.0/21
-	{461373440, 461504511},     // 27.128.0.0/15
-	{461626368, 461627391},     // 27.131.220.0/22
-	{462422016, 462487551},     // 27.144.0.0/16
-	{462684160, 462946303},     // 27.148.0.0/14
-	{462946304, 463470591},     // 27.152.0.0/13
-	{465043456, 465567743},     // 27.184.0.0/13
-	{465567744, 467664895},     // 27.192.0.0/11
-	{467664896, 467927039},     // 27.224.0.0/14
-	{603979776, 603980799},     // 36.0.0.0/22
-	{603983872, 603987967},     // 36.0.16.0/20
-	{603987968, 603996159},     // 36.0.32.0/19
-	{603996160, 604012543},     // 36.0.64.0/18
-	{604012544, 604045311},     // 36.0.128.0/17
-	{604045312, 604110847},     // 36.1.0.0/16
-	{604241920, 604504063},     // 36.4.0.0/14
-	{605028352, 606076927},     // 36.16.0.0/12
-	{606076928, 606339071},     // 36.32.0.0/14
-	{606339072, 606404607},     // 36.36.0.0/16
-	{606404608, 606412799},     // 36.37.0.0/19
-	{606413824, 606414335},     // 36.37.36.0/23
-	{606414592, 606414847},     // 36.37.39.0/24
-	{606414848, 606416895},     // 36.37.40.0/21
-	{606416896, 606420991},     // 36.37.48.0/20
-	{606601216, 607125503},     // 36.40.0.0/13
-	{607125504, 607256575},     // 36.48.0.0/15
-	{607322112, 607354879},     // 36.51.0.0/17
-	{607354880, 607371263},     // 36.51.128.0/18
-	{607371264, 607379455},     // 36.51.192.0/19
-	{607379456, 607383551},     // 36.51.224.0/20
-	{607383552, 607385599},     // 36.51.240.0/21
-	{607385600, 607385855},     // 36.51.248.0/24
-	{607386112, 607386623},     // 36.51.250.0/23
-	{607386624, 607387135},     // 36.51.252.0/23
-	{607649792, 608174079},     // 36.56.0.0/13
-	{610271232, 612368383},     // 36.96.0.0/11
-	{612368384, 616562687},     // 36.128.0.0/10
-	{616562688, 618659839},     // 36.192.0.0/11
-	{620232704, 620494847},     // 36.248.0.0/14
-	{620625920, 620691455},     // 36.254.0.0/16
-	{620721152, 620722175},     // 36.255.116.0/22
-	{620724224, 620725247},     // 36.255.128.0/22
-	{620733440, 620734463},     // 36.255.164.0/22
-	{620735488, 620736511},     // 36.255.172.0/22
-	{620736512, 620737535},     // 36.255.176.0/22
-	{646901618, 646901619},     // 38.142.239.114/31
-	{654311424, 654311679},     // 39.0.0.0/24
-	{654311936, 654312447},     // 39.0.2.0/23
-	{654312448, 654313471},     // 39.0.4.0/22
-	{654313472, 654315519},     // 39.0.8.0/21
-	{654315520, 654319615},     // 39.0.16.0/20
-	{654319616, 654327807},     // 39.0.32.0/19
-	{654327808, 654344191},     // 39.0.64.0/18
-	{654344192, 654376959},     // 39.0.128.0/17
-	{658505728, 660602879},     // 39.64.0.0/11
-	{660602880, 660668415},     // 39.96.0.0/16
-	{660668416, 660701183},     // 39.97.0.0/17
-	{660701184, 660717567},     // 39.97.128.0/18
-	{660717568, 660719615},     // 39.97.192.0/21
-	{660719616, 660720639},     // 39.97.200.0/22
-	{660721664, 660725759},     // 39.97.208.0/20
-	{660725760, 660733951},     // 39.97.224.0/19
-	{660733952, 660865023},     // 39.98.0.0/15
-	{660865024, 661127167},     // 39.100.0.0/14
-	{661127168, 661389311},     // 39.104.0.0/14
-	{661389312, 661454847},     // 39.108.0.0/16
-	{661485568, 661486079},     // 39.109.120.0/23
-	{662700032, 666894335},     // 39.128.0.0/10
-	{671133696, 671137791},     // 40.0.176.0/20
-	{671152128, 671154175},     // 40.0.248.0/21
-	{675807232, 675938303},     // 40.72.0.0/15
-	{676169840, 676169855},     // 40.77.136.112/28
-	{676195552, 676195583},     // 40.77.236.224/27
-	{676200000, 676200031},     // 40.77.254.64/27
-	{679313408, 679346175},     // 40.125.128.0/17
-	{679362560, 679378943},     // 40.126.64.0/18
-	{684067328, 684067583},     // 40.198.10.0/24
-	{684068864, 684070911},     // 40.198.16.0/21
-	{684070912, 684071423},     // 40.198.24.0/23
-	{687595776, 687596031},     // 40.251.225.0/24
-	{687596288, 687596543},     // 40.251.227.0/24
-	{704643072, 704644095},     // 42.0.0.0/22
-	{704645120, 704647167},     // 42.0.8.0/21
-	{704647168, 704649215},     // 42.0.16.0/21
-	{704649216, 704650239},     // 42.0.24.0/22
-	{704651264, 704659455},     // 42.0.32.0/19
-	{704675840, 704708607},     // 42.0.128.0/17
-	{704708608, 704716799},     // 42.1.0.0/19
-	{704716800, 704720895},     // 42.1.32.0/20
-	{704720896, 704722943},     // 42.1.48.0/21
-	{704722944, 704723967},     // 42.1.56.0/22
-	{704905216, 705167359},     // 42.4.0.0/14
-	{707788800, 708313087},     // 42.48.0.0/13
-	{708313088, 708575231},     // 42.56.0.0/14
-	{708706304, 708739071},     // 42.62.0.0/17
-	{708739072, 708747263},     // 42.62.128.0/19
-	{708747264, 708751359},     // 42.62.160.0/20
-	{708752384, 708753407},     // 42.62.180.0/22
-	{708753408, 708755455},     // 42.62.184.0/21
-	{708771840, 708837375},     // 42.63.0.0/16
-	{709885952, 710017023},     // 42.80.0.0/15
-	{710098944, 710103039},     // 42.83.64.0/20
-	{710103040, 710104063},     // 42.83.80.0/22
-	{710105088, 710107135},     // 42.83.88.0/21
-	{710107136, 710115327},     // 42.83.96.0/19
-	{710115328, 710115839},     // 42.83.128.0/23
-	{710116864, 710117119},     // 42.83.134.0/24
-	{710117888, 710118399},     // 42.83.138.0/23
-	{710118400, 710119423},     // 42.83.140.0/22
-	{710119424, 710123519},     // 42.83.144.0/20
-	{710123520, 710131711},     // 42.83.160.0/19
-	{710131712, 710148095},     // 42.83.192.0/18
-	{710148096, 710410239},     // 42.84.0.0/14
-	{710410240, 710934527},     // 42.88.0.0/13
-	{710950912, 710959103},     // 42.96.64.0/19
-	{710959104, 710961151},     // 42.96.96.0/21
-	{710962176, 710963199},     // 42.96.108.0/22
-	{710963200, 710967295},     // 42.96.112.0/20
-	{710967296, 711000063},     // 42.96.128.0/17
-	{711000064, 711065599},     // 42.97.0.0/16
-	{711131136, 711147519},     // 42.99.0.0/18
-	{711147520, 711155711},     // 42.99.64.0/19
-	{711155712, 711159807},     // 42.99.96.0/20
-	{711159808, 711160831},     // 42.99.112.0/22
-	{711161856, 711163903},     // 42.99.120.0/21
-	{711196672, 711458815},     // 42.100.0.0/14
-	{712507392, 712638463},     // 42.120.0.0/15
-	{712638464, 712703999},     // 42.122.0.0/16
-	{712704000, 712712191},     // 42.123.0.0/19
-	{712713216, 712714239},     // 42.123.36.0/22
-	{712714240, 712716287},     // 42.123.40.0/21
-	{712716288, 712720383},     // 42.123.48.0/20
-	{712720384, 712736767},     // 42.123.64.0/18
-	{712736768, 712769535},     // 42.123.128.0/17
-	{713031680, 714080255},     // 42.128.0.0/12
-	{714866688, 714874879},     // 42.156.0.0/19
-	{714875904, 714876927},     // 42.156.36.0/22
-	{714876928, 714878975},     // 42.156.40.0/21
-	{714878976, 714883071},     // 42.156.48.0/20
-	{714883072, 714899455},     // 42.156.64.0/18
-	{714899456, 714932223},     // 42.156.128.0/17
-	{714932224, 714934271},     // 42.157.0.0/21
-	{714934272, 714935295},     // 42.157.8.0/22
-	{714935808, 714936319},     // 42.157.14.0/23
-	{714936320, 714940415},     // 42.157.16.0/20
-	{714940416, 714948607},     // 42.157.32.0/19
-	{714948608, 714964991},     // 42.157.64.0/18
-	{714964992, 714997759},     // 42.157.128.0/17
-	{714997760, 715128831},     // 42.158.0.0/15
-	{715128832, 716177407},     // 42.160.0.0/12
-	{716177408, 716701695},     // 42.176.0.0/13
-	{716701696, 716832767},     // 42.184.0.0/15
-	{716832768, 716898303},     // 42.186.0.0/16
-	{716898304, 716914687},     // 42.187.0.0/18
-	{716914688, 716922879},     // 42.187.64.0/19
-	{716922880, 716926975},     // 42.187.96.0/20
-	{716926976, 716929023},     // 42.187.112.0/21
-	{716929024, 716930047},     // 42.187.120.0/22
-	{716931072, 716963839},     // 42.187.128.0/17
-	{717225984, 717750271},     // 42.192.0.0/13
-	{717815808, 717848575},     // 42.201.0.0/17
-	{717881344, 718012415},     // 42.202.0.0/15
-	{718012416, 718274559},     // 42.204.0.0/14
-	{718274560, 719323135},     // 42.208.0.0/12
-	{719323136, 720371711},     // 42.224.0.0/12
-	{720371712, 720437247},     // 42.240.0.0/16
-	{720502784, 720633855},     // 42.242.0.0/15
-	{720633856, 720764927},     // 42.244.0.0/15
-	{720764928, 720830463},     // 42.246.0.0/16
-	{720830464, 720831487},     // 42.247.0.0/22
-	{720831488, 720831743},     // 42.247.4.0/24
-	{720831744, 720831871},     // 42.247.5.0/25
-	{720831872, 720831935},     // 42.247.5.128/26
-	{720831948, 720831951},     // 42.247.5.204/30
-	{720831952, 720831967},     // 42.247.5.208/28
-	{720831968, 720831999},     // 42.247.5.224/27
-	{720832000, 720832511},     // 42.247.6.0/23
-	{720832512, 720834559},     // 42.247.8.0/21
-	{720834560, 720838655},     // 42.247.16.0/20
-	{720838656, 720846847},     // 42.247.32.0/19
-	{720846848, 720863231},     // 42.247.64.0/18
-	{720863232, 720895999},     // 42.247.128.0/17
-	{720896000, 721420287},     // 42.248.0.0/13
-	{729874432, 729939967},     // 43.129.0.0/16
-	{729972736, 730005503},     // 43.130.128.0/17
-	{730038272, 730071039},     // 43.131.128.0/17
-	{730071040, 730071551},     // 43.132.0.0/23
-	{730072064, 730073087},     // 43.132.4.0/22
-	{730073088, 730075135},     // 43.132.8.0/21
-	{730075136, 730079231},     // 43.132.16.0/20
-	{730079232, 730087423},     // 43.132.32.0/19
-	{730087424, 730103807},     // 43.132.64.0/18
-	{730103808, 730136575},     // 43.132.128.0/17
-	{730136576, 730202111},     // 43.133.0.0/16
-	{730202112, 730333183},     // 43.134.0.0/15
-	{730333184, 730365951},     // 43.136.0.0/17
-	{730365952, 730374143},     // 43.136.128.0/19
-	{730374400, 730374655},     // 43.136.161.0/24
-	{730374656, 730375167},     // 43.136.162.0/23
-	{730375168, 730376191},     // 43.136.164.0/22
-	{730376192, 730378239},     // 43.136.168.0/21
-	{730378240, 730382335},     // 43.136.176.0/20
-	{730382336, 730398719},     // 43.136.192.0/18
-	{730398720, 730464255},     // 43.137.0.0/16
-	{730464256, 730595327},     // 43.138.0.0/15
-	{730595328, 730857471},     // 43.140.0.0/14
-	{730857472, 731906047},     // 43.144.0.0/12
-	{731906048, 734003199},     // 43.160.0.0/11
-	{736103424, 736104447},     // 43.224.12.0/22
-	{736106496, 736107519},     // 43.224.24.0/22
-	{736111616, 736112639},     // 43.224.44.0/22
-	{736113664, 736114687},     // 43.224.52.0/22
-	{736114688, 736115711},     // 43.224.56.0/22
-	{736117760, 736118783},     // 43.224.68.0/22
-	{736118784, 736119807},     // 43.224.72.0/22
-	{736120832, 736121855},     // 43.224.80.0/22
-	{736125952, 736126975},     // 43.224.100.0/22
-	{736137216, 736138239},     // 43.224.144.0/22
-	{736141568, 736141823},     // 43.224.161.0/24
-	{736145408, 736146431},     // 43.224.176.0/22
-	{736147456, 736148479},     // 43.224.184.0/22
-	{736151552, 736153599},     // 43.224.200.0/21
-	{736153600, 736155647},     // 43.224.208.0/21
-	{736155648, 736156671},     // 43.224.216.0/22
-	{736161792, 736162815},     // 43.224.240.0/22
-	{736185344, 736186367},     // 43.225.76.0/22
-	{736187904, 736188159},     // 43.225.86.0/24
-	{736196608, 736197631},     // 43.225.120.0/22
-	{736211968, 736212991},     // 43.225.180.0/22
-	{736219136, 736220159},     // 43.225.208.0/22
-	{736221184, 736223231},     // 43.225.216.0/21
-	{736223232, 736227327},     // 43.225.224.0/20
-	{736227328, 736229375},     // 43.225.240.0/21
-	{736230400, 736231423},     // 43.225.252.0/22
-	{736239616, 736247807},     // 43.226.32.0/19
-	{736247808, 736255999},     // 43.226.64.0/19
-	{736256000, 736260095},     // 43.226.96.0/20
-	{736260096, 736262143},     // 43.226.112.0/21
-	{736262144, 736263167},     // 43.226.120.0/22
-	{736264192, 736272383},     // 43.226.128.0/19
-	{736272384, 736274431},     // 43.226.160.0/21
-	{736291840, 736292863},     // 43.226.236.0/22
-	{736292864, 736296959},     // 43.226.240.0/20
-	{736296960, 736299007},     // 43.227.0.0/21
-	{736299008, 736300031},     // 43.227.8.0/22
-	{736305152, 736313343},     // 43.227.32.0/19
-	{736313344, 736321535},     // 43.227.64.0/19
-	{736323584, 736324607},     // 43.227.104.0/22
-	{736331776, 736333823},     // 43.227.136.0/21
-	{736333824, 736334847},     // 43.227.144.0/22
-	{736335872, 736337919},     // 43.227.152.0/21
-	{736337920, 736342015},     // 43.227.160.0/20
-	{736342016, 736344063},     // 43.227.176.0/21
-	{736345088, 736346111},     // 43.227.188.0/22
-	{736346112, 736354303},     // 43.227.192.0/19
-	{736356352, 736357375},     // 43.227.232.0/22
-	{736360448, 736362495},     // 43.227.248.0/21
-	{736362496, 736378879},     // 43.228.0.0/18
-	{736378880, 736380927},     // 43.228.64.0/21
-	{736381952, 736382975},     // 43.228.76.0/22
-	{736388096, 736389119},     // 43.228.100.0/22
-	{736392192, 736392447},     // 43.228.116.0/24
-	{736392704, 736393215},     // 43.228.118.0/23
-	{736396288, 736397311},     // 43.228.132.0/22
-	{736397312, 736398335},     // 43.228.136.0/22
-	{736400384, 736401407},     // 43.228.148.0/22
-	{736401408, 736402431},     // 43.228.152.0/22
-	{736410624, 736411647},     // 43.228.188.0/22
-	{736438272, 736439295},     // 43.229.40.0/22
-	{736440320, 736440831},     // 43.229.48.0/23
-	{736440832, 736441087},     // 43.229.50.0/24
-	{736441088, 736441151},     // 43.229.51.0/26
-	{736441152, 736441183},     // 43.229.51.64/27
-	{736441184, 736441187},     // 43.229.51.96/30
-	{736441189, 736441189},     // 43.229.51.101/32
-	{736441190, 736441191},     // 43.229.51.102/31
-	{736441192, 736441199},     // 43.229.51.104/29
-	{736441200, 736441215},     // 43.229.51.112/28
-	{736441216, 736441343},     // 43.229.51.128/25
-	{736442368, 736443391},     // 43.229.56.0/22
-	{736452608, 736453631},     // 43.229.96.0/22
-	{736462848, 736464895},     // 43.229.136.0/21
-	{736471040, 736473087},     // 43.229.168.0/21
-	{736473088, 736477183},     // 43.229.176.0/20
-	{736477184, 736479231},     // 43.229.192.0/21
-	{736483328, 736485375},     // 43.229.216.0/21
-	{736487424, 736489471},     // 43.229.232.0/21
-	{736498688, 736499711},     // 43.230.20.0/22
-	{736501760, 736502783},     // 43.230.32.0/22
-	{736510976, 736511999},     // 43.230.68.0/22
-	{736512000, 736513023},     // 43.230.72.0/22
-	{736515072, 736516095},     // 43.230.84.0/22
-	{736525312, 736526335},     // 43.230.124.0/22
-	{736549888, 736550911},     // 43.230.220.0/22
-	{736550912, 736559103},     // 43.230.224.0/19
-	{736567296, 736571391},     // 43.231.32.0/20
-	{736579584, 736583679},     // 43.231.80.0/20
-	{736583680, 736587775},     // 43.231.96.0/20
-	{736593920, 736595967},     // 43.231.136.0/21
-	{736595968, 736600063},     // 43.231.144.0/20
-	{736600064, 736604159},     // 43.231.160.0/20
-	{736604160, 736606207},     // 43.231.176.0/21
-	{736886784, 737017855},     // 43.236.0.0/15
-	{737017856, 737083391},     // 43.238.0.0/16
-	{737083392, 737091583},     // 43.239.0.0/19
-	{737091584, 737095679},     // 43.239.32.0/20
-	{737095680, 737096703},     // 43.239.48.0/22
-	{737113088, 737114111},     // 43.239.116.0/22
-	{737114112, 737115135},     // 43.239.120.0/22
-	{737127424, 737128447},     // 43.239.172.0/22
-	{737148928, 737149951},     // 43.240.0.0/22
-	{737163264, 737165311},     // 43.240.56.0/21
-	{737166336, 737167359},     // 43.240.68.0/22
-	{737167360, 737169407},     // 43.240.72.0/21
-	{737170432, 737171455},     // 43.240.84.0/22
-	{737180672, 737181695},     // 43.240.124.0/22
-	{737181696, 737183743},     // 43.240.128.0/21
-	{737183744, 737184767},     // 43.240.136.0/22
-	{737188864, 737189887},     // 43.240.156.0/22
-	{737189888, 737198079},     // 43.240.160.0/19
-	{737198080, 737206271},     // 43.240.192.0/19
-	{737210368, 737214463},     // 43.240.240.0/20
-	{737214464, 737218559},     // 43.241.0.0/20
-	{737218560, 737220607},     // 43.241.16.0/21
-	{737226752, 737227775},     // 43.241.48.0/22
-	{737233920, 737234943},     // 43.241.76.0/22
-	{737234944, 737239039},     // 43.241.80.0/20
-	{737243136, 737244159},     // 43.241.112.0/22
-	{737257472, 737259519},     // 43.241.168.0/21
-	{737259520, 737261567},     // 43.241.176.0/21
-	{737261568, 737262591},     // 43.241.184.0/22
-	{737267712, 737271807},     // 43.241.208.0/20
-	{737271808, 737275903},     // 43.241.224.0/20
-	{737275904, 737276927},     // 43.241.240.0/22
-	{737277952, 737278975},     // 43.241.248.0/22
-	{737282048, 737284095},     // 43.242.8.0/21
-	{737284096, 737288191},     // 43.242.16.0/20
-	{737292288, 737293311},     // 43.242.48.0/22
-	{737293568, 737293823},     // 43.242.53.0/24
-	{737293824, 737294079},     // 43.242.54.0/24
-	{737294336, 737296383},     // 43.242.56.0/21
-	{737296384, 737297407},     // 43.242.64.0/22
-	{737298432, 737300479},     // 43.242.72.0/21
-	{737300480, 737304575},     // 43.242.80.0/20
-	{737304576, 737305599},     // 43.242.96.0/22
-	{737316864, 737320959},     // 43.242.144.0/20
-	{737320960, 737323007},     // 43.242.160.0/21
-	{737326080, 737327103},     // 43.242.180.0/22
-	{737328128, 737329151},     // 43.242.188.0/22
-	{737329152, 737331199},     // 43.242.192.0/21
-	{737332224, 737333247},     // 43.242.204.0/22
-	{737335296, 737337343},     // 43.242.216.0/21
-	{737344512, 737345535},     // 43.242.252.0/22
-	{737346560, 737347583},     // 43.243.4.0/22
-	{737347584, 737349631},     // 43.243.8.0/21
-	{737349632, 737350655},     // 43.243.16.0/22
-	{737368064, 737369087},     // 43.243.88.0/22
-	{737378304, 737379327},     // 43.243.128.0/22
-	{737380352, 737381375},     // 43.243.136.0/22
-	{737382400, 737384447},     // 43.243.144.0/21
-	{737385472, 737386495},     // 43.243.156.0/22
-	{737391616, 737392639},     // 43.243.180.0/22
-	{737403904, 737404927},     // 43.243.228.0/22
-	{737404928, 737405951},     // 43.243.232.0/22
-	{737408000, 737409023},     // 43.243.244.0/22
-	{737542144, 737558527},     // 43.246.0.0/18
-	{737558528, 737566719},     // 43.246.64.0/19
-	{737566720, 737567743},     // 43.246.96.0/22
-	{737579776, 737580031},     // 43.246.147.0/24
-	{737580032, 737580287},     // 43.246.148.0/24
-	{737580544, 737580799},     // 43.246.150.0/24
-	{737580800, 737580803},     // 43.246.151.0/30
-	{737580804, 737580804},     // 43.246.151.4/32
-	{737580806, 737580807},     // 43.246.151.6/31
-	{737580808, 737580815},     // 43.246.151.8/29
-	{737580816, 737580831},     // 43.246.151.16/28
-	{737580832, 737580863},     // 43.246.151.32/27
-	{737580864, 737580927},     // 43.246.151.64/26
-	{737580928, 737581055},     // 43.246.151.128/25
-	{737600512, 737601535},     // 43.246.228.0/22
-	{737608704, 737609727},     // 43.247.4.0/22
-	{737609728, 737610751},     // 43.247.8.0/22
-	{737618944, 737619967},     // 43.247.44.0/22
-	{737619968, 737620991},     // 43.247.48.0/22
-	{737625088, 737626111},     // 43.247.68.0/22
-	{737627136, 737628159},     // 43.247.76.0/22
-	{737629184, 737630207},     // 43.247.84.0/22
-	{737630208, 737632255},     // 43.247.88.0/21
-	{737632256, 737634303},     // 43.247.96.0/21
-	{737635328, 737636351},     // 43.247.108.0/22
-	{737636352, 737637375},     // 43.247.112.0/22
-	{737645568, 737646591},     // 43.247.148.0/22
-	{737646592, 737647615},     // 43.247.152.0/22
-	{737652736, 737656831},     // 43.247.176.0/20
-	{737657856, 737658879},     // 43.247.196.0/22
-	{737658880, 737660927},     // 43.247.200.0/21
-	{737660928, 737665023},     // 43.247.208.0/20
-	{737665024, 737673215},     // 43.247.224.0/19
-	{737673216, 737675263},     // 43.248.0.0/21
-	{737678336, 737679359},     // 43.248.20.0/22
-	{737680384, 737681407},     // 43.248.28.0/22
-	{737685504, 737686527},     // 43.248.48.0/22
-	{737692672, 737693695},     // 43.248.76.0/22
-	{737693696, 737697791},     // 43.248.80.0/20
-	{737697792, 737705983},     // 43.248.96.0/19
-	{737705984, 737710079},     // 43.248.128.0/20
-	{737710080, 737712127},     // 43.248.144.0/21
-	{737718272, 737722367},     // 43.248.176.0/20
-	{737722368, 737726463},     // 43.248.192.0/20
-	{737726464, 737727487},     // 43.248.208.0/22
-	{737731584, 737732607},     // 43.248.228.0/22
-	{737732608, 737733631},     // 43.248.232.0/22
-	{737735680, 737736703},     // 43.248.244.0/22
-	{737739776, 737740799},     // 43.249.4.0/22
-	{737769472, 737770495},     // 43.249.120.0/22
-	{737772544, 737773567},     // 43.249.132.0/22
-	{737773568, 737774591},     // 43.249.136.0/22
-	{737775616, 737779711},     // 43.249.144.0/20
-	{737779712, 737781759},     // 43.249.160.0/21
-	{737781760, 737782783},     // 43.249.168.0/22
-	{737787904, 737788927},     // 43.249.192.0/22
-	{737799168, 737800191},     // 43.249.236.0/22
-	{737805312, 737806335},     // 43.250.4.0/22
-	{737807360, 737808383},     // 43.250.12.0/22
-	{737808384, 737810431},     // 43.250.16.0/21
-	{737811456, 737812479},     // 43.250.28.0/22
-	{737812480, 737813503},     // 43.250.32.0/22
-	{737828864, 737830911},     // 43.250.96.0/21
-	{737831936, 737832959},     // 43.250.108.0/22
-	{737832960, 737833983},     // 43.250.112.0/22
-	{737834496, 737835007},     // 43.250.118.0/23
-	{737837056, 737838079},     // 43.250.128.0/22
-	{737841152, 737843199},     // 43.250.144.0/21
-	{737845248, 737846271},     // 43.250.160.0/22
-	{737847296, 737848319},     // 43.250.168.0/22
-	{737849344, 737850367},     // 43.250.176.0/22
-	{737855488, 737856511},     // 43.250.200.0/22
-	{737858560, 737859583},     // 43.250.212.0/22
-	{737859584, 737861631},     // 43.250.216.0/21
-	{737864704, 737865727},     // 43.250.236.0/22
-	{737866752, 737867775},     // 43.250.244.0/22
-	{737870848, 737871871},     // 43.251.4.0/22
-	{737879040, 737880063},     // 43.251.36.0/22
-	{737918976, 737919999},     // 43.251.192.0/22
-	{737929216, 737930239},     // 43.251.232.0/22
-	{737932288, 737933311},     // 43.251.244.0/22
-	{737947648, 737948671},     // 43.252.48.0/22
-	{737949696, 737950719},     // 43.252.56.0/22
-	{737992704, 737993727},     // 43.252.224.0/22
-	{738066432, 738068479},     // 43.254.0.0/21
-	{738068480, 738069503},     // 43.254.8.0/22
-	{738072576, 738073599},     // 43.254.24.0/22
-	{738075648, 738076671},     // 43.254.36.0/22
-	{738077696, 738078719},     // 43.254.44.0/22
-	{738079744, 738080767},     // 43.254.52.0/22
-	{738082816, 738083839},     // 43.254.64.0/22
-	{738084864, 738085887},     // 43.254.72.0/22
-	{738087936, 738088959},     // 43.254.84.0/22
-	{738088960, 738091007},     // 43.254.88.0/21
-	{738092032, 738093055},     // 43.254.100.0/22
-	{738093056, 738094079},     // 43.254.104.0/22
-	{738095104, 738097151},     // 43.254.112.0/21
-	{738099200, 738100223},     // 43.254.128.0/22
-	{738101248, 738103295},     // 43.254.136.0/21
-	{738103296, 738107391},     // 43.254.144.0/20
-	{738109440, 738111487},     // 43.254.168.0/21
-	{738112512, 738113535},     // 43.254.180.0/22
-	{738113536, 738115583},     // 43.254.184.0/21
-	{738115584, 738116607},     // 43.254.192.0/22
-	{738117632, 738118655},     // 43.254.200.0/22
-	{738119680, 738120703},     // 43.254.208.0/22
-	{738122752, 738123775},     // 43.254.220.0/22
-	{738123776, 738127871},     // 43.254.224.0/20
-	{738127872, 738128895},     // 43.254.240.0/22
-	{738129920, 738131967},     // 43.254.248.0/21
-	{738131968, 738134015},     // 43.255.0.0/21
-	{738134016, 738135039},     // 43.255.8.0/22
-	{738136064, 738137087},     // 43.255.16.0/22
-	{738144256, 738145279},     // 43.255.48.0/22
-	{738148352, 738152447},     // 43.255.64.0/20
-	{738153472, 738154495},     // 43.255.84.0/22
-	{738156544, 738157567},     // 43.255.96.0/22
-	{738168832, 738169855},     // 43.255.144.0/22
-	{738177024, 738178047},     // 43.255.176.0/22
-	{738179072, 738180095},     // 43.255.184.0/22
-	{738181120, 738182143},     // 43.255.192.0/22
-	{738183168, 738185215},     // 43.255.200.0/21
-	{738185216, 738187263},     // 43.255.208.0/21
-	{738189312, 738191359},     // 43.255.224.0/21
-	{738191360, 738192383},     // 43.255.232.0/22
-	{738194432, 738195455},     // 43.255.244.0/22
-	{757645312, 757649407},     // 45.40.192.0/20
-	{757649408, 757651455},     // 45.40.208.0/21
-	{757653504, 757661695},     // 45.40.224.0/19
-	{759238656, 759242751},     // 45.65.16.0/20
-	{760689920, 760690175},     // 45.87.53.0/24
-	{760690176, 760690687},     // 45.87.54.0/23
-	{762348544, 762349567},     // 45.112.132.0/22
-	{762362880, 762363903},     // 45.112.188.0/22
-	{762368000, 762372095},     // 45.112.208.0/20
-	{762373120, 762374143},     // 45.112.228.0/22
-	{762374144, 762376191},     // 45.112.232.0/21
-	{762383360, 762384383},     // 45.113.12.0/22
-	{762384384, 762388479},     // 45.113.16.0/20
-	{762390528, 762391551},     // 45.113.40.0/22
-	{762393600, 762394623},     // 45.113.52.0/22
-	{762398720, 762399743},     // 45.113.72.0/22
-	{762417152, 762419199},     // 45.113.144.0/21
-	{762423296, 762424319},     // 45.113.168.0/22
-	{762427392, 762428415},     // 45.113.184.0/22
-	{762431488, 762433535},     // 45.113.200.0/21
-	{762433536, 762437631},     // 45.113.208.0/20
-	{762441728, 762442751},     // 45.113.240.0/22
-	{762444800, 762445823},     // 45.113.252.0/22
-	{762445824, 762446847},     // 45.114.0.0/22
-	{762454016, 762455039},     // 45.114.32.0/22
-	{762459136, 762460159},     // 45.114.52.0/22
-	{762470400, 762471423},     // 45.114.96.0/22
-	{762480640, 762481663},     // 45.114.136.0/22
-	{762496000, 762497023},     // 45.114.196.0/22
-	{762497024, 762498047},     // 45.114.200.0/22
-	{762504192, 762505215},     // 45.114.228.0/22
-	{762506496, 762506751},     // 45.114.237.0/24
-	{762506752, 762507263},     // 45.114.238.0/23
-	{762510336, 762511359},     // 45.114.252.0/22
-	{762522624, 762523647},     // 45.115.44.0/22
-	{762536960, 762537983},     // 45.115.100.0/22
-	{762542080, 762543103},     // 45.115.120.0/22
-	{762545152, 762546175},     // 45.115.132.0/22
-	{762548224, 762549247},     // 45.115.144.0/22
-	{762551296, 762552319},     // 45.115.156.0/22
-	{762553344, 762554367},     // 45.115.164.0/22
-	{762562560, 762563583},     // 45.115.200.0/22
-	{762565632, 762566655},     // 45.115.212.0/22
-	{762573824, 762574847},     // 45.115.244.0/22
-	{762574848, 762575871},     // 45.115.248.0/22
-	{762580992, 762582015},     // 45.116.16.0/22
-	{762583040, 762584063},     // 45.116.24.0/22
-	{762585088, 762587135},     // 45.116.32.0/21
-	{762590208, 762591231},     // 45.116.52.0/22
-	{762601472, 762603519},     // 45.116.96.0/21
-	{762612736, 762613759},     // 45.116.140.0/22
-	{762615808, 762616831},     // 45.116.152.0/22
-	{762630144, 762631167},     // 45.116.208.0/22
-	{762644480, 762645503},     // 45.117.8.0/22
-	{762647552, 762648575},     // 45.117.20.0/22
-	{762659840, 762660863},     // 45.117.68.0/22
-	{762674176, 762675199},     // 45.117.124.0/22
-	{762706944, 762707967},     // 45.117.252.0/22
-	{762788864, 762789887},     // 45.119.60.0/22
-	{762789888, 762791935},     // 45.119.64.0/21
-	{762791936, 762792959},     // 45.119.72.0/22
-	{762800128, 762801151},     // 45.119.104.0/22
-	{762832896, 762833919},     // 45.119.232.0/22
-	{762864640, 762865663},     // 45.120.100.0/22
-	{762874880, 762875903},     // 45.120.140.0/22
-	{762881024, 762882047},     // 45.120.164.0/22
-	{762885248, 762885279},     // 45.120.180.128/27
-	{762900480, 762900735},     // 45.120.240.0/24
-	{762900992, 762901503},     // 45.120.242.0/23
-	{762917888, 762918911},     // 45.121.52.0/22
-	{762920960, 762923007},     // 45.121.64.0/21
-	{762923008, 762924031},     // 45.121.72.0/22
-	{762928128, 762929151},     // 45.121.92.0/22
-	{762929152, 762930175},     // 45.121.96.0/22
-	{762948608, 762949631},     // 45.121.172.0/22
-	{762949632, 762950655},     // 45.121.176.0/22
-	{762966016, 762970111},     // 45.121.240.0/20
-	{762970112, 762978303},     // 45.122.0.0/19
-	{762978304, 762980351},     // 45.122.32.0/21
-	{762980352, 762981375},     // 45.122.40.0/22
-	{762985472, 762986495},     // 45.122.60.0/22
-	{762986496, 762994687},     // 45.122.64.0/19
-	{762994688, 762998783},     // 45.122.96.0/20
-	{762998784, 763000831},     // 45.122.112.0/21
-	{763011072, 763019263},     // 45.122.160.0/19
-	{763019264, 763023359},     // 45.122.192.0/20
-	{763023360, 763025407},     // 45.122.208.0/21
-	{763025408, 763026431},     // 45.122.216.0/22
-	{763042816, 763043839},     // 45.123.28.0/22
-	{763043840, 763045887},     // 45.123.32.0/21
-	{763046912, 763047935},     // 45.123.44.0/22
-	{763047936, 763052031},     // 45.123.48.0/20
-	{763052032, 763056127},     // 45.123.64.0/20
-	{763056128, 763058175},     // 45.123.80.0/21
-	{763066368, 763067391},     // 45.123.120.0/22
-	{763068416, 763070463},     // 45.123.128.0/21
-	{763070464, 763071487},     // 45.123.136.0/22
-	{763073536, 763074559},     // 45.123.148.0/22
-	{763074560, 763076607},     // 45.123.152.0/21
-	{763077632, 763078655},     // 45.123.164.0/22
-	{763078656, 763080703},     // 45.123.168.0/21
-	{763080704, 763082751},     // 45.123.176.0/21
-	{763082752, 763083775},     // 45.123.184.0/22
-	{763087872, 763088895},     // 45.123.204.0/22
-	{763089920, 763090943},     // 45.123.212.0/22
-	{763092992, 763101183},     // 45.123.224.0/19
-	{763101184, 763102207},     // 45.124.0.0/22
-	{763106304, 763107327},     // 45.124.20.0/22
-	{763108352, 763109375},     // 45.124.28.0/22
-	{763109376, 763111423},     // 45.124.32.0/21
-	{763112448, 763113471},     // 45.124.44.0/22
-	{763118592, 763119615},     // 45.124.68.0/22
-	{763120640, 763121663},     // 45.124.76.0/22
-	{763121664, 763122687},     // 45.124.80.0/22
-	{763126784, 763127807},     // 45.124.100.0/22
-	{763132928, 763133951},     // 45.124.124.0/22
-	{763145216, 763146239},     // 45.124.172.0/22
-	{763146240, 763147263},     // 45.124.176.0/22
-	{763154432, 763155455},     // 45.124.208.0/22
-	{763164672, 763165695},     // 45.124.248.0/22
-	{763172864, 763173887},     // 45.125.24.0/22
-	{763177984, 763179007},     // 45.125.44.0/22
-	{763180032, 763181055},     // 45.125.52.0/22
-	{763181056, 763182079},     // 45.125.56.0/22
-	{763186176, 763187199},     // 45.125.76.0/22
-	{763187200, 763191295},     // 45.125.80.0/20
-	{763191296, 763193343},     // 45.125.96.0/21
-	{763201536, 763202559},     // 45.125.136.0/22
-	{763244544, 763246591},     // 45.126.48.0/21
-	{763259904, 763260927},     // 45.126.108.0/22
-	{763260928, 763262975},     // 45.126.112.0/21
-	{763262976, 763263999},     // 45.126.120.0/22
-	{763288576, 763289599},     // 45.126.220.0/22
-	{763299840, 763301887},     // 45.127.8.0/21
-	{763330560, 763331583},     // 45.127.128.0/22
-	{763334656, 763336703},     // 45.127.144.0/21
-	{763337728, 763338751},     // 45.127.156.0/22
-	{771229696, 771230719},     // 45.248.8.0/22
-	{771248128, 771249151},     // 45.248.80.0/22
-	{771250176, 771251199},     // 45.248.88.0/22
-	{771252224, 771256319},     // 45.248.96.0/20
-	{771260416, 771262463},     // 45.248.128.0/21
-	{771279872, 771280895},     // 45.248.204.0/22
-	{771280896, 771284991},     // 45.248.208.0/20
-	{771284992, 771293183},     // 45.248.224.0/19
-	{771293184, 771295231},     // 45.249.0.0/21
-	{771296256, 771297279},     // 45.249.12.0/22
-	{771297280, 771301375},     // 45.249.16.0/20
-	{771301376, 771303423},     // 45.249.32.0/21
-	{771321856, 771322879},     // 45.249.112.0/22
-	{771341312, 771342335},     // 45.249.188.0/22
-	{771342336, 771346431},     // 45.249.192.0/20
-	{771346432, 771348479},     // 45.249.208.0/21
-	{771361792, 771362815},     // 45.250.12.0/22
-	{771362816, 771363839},     // 45.250.16.0/22
-	{771365888, 771366911},     // 45.250.28.0/22
-	{771366912, 771368959},     // 45.250.32.0/21
-	{771368960, 771369983},     // 45.250.40.0/22
-	{771378176, 771379199},     // 45.250.76.0/22
-	{771379200, 771383295},     // 45.250.80.0/20
-	{771383296, 771384319},     // 45.250.96.0/22
-	{771385344, 771387391},     // 45.250.104.0/21
-	{771387392, 771391487},     // 45.250.112.0/20
-	{771391488, 771395583},     // 45.250.128.0/20
-	{771395584, 771397631},     // 45.250.144.0/21
-	{771397632, 771398655},     // 45.250.152.0/22
-	{771400704, 771401727},     // 45.250.164.0/22
-	{771404800, 771405823},     // 45.250.180.0/22
-	{771405824, 771407871},     // 45.250.184.0/21
-	{771407872, 771408895},     // 45.250.192.0/22
-	{771424256, 771425279},     // 45.251.0.0/22
-	{771426304, 771427327},     // 45.251.8.0/22
-	{771428352, 771430399},     // 45.251.16.0/21
-	{771437568, 771438591},     // 45.251.52.0/22
-	{771445760, 771446783},     // 45.251.84.0/22
-	{771446784, 771448831},     // 45.251.88.0/21
-	{771448832, 771450879},     // 45.251.96.0/21
-	{771454976, 771457023},     // 45.251.120.0/21
-	{771459584, 771460095},     // 45.251.138.0/23
-	{771460096, 771461119},     // 45.251.140.0/22
-	{771461120, 771465215},     // 45.251.144.0/20
-	{771465216, 771473407},     // 45.251.160.0/19
-	{771473408, 771481599},     // 45.251.192.0/19
-	{771481600, 771482623},     // 45.251.224.0/22
-	{771489792, 771497983},     // 45.252.0.0/19
-	{771497984, 771502079},     // 45.252.32.0/20
-	{771502080, 771503103},     // 45.252.48.0/22
-	{771511296, 771512319},     // 45.252.84.0/22
-	{771512320, 771514367},     // 45.252.88.0/21
-	{771514368, 771522559},     // 45.252.96.0/19
-	{771522560, 771530751},     // 45.252.128.0/19
-	{771530752, 771534847},     // 45.252.160.0/20
-	{771534848, 771535871},     // 45.252.176.0/22
-	{771538944, 771547135},     // 45.252.192.0/19
-	{771547136, 771549183},     // 45.252.224.0/21
-	{771549184, 771550207},     // 45.252.232.0/22
-	{771555328, 771571711},     // 45.253.0.0/18
-	{771571712, 771575807},     // 45.253.64.0/20
-	{771575808, 771577855},     // 45.253.80.0/21
-	{771578880, 771579903},     // 45.253.92.0/22
-	{771579904, 771583999},     // 45.253.96.0/20
-	{771584000, 771586047},     // 45.253.112.0/21
-	{771586048, 771587071},     // 45.253.120.0/22
-	{771589120, 771590143},     // 45.253.132.0/22
-	{771590144, 771592191},     // 45.253.136.0/21
-	{771592192, 771596287},     // 45.253.144.0/20
-	{771596288, 771604479},     // 45.253.160.0/19
-	{771604480, 771612671},     // 45.253.192.0/19
-	{771612672, 771616767},     // 45.253.224.0/20
-	{771616768, 771617791},     // 45.253.240.0/22
-	{771620864, 771624959},     // 45.254.0.0/20
-	{771624960, 771627007},     // 45.254.16.0/21
-	{771628032, 771629055},     // 45.254.28.0/22
-	{771631104, 771632127},     // 45.254.40.0/22
-	{771633152, 771637247},     // 45.254.48.0/20
-	{771637248, 771653631},     // 45.254.64.0/18
-	{771653632, 771670015},     // 45.254.128.0/18
-	{771670016, 771678207},     // 45.254.192.0/19
-	{771678208, 771680255},     // 45.254.224.0/21
-	{771681280, 771682303},     // 45.254.236.0/22
-	{771684352, 771685375},     // 45.254.248.0/22
-	{771686400, 771702783},     // 45.255.0.0/18
-	{771702784, 771710975},     // 45.255.64.0/19
-	{771710976, 771715071},     // 45.255.96.0/20
-	{771715072, 771717119},     // 45.255.112.0/21
-	{771717120, 771718143},     // 45.255.120.0/22
-	{771721216, 771723263},     // 45.255.136.0/21
-	{771723264, 771727359},     // 45.255.144.0/20
-	{771727360, 771735551},     // 45.255.160.0/19
-	{771735552, 771743743},     // 45.255.192.0/19
-	{771743744, 771747839},     // 45.255.224.0/20
-	{771747840, 771749887},     // 45.255.240.0/21
-	{771749888, 771750911},     // 45.255.248.0/22
-	{788011008, 788011519},     // 46.248.24.0/23
-	{794558464, 794820607},     // 47.92.0.0/14
-	{794820608, 796917759},     // 47.96.0.0/11
-	{822345728, 822607871},     // 49.4.0.0/14
-	{825440512, 825440767},     // 49.51.57.0/24
-	{825440768, 825441279},     // 49.51.58.0/23
-	{825441280, 825441791},     // 49.51.60.0/23
-	{825454080, 825454591},     // 49.51.110.0/23
-	{825454592, 825458687},     // 49.51.112.0/20
-	{825491456, 825753599},     // 49.52.0.0/14
-	{826277888, 828375039},     // 49.64.0.0/11
-	{829423616, 829947903},     // 49.112.0.0/13
-	{829947904, 830210047},     // 49.120.0.0/14
-	{830472192, 830472447},     // 49.128.0.0/24
-	{830472704, 830473215},     // 49.128.2.0/23
-	{830473216, 830474239},     // 49.128.4.0/22
-	{831258624, 831389695},     // 49.140.0.0/15
-	{832045056, 832307199},     // 49.152.0.0/14
-	{835715072, 835977215},     // 49.208.0.0/14
-	{836501504, 836763647},     // 49.220.0.0/14
-	{837287936, 837550079},     // 49.232.0.0/14
-	{837746688, 837763071},     // 49.239.0.0/18
-	{837795840, 837812223},     // 49.239.192.0/18
-	{877658112, 877920255},     // 52.80.0.0/14
-	{878542684, 878542687},     // 52.93.127.92/30
-	{878542688, 878542695},     // 52.93.127.96/29
-	{878542696, 878542697},     // 52.93.127.104/31
-	{878572152, 878572159},     // 52.93.242.120/29
-	{878572160, 878572287},     // 52.93.242.128/25
-	{878639360, 878639391},     // 52.94.249.0/27
-	{880934912, 881065983},     // 52.130.0.0/15
-	{920518656, 920649727},     // 54.222.0.0/15
-	{921161728, 921165823},     // 54.231.208.0/20
-	{921755648, 921755903},     // 54.240.224.0/24
-	{962355200, 962359295},     // 57.92.96.0/20
-	{973996032, 974127103},     // 58.14.0.0/15
-	{974127104, 974651391},     // 58.16.0.0/13
-	{974651392, 974782463},     // 58.24.0.0/15
-	{975044608, 975175679},     // 58.30.0.0/15
-	{975175680, 977272831},     // 58.32.0.0/11
-	{977397760, 977399807},     // 58.65.232.0/21
-	{977403904, 977534975},     // 58.66.0.0/15
-	{977567744, 977575935},     // 58.68.128.0/19
-	{977575936, 977576447},     // 58.68.160.0/23
-	{977576704, 977576959},     // 58.68.163.0/24
-	{977576960, 977577983},     // 58.68.164.0/22
-	{977580800, 977581055},     // 58.68.179.0/24
-	{977581056, 977581311},     // 58.68.180.0/24
-	{977586176, 977588223},     // 58.68.200.0/21
-	{977588224, 977592319},     // 58.68.208.0/20
-	{977592320, 977600511},     // 58.68.224.0/19
-	{978452480, 978485247},     // 58.82.0.0/17
-	{978518016, 978583551},     // 58.83.0.0/16
-	{978796544, 978812927},     // 58.87.64.0/18
-	{979599360, 979632127},     // 58.99.128.0/17
-	{979632128, 979763199},     // 58.100.0.0/15
-	{980680704, 980942847},     // 58.116.0.0/14
-	{981467136, 981991423},     // 58.128.0.0/13
-	{982515712, 982581247},     // 58.144.0.0/16
-	{983171072, 983302143},     // 58.154.0.0/15
-	{985661440, 987758591},     // 58.192.0.0/11
-	{988807168, 989855743},     // 58.240.0.0/12
-	{991952896, 994050047},     // 59.32.0.0/11
-	{994050048, 995098623},     // 59.64.0.0/12
-	{995098624, 995229695},     // 59.80.0.0/15
-	{995229696, 995295231},     // 59.82.0.0/16
-	{995295232, 995311615},     // 59.83.0.0/18
-	{995329024, 995330047},     // 59.83.132.0/22
-	{995330048, 995332095},     // 59.83.136.0/21
-	{995332096, 995336191},     // 59.83.144.0/20
-	{995336192, 995344383},     // 59.83.160.0/19
-	{995344384, 995352575},     // 59.83.192.0/19
-	{995352576, 995356671},     // 59.83.224.0/20
-	{995356672, 995358719},     // 59.83.240.0/21
-	{995358720, 995359743},     // 59.83.248.0/22
-	{995359744, 995360255},     // 59.83.252.0/23
-	{995360256, 995360511},     // 59.83.254.0/24
-	{996868096, 996933631},     // 59.107.0.0/16
-	{996933632, 997195775},     // 59.108.0.0/14
-	{999751680, 999784447},     // 59.151.0.0/17
-	{999821312, 999825407},     // 59.152.16.0/20
-	{999826432, 999827455},     // 59.152.36.0/22
-	{999833600, 999837695},     // 59.152.64.0/20
-	{999845888, 999847935},     // 59.152.112.0/21
-	{999883776, 999884799},     // 59.153.4.0/22
-	{999890944, 999891967},     // 59.153.32.0/22
-	{999899136, 999901183},     // 59.153.64.0/21
-	{999901184, 999902207},     // 59.153.72.0/22
-	{999906304, 999907327},     // 59.153.92.0/22
-	{999917568, 999918591},     // 59.153.136.0/22
-	{999921664, 999923711},     // 59.153.152.0/21
-	{999924736, 999925759},     // 59.153.164.0/22
-	{999925760, 999927807},     // 59.153.168.0/21
-	{999927808, 999931903},     // 59.153.176.0/20
-	{999931904, 999932927},     // 59.153.192.0/22
-	{1000013824, 1000079359},     // 59.155.0.0/16
-	{1001127936, 1001390079},     // 59.172.0.0/14
-	{1002373120, 1002405887},     // 59.191.0.0/17
-	{1002438656, 1006632959},     // 59.192.0.0/10
-	{1006632960, 1008730111},     // 60.0.0.0/11
-	{1010237440, 1010302975},     // 60.55.0.0/16
-	{1010761728, 1010827263},     // 60.63.0.0/16
-	{1017118720, 1019215871},     // 60.160.0.0/11
-	{1019346944, 1019478015},     // 60.194.0.0/15
-	{1019740160, 1020264447},     // 60.200.0.0/13
-	{1020264448, 1021313023},     // 60.208.0.0/12
-	{1021837312, 1021968383},     // 60.232.0.0/15
-	{1022033920, 1022099455},     // 60.235.0.0/16
-	{1022722048, 1022754815},     // 60.245.128.0/17
-	{1022820352, 1022885887},     // 60.247.0.0/16
-	{1023148032, 1023213567},     // 60.252.0.0/16
-	{1023246336, 1023279103},     // 60.253.128.0/17
-	{1023344640, 1023410175},     // 60.255.0.0/16
-	{1023693056, 1023693311},     // 61.4.81.0/24
-	{1023693312, 1023693823},     // 61.4.82.0/23
-	{1023693824, 1023694847},     // 61.4.84.0/22
-	{1023694848, 1023696895},     // 61.4.88.0/21
-	{1023717376, 1023721471},     // 61.4.176.0/20
-	{1023975424, 1023979519},     // 61.8.160.0/20
-	{1024381952, 1024382975},     // 61.14.212.0/22
-	{1024382976, 1024385023},     // 61.14.216.0/21
-	{1024389120, 1024391167},     // 61.14.240.0/21
-	{1025245184, 1025277951},     // 61.28.0.0/17
-	{1025343488, 1025359871},     // 61.29.128.0/18
-	{1025359872, 1025368063},     // 61.29.192.0/19
-	{1025368064, 1025372159},     // 61.29.224.0/20
-	{1026392064, 1026408447},     // 61.45.128.0/18
-	{1026416640, 1026420735},     // 61.45.224.0/20
-	{1026523136, 1026539519},     // 61.47.128.0/18
-	{1026555904, 1027080191},     // 61.48.0.0/13
-	{1029160960, 1029177343},     // 61.87.192.0/18
-	{1031798784, 1035993087},     // 61.128.0.0/10
-	{1038614528, 1038876671},     // 61.232.0.0/14
-	{1038876672, 1039007743},     // 61.236.0.0/15
-	{1039138816, 1039400959},     // 61.240.0.0/14
-	{1055522816, 1055588351},     // 62.234.0.0/16
-	{1086072320, 1086072831},     // 64.188.38.0/23
-	{1086072832, 1086073855},     // 64.188.40.0/22
-	{1086073856, 1086074367},     // 64.188.44.0/23
-	{1125208949, 1125208949},     // 67.17.83.117/32
-	{1146028032, 1146044415},     // 68.79.0.0/18
-	{1172750336, 1172766719},     // 69.230.192.0/18
-	{1172799488, 1172815871},     // 69.231.128.0/18
-	{1173012480, 1173028863},     // 69.234.192.0/18
-	{1173061632, 1173078015},     // 69.235.128.0/18
-	{1199816704, 1199833087},     // 71.131.192.0/18
-	{1199833088, 1199849471},     // 71.132.0.0/18
-	{1200111616, 1200127999},     // 71.136.64.0/18
-	{1200160768, 1200177151},     // 71.137.0.0/18
-	{1218703360, 1218703871},     // 72.163.240.0/23
-	{1218705408, 1218706431},     // 72.163.248.0/22
-	{1363410944, 1363673087},     // 81.68.0.0/14
-	{1385955328, 1386086399},     // 82.156.0.0/15
-	{1476316928, 1476317183},     // 87.254.207.0/24
-	{1542071296, 1542071551},     // 91.234.36.0/24
-	{1572277760, 1572278015},     // 93.183.14.0/24
-	{1572278784, 1572279039},     // 93.183.18.0/24
-	{1589575680, 1589608447},     // 94.191.0.0/17
-	{1694498816, 1694499839},     // 101.0.0.0/22
-	{1694564352, 1694565375},     // 101.1.0.0/22
-	{1694673920, 1694674943},     // 101.2.172.0/22
-	{1694760960, 1695023103},     // 101.4.0.0/14
-	{1695547392, 1696595967},     // 101.16.0.0/12
-	{1696663808, 1696664063},     // 101.33.9.0/24
-	{1696665600, 1696665855},     // 101.33.16.0/24
-	{1696666624, 1696667647},     // 101.33.20.0/22
-	{1696667648, 1696668159},     // 101.33.24.0/23
-	{1696694272, 1696727039},     // 101.33.128.0/17
-	{1696727040, 1696858111},     // 101.34.0.0/15
-	{1696858112, 1696874495},     // 101.36.0.0/18
-	{1696874496, 1696878591},     // 101.36.64.0/20
-	{1696880640, 1696882687},     // 101.36.88.0/21
-	{1696890880, 1696923647},     // 101.36.128.0/17
-	{1696923648, 1696989183},     // 101.37.0.0/16
-	{1696989184, 1697120255},     // 101.38.0.0/15
-	{1697120256, 1697644543},     // 101.40.0.0/13
-	{1697644544, 1697775615},     // 101.48.0.0/15
-	{1697777664, 1697779711},     // 101.50.8.0/21
-	{1697789952, 1697790975},     // 101.50.56.0/22
-	{1697906688, 1697972223},     // 101.52.0.0/16
-	{1697997824, 1697998847},     // 101.53.100.0/22
-	{1698037760, 1698103295},     // 101.54.0.0/16
-	{1698160640, 1698162687},     // 101.55.224.0/21
-	{1698693120, 1699217407},     // 101.64.0.0/13
-	{1699217408, 1699479551},     // 101.72.0.0/14
-	{1699479552, 1699610623},     // 101.76.0.0/15
-	{1699610624, 1699611647},     // 101.78.0.0/22
-	{1699618816, 1699627007},     // 101.78.32.0/19
-	{1699741696, 1700790271},     // 101.80.0.0/12
-	{1700790272, 1700792319},     // 101.96.0.0/21
-	{1700792320, 1700793343},     // 101.96.8.0/22
-	{1700794368, 1700798463},     // 101.96.16.0/20
-	{1700823040, 1700855807},     // 101.96.128.0/17
-	{1701011456, 1701019647},     // 101.99.96.0/19
-	{1701134336, 1701142527},     // 101.101.64.0/19
-	{1701143552, 1701143807},     // 101.101.100.0/24
-	{1701144064, 1701144575},     // 101.101.102.0/23
-	{1701144576, 1701146623},     // 101.101.104.0/21
-	{1701146624, 1701150719},     // 101.101.112.0/20
-	{1701199872, 1701208063},     // 101.102.64.0/19
-	{1701209088, 1701209599},     // 101.102.100.0/23
-	{1701209600, 1701209855},     // 101.102.102.0/24
-	{1701210112, 1701212159},     // 101.102.104.0/21
-	{1701212160, 1701216255},     // 101.102.112.0/20
-	{1701314560, 1701576703},     // 101.104.0.0/14
-	{1701724160, 1701732351},     // 101.110.64.0/19
-	{1701732352, 1701736447},     // 101.110.96.0/20
-	{1701737472, 1701738495},     // 101.110.116.0/22
-	{1701738496, 1701740543},     // 101.110.120.0/21
-	{1702363136, 1702625279},     // 101.120.0.0/14
-	{1702625280, 1702756351},     // 101.124.0.0/15
-	{1702756352, 1702821887},     // 101.126.0.0/16
-	{1702887424, 1702888447},     // 101.128.0.0/22
-	{1702889472, 1702891519},     // 101.128.8.0/21
-	{1702891520, 1702895615},     // 101.128.16.0/20
-	{1702895616, 1702903807},     // 101.128.32.0/19
-	{1702952960, 1703018495},     // 101.129.0.0/16
-	{1703018496, 1703149567},     // 101.130.0.0/15
-	{1703149568, 1703280639},     // 101.132.0.0/15
-	{1703280640, 1703313407},     // 101.134.0.0/17
-	{1703313408, 1703321599},     // 101.134.128.0/19
-	{1703321600, 1703325695},     // 101.134.160.0/20
-	{1703325696, 1703327743},     // 101.134.176.0/21
-	{1703327744, 1703328767},     // 101.134.184.0/22
-	{1703329024, 1703329279},     // 101.134.189.0/24
-	{1703329280, 1703329791},     // 101.134.190.0/23
-	{1703329792, 1703346175},     // 101.134.192.0/18
-	{1703346176, 1703411711},     // 101.135.0.0/16
-	{1703936000, 1704984575},     // 101.144.0.0/12
-	{1707081728, 1707343871},     // 101.192.0.0/14
-	{1707343872, 1707409407},     // 101.196.0.0/16
-	{1707507712, 1707507967},     // 101.198.128.0/24
-	{1707518464, 1707518975},     // 101.198.170.0/23
-	{1707518976, 1707519999},     // 101.198.172.0/22
-	{1707520000, 1707522047},     // 101.198.176.0/21
-	{1707522048, 1707523071},     // 101.198.184.0/22
-	{1707523328, 1707523583},     // 101.198.189.0/24
-	{1707523584, 1707524095},     // 101.198.190.0/23
-	{1707524608, 1707524863},     // 101.198.194.0/24
-	{1707525120, 1707525631},     // 101.198.196.0/23
-	{1707526144, 1707527167},     // 101.198.200.0/22
-	{1707532288, 1707540479},     // 101.198.224.0/19
-	{1707540480, 1707548671},     // 101.199.0.0/19
-	{1707552768, 1707556863},     // 101.199.48.0/20
-	{1707556864, 1707573247},     // 101.199.64.0/18
-	{1707573248, 1707606015},     // 101.199.128.0/17
-	{1707606016, 1707737087},     // 101.200.0.0/15
-	{1707835392, 1707843583},     // 101.203.128.0/19
-	{1707843584, 1707845631},     // 101.203.160.0/21
-	{1707846656, 1707847679},     // 101.203.172.0/22
-	{1707847680, 1707851775},     // 101.203.176.0/20
-	{1707868160, 1708130303},     // 101.204.0.0/14
-	{1709178880, 1709703167},     // 101.224.0.0/13
-	{1709703168, 1709834239},     // 101.232.0.0/15
-	{1709850624, 1709852671},     // 101.234.64.0/21
-	{1709853696, 1709854719},     // 101.234.76.0/22
-	{1709854720, 1709858815},     // 101.234.80.0/20
-	{1709858816, 1709867007},     // 101.234.96.0/19
-	{1709965312, 1710227455},     // 101.236.0.0/14
-	{1710227456, 1710751743},     // 101.240.0.0/13
-	{1710751744, 1710882815},     // 101.248.0.0/15
-	{1710948352, 1710949375},     // 101.251.0.0/22
-	{1710950400, 1710952447},     // 101.251.8.0/21
-	{1710952448, 1710956543},     // 101.251.16.0/20
-	{1710956544, 1710964735},     // 101.251.32.0/19
-	{1710964736, 1710981119},     // 101.251.64.0/18
-	{1710981120, 1711013887},     // 101.251.128.0/17
-	{1711013888, 1711144959},     // 101.252.0.0/15
-	{1711144960, 1711210495},     // 101.254.0.0/16
-	{1722843648, 1722843903},     // 102.176.130.0/24
-	{1728120832, 1728121855},     // 103.1.8.0/22
-	{1728123904, 1728124927},     // 103.1.20.0/22
-	{1728124928, 1728125951},     // 103.1.24.0/22
-	{1728141312, 1728142335},     // 103.1.88.0/22
-	{1728161792, 1728162815},     // 103.1.168.0/22
-	{1728211968, 1728212991},     // 103.2.108.0/22
-	{1728224256, 1728225279},     // 103.2.156.0/22
-	{1728226304, 1728227327},     // 103.2.164.0/22
-	{1728235520, 1728237567},     // 103.2.200.0/21
-	{1728237568, 1728239615},     // 103.2.208.0/21
-	{1728271360, 1728272383},     // 103.3.84.0/22
-	{1728272384, 1728274431},     // 103.3.88.0/21
-	{1728274432, 1728282623},     // 103.3.96.0/19
-	{1728282624, 1728286719},     // 103.3.128.0/20
-	{1728287744, 1728288767},     // 103.3.148.0/22
-	{1728288768, 1728290815},     // 103.3.152.0/21
-	{1728329728, 1728330751},     // 103.4.56.0/22
-	{1728358400, 1728359423},     // 103.4.168.0/22
-	{1728362496, 1728363519},     // 103.4.184.0/22
-	{1728390144, 1728391167},     // 103.5.36.0/22
-	{1728394240, 1728394495},     // 103.5.52.0/24
-	{1728395264, 1728396287},     // 103.5.56.0/22
-	{1728419840, 1728420863},     // 103.5.152.0/22
-	{1728423936, 1728424959},     // 103.5.168.0/22
-	{1728430080, 1728431103},     // 103.5.192.0/22
-	{1728445440, 1728446463},     // 103.5.252.0/22
-	{1728465920, 1728466943},     // 103.6.76.0/22
-	{1728477184, 1728478207},     // 103.6.120.0/22
-	{1728502784, 1728503807},     // 103.6.220.0/22
-	{1728547840, 1728548863},     // 103.7.140.0/22
-	{1728566272, 1728567295},     // 103.7.212.0/22
-	{1728567296, 1728569343},     // 103.7.216.0/21
-	{1728577536, 1728579583},     // 103.8.0.0/21
-	{1728579584, 1728580607},     // 103.8.8.0/22
-	{1728585728, 1728586751},     // 103.8.32.0/22
-	{1728590848, 1728591871},     // 103.8.52.0/22
-	{1728594944, 1728595967},     // 103.8.68.0/22
-	{1728605184, 1728606207},     // 103.8.108.0/22
-	{1728617472, 1728618495},     // 103.8.156.0/22
-	{1728628736, 1728630783},     // 103.8.200.0/21
-	{1728633856, 1728634879},     // 103.8.220.0/22
-	{1728645120, 1728646143},     // 103.9.8.0/22
-	{1728649216, 1728650239},     // 103.9.24.0/22
-	{1728670720, 1728671743},     // 103.9.108.0/22
-	{1728681984, 1728683007},     // 103.9.152.0/22
-	{1728706560, 1728708607},     // 103.9.248.0/21
-	{1728708608, 1728709631},     // 103.10.0.0/22
-	{1728712704, 1728713727},     // 103.10.16.0/22
-	{1728730112, 1728731135},     // 103.10.84.0/22
-	{1728737024, 1728737279},     // 103.10.111.0/24
-	{1728744448, 1728745471},     // 103.10.140.0/22
-	{1728778240, 1728779263},     // 103.11.16.0/22
-	{1728817152, 1728818175},     // 103.11.168.0/22
-	{1728820224, 1728821247},     // 103.11.180.0/22
-	{1728847872, 1728848895},     // 103.12.32.0/22
-	{1728874496, 1728875519},     // 103.12.136.0/22
-	{1728886784, 1728887807},     // 103.12.184.0/22
-	{1728899072, 1728900095},     // 103.12.232.0/22
-	{1728908288, 1728909311},     // 103.13.12.0/22
-	{1728936960, 1728937983},     // 103.13.124.0/22
-	{1728942336, 1728942591},     // 103.13.145.0/24
-	{1728942848, 1728943103},     // 103.13.147.0/24
-	{1728955392, 1728956415},     // 103.13.196.0/22
-	{1728967680, 1728968703},     // 103.13.244.0/22
-	{1728992256, 1728993279},     // 103.14.84.0/22
-	{1729004544, 1729005567},     // 103.14.132.0/22
-	{1729005568, 1729006591},     // 103.14.136.0/22
-	{1729010688, 1729011711},     // 103.14.156.0/22
-	{1729032192, 1729033215},     // 103.14.240.0/22
-	{1729037312, 1729038335},     // 103.15.4.0/22
-	{1729038336, 1729039359},     // 103.15.8.0/22
-	{1729040384, 1729041407},     // 103.15.16.0/22
-	{1729060864, 1729061887},     // 103.15.96.0/22
-	{1729087488, 1729088511},     // 103.15.200.0/22
-	{1729115136, 1729116159},     // 103.16.52.0/22
-	{1729122304, 1729124351},     // 103.16.80.0/21
-	{1729124352, 1729125375},     // 103.16.88.0/22
-	{1729129472, 1729130495},     // 103.16.108.0/22
-	{1729133568, 1729134591},     // 103.16.124.0/22
-	{1729177600, 1729178623},     // 103.17.40.0/22
-	{1729183744, 1729184767},     // 103.17.64.0/22
-	{1729198080, 1729198591},     // 103.17.120.0/23
-	{1729202176, 1729203199},     // 103.17.136.0/22
-	{1729208320, 1729209343},     // 103.17.160.0/22
-	{1729219584, 1729220607},     // 103.17.204.0/22
-	{1729225728, 1729226751},     // 103.17.228.0/22
-	{1729282048, 1729283071},     // 103.18.192.0/22
-	{1729286144, 1729288191},     // 103.18.208.0/21
-	{1729290240, 1729291263},     // 103.18.224.0/22
-	{1729301504, 1729302527},     // 103.19.12.0/22
-	{1729308672, 1729310719},     // 103.19.40.0/21
-	{1729314816, 1729316863},     // 103.19.64.0/21
-	{1729316864, 1729317887},     // 103.19.72.0/22
-	{1729357824, 1729358847},     // 103.19.232.0/22
-	{1729367040, 1729368063},     // 103.20.12.0/22
-	{1729372160, 1729372671},     // 103.20.32.0/23
-	{1729372672, 1729372927},     // 103.20.34.0/24
-	{1729381376, 1729382399},     // 103.20.68.0/22
-	{1729392640, 1729393663},     // 103.20.112.0/22
-	{1729396736, 1729397759},     // 103.20.128.0/22
-	{1729404928, 1729405951},     // 103.20.160.0/22
-	{1729427456, 1729428479},     // 103.20.248.0/22
-	{1729458176, 1729460223},     // 103.21.112.0/21
-	{1729465344, 1729466367},     // 103.21.140.0/22
-	{1729474560, 1729475583},     // 103.21.176.0/22
-	{1729490944, 1729491967},     // 103.21.240.0/22
-	{1729495040, 1729511423},     // 103.22.0.0/18
-	{1729511424, 1729519615},     // 103.22.64.0/19
-	{1729520640, 1729521663},     // 103.22.100.0/22
-	{1729521664, 1729523711},     // 103.22.104.0/21
-	{1729523712, 1729527807},     // 103.22.112.0/20
-	{1729543168, 1729544191},     // 103.22.188.0/22
-	{1729553408, 1729554431},     // 103.22.228.0/22
-	{1729559552, 1729560575},     // 103.22.252.0/22
-	{1729562624, 1729563647},     // 103.23.8.0/22
-	{1729574912, 1729575935},     // 103.23.56.0/22
-	{1729601536, 1729603583},     // 103.23.160.0/21
-	{1729605632, 1729606655},     // 103.23.176.0/22
-	{1729618944, 1729619967},     // 103.23.228.0/22
-	{1729632256, 1729633279},     // 103.24.24.0/22
-	{1729655808, 1729656831},     // 103.24.116.0/22
-	{1729658880, 1729659903},     // 103.24.128.0/22
-	{1729662976, 1729663999},     // 103.24.144.0/22
-	{1729671168, 1729672191},     // 103.24.176.0/22
-	{1729673216, 1729674239},     // 103.24.184.0/22
-	{1729684480, 1729685503},     // 103.24.228.0/22
-	{1729690624, 1729691647},     // 103.24.252.0/22
-	{1729696768, 1729697791},     // 103.25.20.0/22
-	{1729697792, 1729699839},     // 103.25.24.0/21
-	{1729699840, 1729701887},     // 103.25.32.0/21
-	{1729701888, 1729702911},     // 103.25.40.0/22
-	{1729703936, 1729704959},     // 103.25.48.0/22
-	{1729708032, 1729710079},     // 103.25.64.0/21
-	{1729729536, 1729730559},     // 103.25.148.0/22
-	{1729731584, 1729732607},     // 103.25.156.0/22
-	{1729746944, 1729747967},     // 103.25.216.0/22
-	{1729757184, 1729758207},     // 103.26.0.0/22
-	{1729773568, 1729774591},     // 103.26.64.0/22
-	{1729776640, 1729777663},     // 103.26.76.0/22
-	{1729786880, 1729787903},     // 103.26.116.0/22
-	{1729797120, 1729798143},     // 103.26.156.0/22
-	{1729798144, 1729799167},     // 103.26.160.0/22
-	{1729815552, 1729816575},     // 103.26.228.0/22
-	{1729818624, 1729819647},     // 103.26.240.0/22
-	{1729823744, 1729824767},     // 103.27.4.0/22
-	{1729825792, 1729826815},     // 103.27.12.0/22
-	{1729828864, 1729829887},     // 103.27.24.0/22
-	{1729837056, 1729838079},     // 103.27.56.0/22
-	{1729847296, 1729848319},     // 103.27.96.0/22
-	{1729884160, 1729885183},     // 103.27.240.0/22
-	{1729889280, 1729890303},     // 103.28.4.0/22
-	{1729890304, 1729891327},     // 103.28.8.0/22
-	{1729935360, 1729936383},     // 103.28.184.0/22
-	{1729940480, 1729941503},     // 103.28.204.0/22
-	{1729942528, 1729943551},     // 103.28.212.0/22
-	{1729957888, 1729958911},     // 103.29.16.0/22
-	{1729986560, 1729988607},     // 103.29.128.0/21
-	{1729988608, 1729989631},     // 103.29.136.0/22
-	{1730024448, 1730025471},     // 103.30.20.0/22
-	{1730043904, 1730044927},     // 103.30.96.0/22
-	{1730057216, 1730058239},     // 103.30.148.0/22
-	{1730071040, 1730071551},     // 103.30.202.0/23
-	{1730077696, 1730078719},     // 103.30.228.0/22
-	{1730079744, 1730080767},     // 103.30.236.0/22
-	{1730084864, 1730085887},     // 103.31.0.0/22
-	{1730097152, 1730099199},     // 103.31.48.0/21
-	{1730100224, 1730101247},     // 103.31.60.0/22
-	{1730101248, 1730103295},     // 103.31.64.0/21
-	{1730103296, 1730103551},     // 103.31.72.0/24
-	{1730122752, 1730123775},     // 103.31.148.0/22
-	{1730125824, 1730126847},     // 103.31.160.0/22
-	{1730127872, 1730128895},     // 103.31.168.0/22
-	{1730136064, 1730137087},     // 103.31.200.0/22
-	{1730150400, 1730281471},     // 103.32.0.0/15
-	{1730281472, 1730347007},     // 103.34.0.0/16
-	{1730347008, 1730355199},     // 103.35.0.0/19
-	{1730355200, 1730359295},     // 103.35.32.0/20
-	{1730359296, 1730360319},     // 103.35.48.0/22
-	{1730373632, 1730374655},     // 103.35.104.0/22
-	{1730403328, 1730404351},     // 103.35.220.0/22
-	{1730419712, 1730420735},     // 103.36.28.0/22
-	{1730421760, 1730422783},     // 103.36.36.0/22
-	{1730426880, 1730428927},     // 103.36.56.0/21
-	{1730428928, 1730429951},     // 103.36.64.0/22
-	{1730430976, 1730431999},     // 103.36.72.0/22
-	{1730437120, 1730438143},     // 103.36.96.0/22
-	{1730446336, 1730447359},     // 103.36.132.0/22
-	{1730447360, 1730448383},     // 103.36.136.0/22
-	{1730453504, 1730461695},     // 103.36.160.0/19
-	{1730461696, 1730469887},     // 103.36.192.0/19
-	{1730469888, 1730473983},     // 103.36.224.0/20
-	{1730473984, 1730476031},     // 103.36.240.0/21
-	{1730481152, 1730482175},     // 103.37.12.0/22
-	{1730482176, 1730483199},     // 103.37.16.0/22
-	{1730484224, 1730485247},     // 103.37.24.0/22
-	{1730489344, 1730490367},     // 103.37.44.0/22
-	{1730491392, 1730492415},     // 103.37.52.0/22
-	{1730492416, 1730493439},     // 103.37.56.0/22
-	{1730496512, 1730497535},     // 103.37.72.0/22
-	{1730503680, 1730504703},     // 103.37.100.0/22
-	{1730504704, 1730505727},     // 103.37.104.0/22
-	{1730512896, 1730514943},     // 103.37.136.0/21
-	{1730514944, 1730519039},     // 103.37.144.0/20
-	{1730519040, 1730521087},     // 103.37.160.0/21
-	{1730522112, 1730523135},     // 103.37.172.0/22
-	{1730523136, 1730524159},     // 103.37.176.0/22
-	{1730526208, 1730527231},     // 103.37.188.0/22
-	{1730531328, 1730535423},     // 103.37.208.0/20
-	{1730542592, 1730543615},     // 103.37.252.0/22
-	{1730543616, 1730544639},     // 103.38.0.0/22
-	{1730551808, 1730552831},     // 103.38.32.0/22
-	{1730553856, 1730555903},     // 103.38.40.0/21
-	{1730563072, 1730564095},     // 103.38.76.0/22
-	{1730565120, 1730566143},     // 103.38.84.0/22
-	{1730567168, 1730568191},     // 103.38.92.0/22
-	{1730568192, 1730569215},     // 103.38.96.0/22
-	{1730573312, 1730574335},     // 103.38.116.0/22
-	{1730577408, 1730578431},     // 103.38.132.0/22
-	{1730579456, 1730580479},     // 103.38.140.0/22
-	{1730599936, 1730600959},     // 103.38.220.0/22
-	{1730600960, 1730603007},     // 103.38.224.0/21
-	{1730603008, 1730604031},     // 103.38.232.0/22
-	{1730608128, 1730608639},     // 103.38.252.0/23
-	{1730625536, 1730626559},     // 103.39.64.0/22
-	{1730631680, 1730632703},     // 103.39.88.0/22
-	{1730634752, 1730635775},     // 103.39.100.0/22
-	{1730635776, 1730636799},     // 103.39.104.0/22
-	{1730650112, 1730658303},     // 103.39.160.0/19
-	{1730660352, 1730662399},     // 103.39.200.0/21
-	{1730662400, 1730666495},     // 103.39.208.0/20
-	{1730666496, 1730668543},     // 103.39.224.0/21
-	{1730668544, 1730669567},     // 103.39.232.0/22
-	{1730677760, 1730678783},     // 103.40.12.0/22
-	{1730678784, 1730682879},     // 103.40.16.0/20
-	{1730682880, 1730686975},     // 103.40.32.0/20
-	{1730697216, 1730698239},     // 103.40.88.0/22
-	{1730723840, 1730724863},     // 103.40.192.0/22
-	{1730728960, 1730729983},     // 103.40.212.0/22
-	{1730731008, 1730732031},     // 103.40.220.0/22
-	{1730733056, 1730734079},     // 103.40.228.0/22
-	{1730734080, 1730736127},     // 103.40.232.0/21
-	{1730736128, 1730740223},     // 103.40.240.0/20
-	{1730740224, 1730741247},     // 103.41.0.0/22
-	{1730753536, 1730754559},     // 103.41.52.0/22
-	{1730776064, 1730777087},     // 103.41.140.0/22
-	{1730778112, 1730779135},     // 103.41.148.0/22
-	{1730779136, 1730780159},     // 103.41.152.0/22
-	{1730781184, 1730783231},     // 103.41.160.0/21
-	{1730787328, 1730787583},     // 103.41.184.0/24
-	{1730796544, 1730797567},     // 103.41.220.0/22
-	{1730797568, 1730799615},     // 103.41.224.0/21
-	{1730799616, 1730800639},     // 103.41.232.0/22
-	{1730807808, 1730808831},     // 103.42.8.0/22
-	{1730811904, 1730812927},     // 103.42.24.0/22
-	{1730813952, 1730814975},     // 103.42.32.0/22
-	{1730822144, 1730824191},     // 103.42.64.0/21
-	{1730825216, 1730826239},     // 103.42.76.0/22
-	{1730865152, 1730866175},     // 103.42.232.0/22
-	{1730877952, 1730878463},     // 103.43.26.0/23
-	{1730895872, 1730897919},     // 103.43.96.0/21
-	{1730897920, 1730898943},     // 103.43.104.0/22
-	{1730903040, 1730904063},     // 103.43.124.0/22
-	{1730918400, 1730919423},     // 103.43.184.0/22
-	{1730920448, 1730922495},     // 103.43.192.0/21
-	{1730924544, 1730925567},     // 103.43.208.0/22
-	{1730927616, 1730928639},     // 103.43.220.0/22
-	{1730928640, 1730929663},     // 103.43.224.0/22
-	{1730932736, 1730933759},     // 103.43.240.0/22
-	{1730951680, 1730952191},     // 103.44.58.0/23
-	{1730957312, 1730958335},     // 103.44.80.0/22
-	{1730967552, 1730969599},     // 103.44.120.0/21
-	{1730973696, 1730974719},     // 103.44.144.0/22
-	{1730975744, 1730976767},     // 103.44.152.0/22
-	{1730979840, 1730980863},     // 103.44.168.0/22
-	{1730981888, 1730985983},     // 103.44.176.0/20
-	{1730985984, 1730990079},     // 103.44.192.0/20
-	{1730994176, 1730995199},     // 103.44.224.0/22
-	{1730997248, 1730998271},     // 103.44.236.0/22
-	{1730998272, 1731002367},     // 103.44.240.0/20
-	{1731002368, 1731018751},     // 103.45.0.0/18
-	{1731020800, 1731022847},     // 103.45.72.0/21
-	{1731022848, 1731026943},     // 103.45.80.0/20
-	{1731026944, 1731035135},     // 103.45.96.0/19
-	{1731035136, 1731051519},     // 103.45.128.0/18
-	{1731051520, 1731059711},     // 103.45.192.0/19
-	{1731059712, 1731060735},     // 103.45.224.0/22
-	{1731065856, 1731066879},     // 103.45.248.0/22
-	{1731067904, 1731068927},     // 103.46.0.0/22
-	{1731070976, 1731071999},     // 103.46.12.0/22
-	{1731072000, 1731076095},     // 103.46.16.0/20
-	{1731076096, 1731084287},     // 103.46.32.0/19
-	{1731084288, 1731100671},     // 103.46.64.0/18
-	{1731100672, 1731102719},     // 103.46.128.0/21
-	{1731102720, 1731103743},     // 103.46.136.0/22
-	{1731106816, 1731108863},     // 103.46.152.0/21
-	{1731108864, 1731112959},     // 103.46.160.0/20
-	{1731112960, 1731115007},     // 103.46.176.0/21
-	{1731130368, 1731131391},     // 103.46.244.0/22
-	{1731131392, 1731132415},     // 103.46.248.0/22
-	{1731134464, 1731135487},     // 103.47.4.0/22
-	{1731138560, 1731139583},     // 103.47.20.0/22
-	{1731142656, 1731143679},     // 103.47.36.0/22
-	{1731143680, 1731144703},     // 103.47.40.0/22
-	{1731145728, 1731146751},     // 103.47.48.0/22
-	{1731153920, 1731154943},     // 103.47.80.0/22
-	{1731158016, 1731159039},     // 103.47.96.0/22
-	{1731163136, 1731164159},     // 103.47.116.0/22
-	{1731164160, 1731165183},     // 103.47.120.0/22
-	{1731168256, 1731170303},     // 103.47.136.0/21
-	{1731187712, 1731188735},     // 103.47.212.0/22
-	{1731212288, 1731213311},     // 103.48.52.0/22
-	{1731222528, 1731223551},     // 103.48.92.0/22
-	{1731236864, 1731237887},     // 103.48.148.0/22
-	{1731237888, 1731238911},     // 103.48.152.0/22
-	{1731250688, 1731251199},     // 103.48.202.0/23
-	{1731254272, 1731256319},     // 103.48.216.0/21
-	{1731256320, 1731260415},     // 103.48.224.0/20
-	{1731260416, 1731262463},     // 103.48.240.0/21
-	{1731267584, 1731268607},     // 103.49.12.0/22
-	{1731269632, 1731270655},     // 103.49.20.0/22
-	{1731282944, 1731284991},     // 103.49.72.0/21
-	{1731289088, 1731290111},     // 103.49.96.0/22
-	{1731292160, 1731293183},     // 103.49.108.0/22
-	{1731297280, 1731298303},     // 103.49.128.0/22
-	{1731309568, 1731311615},     // 103.49.176.0/21
-	{1731339264, 1731340287},     // 103.50.36.0/22
-	{1731341312, 1731342335},     // 103.50.44.0/22
-	{1731342336, 1731346431},     // 103.50.48.0/20
-	{1731346432, 1731348479},     // 103.50.64.0/21
-	{1731348480, 1731349503},     // 103.50.72.0/22
-	{1731357696, 1731358719},     // 103.50.108.0/22
-	{1731358720, 1731362815},     // 103.50.112.0/20
-	{1731363840, 1731364863},     // 103.50.132.0/22
-	{1731364864, 1731366911},     // 103.50.136.0/21
-	{1731374080, 1731375103},     // 103.50.172.0/22
-	{1731375104, 1731379199},     // 103.50.176.0/20
-	{1731379200, 1731381247},     // 103.50.192.0/21
-	{1731381248, 1731382271},     // 103.50.200.0/22
-	{1731386368, 1731387391},     // 103.50.220.0/22
-	{1731387392, 1731391487},     // 103.50.224.0/20
-	{1731391488, 1731393535},     // 103.50.240.0/21
-	{1731393536, 1731394559},     // 103.50.248.0/22
-	{1731471360, 1731472383},     // 103.52.40.0/22
-	{1731479552, 1731481599},     // 103.52.72.0/21
-	{1731481600, 1731483647},     // 103.52.80.0/21
-	{1731485696, 1731487743},     // 103.52.96.0/21
-	{1731487744, 1731488767},     // 103.52.104.0/22
-	{1731502080, 1731504127},     // 103.52.160.0/21
-	{1731505152, 1731506175},     // 103.52.172.0/22
-	{1731506176, 1731507199},     // 103.52.176.0/22
-	{1731508224, 1731509247},     // 103.52.184.0/22
-	{1731511296, 1731512319},     // 103.52.196.0/22
-	{1731543040, 1731545087},     // 103.53.64.0/21
-	{1731550208, 1731551231},     // 103.53.92.0/22
-	{1731558400, 1731559423},     // 103.53.124.0/22
-	{1731559424, 1731563519},     // 103.53.128.0/20
-	{1731563520, 1731564543},     // 103.53.144.0/22
-	{1731567616, 1731568639},     // 103.53.160.0/22
-	{1731572736, 1731573759},     // 103.53.180.0/22
-	{1731578880, 1731579903},     // 103.53.204.0/22
-	{1731579904, 1731581951},     // 103.53.208.0/21
-	{1731587072, 1731588095},     // 103.53.236.0/22
-	{1731590144, 1731591167},     // 103.53.248.0/22
-	{1731594240, 1731595263},     // 103.54.8.0/22
-	{1731604480, 1731605503},     // 103.54.48.0/22
-	{1731633152, 1731635199},     // 103.54.160.0/21
-	{1731646464, 1731647487},     // 103.54.212.0/22
-	{1731650560, 1731651583},     // 103.54.228.0/22
-	{1731653632, 1731654655},     // 103.54.240.0/22
-	{1731678208, 1731679231},     // 103.55.80.0/22
-	{1731688448, 1731689471},     // 103.55.120.0/22
-	{1731696640, 1731697663},     // 103.55.152.0/22
-	{1731701760, 1731702783},     // 103.55.172.0/22
-	{1731709952, 1731710975},     // 103.55.204.0/22
-	{1731710976, 1731711999},     // 103.55.208.0/22
-	{1731716096, 1731717119},     // 103.55.228.0/22
-	{1731718144, 1731719167},     // 103.55.236.0/22
-	{1731719168, 1731720191},     // 103.55.240.0/22
-	{1731728384, 1731729407},     // 103.56.20.0/22
-	{1731731456, 1731732479},     // 103.56.32.0/22
-	{1731737600, 1731739647},     // 103.56.56.0/21
-	{1731741696, 1731743743},     // 103.56.72.0/21
-	{1731759104, 1731760127},     // 103.56.140.0/22
-	{1731762176, 1731763199},     // 103.56.152.0/22
-	{1731770368, 1731771391},     // 103.56.184.0/22
-	{1731774464, 1731775487},     // 103.56.200.0/22
-	{1731791872, 1731792895},     // 103.57.12.0/22
-	{1731802112, 1731803135},     // 103.57.52.0/22
-	{1731803136, 1731804159},     // 103.57.56.0/22
-	{1731808256, 1731809279},     // 103.57.76.0/22
-	{1731823616, 1731824639},     // 103.57.136.0/22
-	{1731838976, 1731839999},     // 103.57.196.0/22
-	{1731860480, 1731861503},     // 103.58.24.0/22
-	{1731939328, 1731940351},     // 103.59.76.0/22
-	{1731948544, 1731950591},     // 103.59.112.0/21
-	{1731950592, 1731950847},     // 103.59.120.0/24
-	{1731951360, 1731951615},     // 103.59.123.0/24
-	{1731951616, 1731952639},     // 103.59.124.0/22
-	{1731952640, 1731953663},     // 103.59.128.0/22
-	{1731957760, 1731958783},     // 103.59.148.0/22
-	{1731993600, 1731994623},     // 103.60.32.0/22
-	{1731996672, 1731997695},     // 103.60.44.0/22
-	{1732027392, 1732028415},     // 103.60.164.0/22
-	{1732043776, 1732044799},     // 103.60.228.0/22
-	{1732045824, 1732046847},     // 103.60.236.0/22
-	{1732066304, 1732066559},     // 103.61.60.0/24
-	{1732077568, 1732078591},     // 103.61.104.0/22
-	{1732086784, 1732087807},     // 103.61.140.0/22
-	{1732089856, 1732091903},     // 103.61.152.0/21
-	{1732091904, 1732092927},     // 103.61.160.0/22
-	{1732094976, 1732095999},     // 103.61.172.0/22
-	{1732096000, 1732097023},     // 103.61.176.0/22
-	{1732122624, 1732123647},     // 103.62.24.0/22
-	{1732134912, 1732136959},     // 103.62.72.0/21
-	{1732136960, 1732139007},     // 103.62.80.0/21
-	{1732139008, 1732140031},     // 103.62.88.0/22
-	{1732141056, 1732149247},     // 103.62.96.0/19
-	{1732149248, 1732151295},     // 103.62.128.0/21
-	{1732156416, 1732157439},     // 103.62.156.0/22
-	{1732157440, 1732165631},     // 103.62.160.0/19
-	{1732165632, 1732166655},     // 103.62.192.0/22
-	{1732168704, 1732169727},     // 103.62.204.0/22
-	{1732169728, 1732173823},     // 103.62.208.0/20
-	{1732173824, 1732174847},     // 103.62.224.0/22
-	{1732190208, 1732198399},     // 103.63.32.0/19
-	{1732198400, 1732202495},     // 103.63.64.0/20
-	{1732202496, 1732204543},     // 103.63.80.0/21
-	{1732204544, 1732205567},     // 103.63.88.0/22
-	{1732217856, 1732218879},     // 103.63.140.0/22
-	{1732218880, 1732219903},     // 103.63.144.0/22
-	{1732220928, 1732221951},     // 103.63.152.0/22
-	{1732222976, 1732227071},     // 103.63.160.0/20
-	{1732227072, 1732229119},     // 103.63.176.0/21
-	{1732229120, 1732230143},     // 103.63.184.0/22
-	{1732231168, 1732235263},     // 103.63.192.0/20
-	{1732235264, 1732236287},     // 103.63.208.0/22
-	{1732243456, 1732247551},     // 103.63.240.0/20
-	{1732247552, 1732249599},     // 103.64.0.0/21
-	{1732253696, 1732255743},     // 103.64.24.0/21
-	{1732255744, 1732263935},     // 103.64.32.0/19
-	{1732263936, 1732280319},     // 103.64.64.0/18
-	{1732283392, 1732284415},     // 103.64.140.0/22
-	{1732284416, 1732285439},     // 103.64.144.0/22
-	{1732286464, 1732288511},     // 103.64.152.0/21
-	{1732288512, 1732296703},     // 103.64.160.0/19
-	{1732296704, 1732313087},     // 103.64.192.0/18
-	{1732313088, 1732317183},     // 103.65.0.0/20
-	{1732317184, 1732318207},     // 103.65.16.0/22
-	{1732325376, 1732329471},     // 103.65.48.0/20
-	{1732329472, 1732337663},     // 103.65.64.0/19
-	{1732338688, 1732339711},     // 103.65.100.0/22
-	{1732339712, 1732341759},     // 103.65.104.0/21
-	{1732341760, 1732345855},     // 103.65.112.0/20
-	{1732345856, 1732347903},     // 103.65.128.0/21
-	{1732347904, 1732348927},     // 103.65.136.0/22
-	{1732349952, 1732354047},     // 103.65.144.0/20
-	{1732354048, 1732358143},     // 103.65.160.0/20
-	{1732386816, 1732387839},     // 103.66.32.0/22
-	{1732388864, 1732389887},     // 103.66.40.0/22
-	{1732406272, 1732407295},     // 103.66.108.0/22
-	{1732429824, 1732430847},     // 103.66.200.0/22
-	{1732440064, 1732444159},     // 103.66.240.0/20
-	{1732444160, 1732446207},     // 103.67.0.0/21
-	{1732446208, 1732447231},     // 103.67.8.0/22
-	{1732454400, 1732456447},     // 103.67.40.0/21
-	{1732456448, 1732460543},     // 103.67.48.0/20
-	{1732460544, 1732476927},     // 103.67.64.0/18
-	{1732476928, 1732481023},     // 103.67.128.0/20
-	{1732481024, 1732483071},     // 103.67.144.0/21
-	{1732488192, 1732488447},     // 103.67.172.0/24
-	{1732488960, 1732489215},     // 103.67.175.0/24
-	{1732493312, 1732494335},     // 103.67.192.0/22
-	{1732498432, 1732499455},     // 103.67.212.0/22
-	{1732532224, 1732533247},     // 103.68.88.0/22
-	{1732535296, 1732536319},     // 103.68.100.0/22
-	{1732542464, 1732543487},     // 103.68.128.0/22
-	{1732579328, 1732580351},     // 103.69.16.0/22
-	{1732642816, 1732643839},     // 103.70.8.0/22
-	{1732678656, 1732679679},     // 103.70.148.0/22
-	{1732701184, 1732702207},     // 103.70.236.0/22
-	{1732705280, 1732706303},     // 103.70.252.0/22
-	{1732706304, 1732707327},     // 103.71.0.0/22
-	{1732723712, 1732724735},     // 103.71.68.0/22
-	{1732724736, 1732725759},     // 103.71.72.0/22
-	{1732726784, 1732728831},     // 103.71.80.0/21
-	{1732728832, 1732729855},     // 103.71.88.0/22
-	{1732737024, 1732739071},     // 103.71.120.0/21
-	{1732739072, 1732740095},     // 103.71.128.0/22
-	{1732756480, 1732757503},     // 103.71.196.0/22
-	{1732757504, 1732758527},     // 103.71.200.0/22
-	{1732765696, 1732766719},     // 103.71.232.0/22
-	{1732774912, 1732775935},     // 103.72.12.0/22
-	{1732775936, 1732780031},     // 103.72.16.0/20
-	{1732780032, 1732784127},     // 103.72.32.0/20
-	{1732784128, 1732786175},     // 103.72.48.0/21
-	{1732800512, 1732802559},     // 103.72.112.0/21
-	{1732803584, 1732804607},     // 103.72.124.0/22
-	{1732804608, 1732806655},     // 103.72.128.0/21
-	{1732809984, 1732810239},     // 103.72.149.0/24
-	{1732810240, 1732810751},     // 103.72.150.0/23
-	{1732815872, 1732816895},     // 103.72.172.0/22
-	{1732817920, 1732818943},     // 103.72.180.0/22
-	{1732829184, 1732837375},     // 103.72.224.0/19
-	{1732837376, 1732845567},     // 103.73.0.0/19
-	{1732849664, 1732850687},     // 103.73.48.0/22
-	{1732867072, 1732868095},     // 103.73.116.0/22
-	{1732868096, 1732869119},     // 103.73.120.0/22
-	{1732870144, 1732874239},     // 103.73.128.0/20
-	{1732880384, 1732881407},     // 103.73.168.0/22
-	{1732882432, 1732883455},     // 103.73.176.0/22
-	{1732889600, 1732890623},     // 103.73.204.0/22
-	{1732890624, 1732891647},     // 103.73.208.0/22
-	{1732898816, 1732899327},     // 103.73.240.0/23
-	{1732899840, 1732900863},     // 103.73.244.0/22
-	{1732900864, 1732901887},     // 103.73.248.0/22
-	{1732909056, 1732911103},     // 103.74.24.0/21
-	{1732911104, 1732915199},     // 103.74.32.0/20
-	{1732915200, 1732916223},     // 103.74.48.0/22
-	{1732917248, 1732919295},     // 103.74.56.0/21
-	{1732923392, 1732924415},     // 103.74.80.0/22
-	{1732934656, 1732935679},     // 103.74.124.0/22
-	{1732940800, 1732941823},     // 103.74.148.0/22
-	{1732941824, 1732943871},     // 103.74.152.0/21
-	{1732955136, 1732956159},     // 103.74.204.0/22
-	{1732962304, 1732963327},     // 103.74.232.0/22
-	{1732990720, 1732990975},     // 103.75.87.0/24
-	{1732990976, 1732993023},     // 103.75.88.0/21
-	{1732995072, 1732997119},     // 103.75.104.0/21
-	{1732997120, 1732998143},     // 103.75.112.0/22
-	{1732999168, 1733000191},     // 103.75.120.0/22
-	{1733001216, 1733002239},     // 103.75.128.0/22
-	{1733005312, 1733005823},     // 103.75.144.0/23
-	{1733005824, 1733006079},     // 103.75.146.0/24
-	{1733007360, 1733008383},     // 103.75.152.0/22
-	{1733049344, 1733050367},     // 103.76.60.0/22
-	{1733050368, 1733052415},     // 103.76.64.0/21
-	{1733052416, 1733053439},     // 103.76.72.0/22
-	{1733057536, 1733058559},     // 103.76.92.0/22
-	{1733089280, 1733091327},     // 103.76.216.0/21
-	{1733091328, 1733092351},     // 103.76.224.0/22
-	{1733106688, 1733107711},     // 103.77.28.0/22
-	{1733112832, 1733113855},     // 103.77.52.0/22
-	{1733113856, 1733114879},     // 103.77.56.0/22
-	{1733122048, 1733123071},     // 103.77.88.0/22
-	{1733133312, 1733134335},     // 103.77.132.0/22
-	{1733137408, 1733138431},     // 103.77.148.0/22
-	{1733155840, 1733156863},     // 103.77.220.0/22
-	{1733179392, 1733181439},     // 103.78.56.0/21
-	{1733181440, 1733182463},     // 103.78.64.0/22
-	{1733196800, 1733197823},     // 103.78.124.0/22
-	{1733209088, 1733210111},     // 103.78.172.0/22
-	{1733210112, 1733211135},     // 103.78.176.0/22
-	{1733215232, 1733216255},     // 103.78.196.0/22
-	{1733223424, 1733224447},     // 103.78.228.0/22
-	{1733236736, 1733238783},     // 103.79.24.0/21
-	{1733239808, 1733240831},     // 103.79.36.0/22
-	{1733240832, 1733242879},     // 103.79.40.0/21
-	{1733244928, 1733246975},     // 103.79.56.0/21
-	{1733246976, 1733249023},     // 103.79.64.0/21
-	{1733251072, 1733253119},     // 103.79.80.0/21
-	{1733265408, 1733266431},     // 103.79.136.0/22
-	{1733278720, 1733279743},     // 103.79.188.0/22
-	{1733279744, 1733283839},     // 103.79.192.0/20
-	{1733283840, 1733285887},     // 103.79.208.0/21
-	{1733307392, 1733308415},     // 103.80.44.0/22
-	{1733314560, 1733315583},     // 103.80.72.0/22
-	{1733341184, 1733343231},     // 103.80.176.0/21
-	{1733343232, 1733344255},     // 103.80.184.0/22
-	{1733345280, 1733346303},     // 103.80.192.0/22
-	{1733347328, 1733348351},     // 103.80.200.0/22
-	{1733355520, 1733356543},     // 103.80.232.0/22
-	{1733362688, 1733363711},     // 103.81.4.0/22
-	{1733372928, 1733373951},     // 103.81.44.0/22
-	{1733373952, 1733374975},     // 103.81.48.0/22
-	{1733386240, 1733387263},     // 103.81.96.0/22
-	{1733392384, 1733393407},     // 103.81.120.0/22
-	{1733399552, 1733400575},     // 103.81.148.0/22
-	{1733403648, 1733404671},     // 103.81.164.0/22
-	{1733412864, 1733413887},     // 103.81.200.0/22
-	{1733421056, 1733422079},     // 103.81.232.0/22
-	{1733442560, 1733443583},     // 103.82.60.0/22
-	{1733444608, 1733445631},     // 103.82.68.0/22
-	{1733448704, 1733449727},     // 103.82.84.0/22
-	{1733453824, 1733454847},     // 103.82.104.0/22
-	{1733484544, 1733485567},     // 103.82.224.0/22
-	{1733487616, 1733488639},     // 103.82.236.0/22
-	{1733504000, 1733505023},     // 103.83.44.0/22
-	{1733506048, 1733507071},     // 103.83.52.0/22
-	{1733508096, 1733509119},     // 103.83.60.0/22
-	{1733511168, 1733512191},     // 103.83.72.0/22
-	{1733521408, 1733522431},     // 103.83.112.0/22
-	{1733526528, 1733527551},     // 103.83.132.0/22
-	{1733538816, 1733539839},     // 103.83.180.0/22
-	{1733558272, 1733559295},     // 103.84.0.0/22
-	{1733561344, 1733562367},     // 103.84.12.0/22
-	{1733563392, 1733564415},     // 103.84.20.0/22
-	{1733564416, 1733566463},     // 103.84.24.0/21
-	{1733570560, 1733571583},     // 103.84.48.0/22
-	{1733572608, 1733573631},     // 103.84.56.0/22
-	{1733574656, 1733575679},     // 103.84.64.0/22
-	{1733576704, 1733577727},     // 103.84.72.0/22
-	{1733635072, 1733636095},     // 103.85.44.0/22
-	{1733636096, 1733638143},     // 103.85.48.0/21
-	{1733638144, 1733639167},     // 103.85.56.0/22
-	{1733645312, 1733646335},     // 103.85.84.0/22
-	{1733658624, 1733659647},     // 103.85.136.0/22
-	{1733660672, 1733661695},     // 103.85.144.0/22
-	{1733665792, 1733666815},     // 103.85.164.0/22
-	{1733666816, 1733668863},     // 103.85.168.0/21
-	{1733668864, 1733669887},     // 103.85.176.0/22
-	{1733696512, 1733697535},     // 103.86.28.0/22
-	{1733697536, 1733698559},     // 103.86.32.0/22
-	{1733704704, 1733705727},     // 103.86.60.0/22
-	{1733722368, 1733722623},     // 103.86.129.0/24
-	{1733741568, 1733742591},     // 103.86.204.0/22
-	{1733742592, 1733746687},     // 103.86.208.0/20
-	{1733746688, 1733754879},     // 103.86.224.0/19
-	{1733754880, 1733756927},     // 103.87.0.0/21
-	{1733760000, 1733761023},     // 103.87.20.0/22
-	{1733763072, 1733764095},     // 103.87.32.0/22
-	{1733779456, 1733780479},     // 103.87.96.0/22
-	{1733788672, 1733789695},     // 103.87.132.0/22
-	{1733800960, 1733801983},     // 103.87.180.0/22
-	{1733812224, 1733813247},     // 103.87.224.0/22
-	{1733821440, 1733822463},     // 103.88.4.0/22
-	{1733822464, 1733824511},     // 103.88.8.0/21
-	{1733824512, 1733826559},     // 103.88.16.0/21
-	{1733828608, 1733830655},     // 103.88.32.0/21
-	{1733835776, 1733836799},     // 103.88.60.0/22
-	{1733836800, 1733837823},     // 103.88.64.0/22
-	{1733838848, 1733839871},     // 103.88.72.0/22
-	{1733844992, 1733847039},     // 103.88.96.0/21
-	{1733859328, 1733859839},     // 103.88.152.0/23
-	{1733862400, 1733863423},     // 103.88.164.0/22
-	{1733874688, 1733875711},     // 103.88.212.0/22
-	{1733893120, 1733894143},     // 103.89.28.0/22
-	{1733910528, 1733914623},     // 103.89.96.0/20
-	{1733914624, 1733915647},     // 103.89.112.0/22
-	{1733923840, 1733924863},     // 103.89.148.0/22
-	{1733929984, 1733931007},     // 103.89.172.0/22
-	{1733933056, 1733935103},     // 103.89.184.0/21
-	{1733935104, 1733943295},     // 103.89.192.0/19
-	{1733943296, 1733945343},     // 103.89.224.0/21
-	{1733964800, 1733965823},     // 103.90.52.0/22
-	{1733975040, 1733976063},     // 103.90.92.0/22
-	{1733977088, 1733978111},     // 103.90.100.0/22
-	{1733978112, 1733980159},     // 103.90.104.0/21
-	{1733980160, 1733984255},     // 103.90.112.0/20
-	{1733984256, 1733986303},     // 103.90.128.0/21
-	{1733990400, 1733991423},     // 103.90.152.0/22
-	{1733994496, 1733995519},     // 103.90.168.0/22
-	{1733995776, 1733996031},     // 103.90.173.0/24
-	{1733996544, 1733997567},     // 103.90.176.0/22
-	{1733999616, 1734000639},     // 103.90.188.0/22
-	{1734000640, 1734001663},     // 103.90.192.0/22
-	{1734026240, 1734027263},     // 103.91.36.0/22
-	{1734027264, 1734028287},     // 103.91.40.0/22
-	{1734044672, 1734045695},     // 103.91.108.0/22
-	{1734055936, 1734056959},     // 103.91.152.0/22
-	{1734062080, 1734063103},     // 103.91.176.0/22
-	{1734068224, 1734069247},     // 103.91.200.0/22
-	{1734070272, 1734072319},     // 103.91.208.0/21
-	{1734077440, 1734078463},     // 103.91.236.0/22
-	{1734094848, 1734098943},     // 103.92.48.0/20
-	{1734098944, 1734103039},     // 103.92.64.0/20
-	{1734103040, 1734104063},     // 103.92.80.0/22
-	{1734105088, 1734106111},     // 103.92.88.0/22
-	{1734110208, 1734111231},     // 103.92.108.0/22
-	{1734114304, 1734115327},     // 103.92.124.0/22
-	{1734116352, 1734117375},     // 103.92.132.0/22
-	{1734122496, 1734123519},     // 103.92.156.0/22
-	{1734124544, 1734125567},     // 103.92.164.0/22
-	{1734125568, 1734127615},     // 103.92.168.0/21
-	{1734127616, 1734131711},     // 103.92.176.0/20
-	{1734131712, 1734132735},     // 103.92.192.0/22
-	{1734142976, 1734143999},     // 103.92.236.0/22
-	{1734144000, 1734148095},     // 103.92.240.0/20
-	{1734148096, 1734150143},     // 103.93.0.0/21
-	{1734155264, 1734156287},     // 103.93.28.0/22
-	{1734169600, 1734170623},     // 103.93.84.0/22
-	{1734187008, 1734188031},     // 103.93.152.0/22
-	{1734194176, 1734195199},     // 103.93.180.0/22
-	{1734200320, 1734201343},     // 103.93.204.0/22
-	{1734216704, 1734217727},     // 103.94.12.0/22
-	{1734218752, 1734219775},     // 103.94.20.0/22
-	{1734221056, 1734221311},     // 103.94.29.0/24
-	{1734221312, 1734221823},     // 103.94.30.0/23
-	{1734221824, 1734225919},     // 103.94.32.0/20
-	{1734232064, 1734233087},     // 103.94.72.0/22
-	{1734236160, 1734237183},     // 103.94.88.0/22
-	{1734243328, 1734244351},     // 103.94.116.0/22
-	{1734254592, 1734255615},     // 103.94.160.0/22
-	{1734264832, 1734265855},     // 103.94.200.0/22
-	{1734286336, 1734286591},     // 103.95.28.0/24
-	{1734286848, 1734287103},     // 103.95.30.0/24
-	{1734292480, 1734293503},     // 103.95.52.0/22
-	{1734296576, 1734297599},     // 103.95.68.0/22
-	{1734301696, 1734303743},     // 103.95.88.0/21
-	{1734313984, 1734316031},     // 103.95.136.0/21
-	{1734316032, 1734317055},     // 103.95.144.0/22
-	{1734318080, 1734319103},     // 103.95.152.0/22
-	{1734334464, 1734336511},     // 103.95.216.0/21
-	{1734336512, 1734337535},     // 103.95.224.0/22
-	{1734339584, 1734340607},     // 103.95.236.0/22
-	{1734340608, 1734344703},     // 103.95.240.0/20
-	{1734346752, 1734347775},     // 103.96.8.0/22
-	{1734376448, 1734377471},     // 103.96.124.0/22
-	{1734379520, 1734380543},     // 103.96.136.0/22
-	{1734383616, 1734385663},     // 103.96.152.0/21
-	{1734385664, 1734393855},     // 103.96.160.0/19
-	{1734393856, 1734397951},     // 103.96.192.0/20
-	{1734397952, 1734399999},     // 103.96.208.0/21
-	{1734400000, 1734401023},     // 103.96.216.0/22
-	{1734420480, 1734421503},     // 103.97.40.0/22
-	{1734425600, 1734426111},     // 103.97.60.0/23
-	{1734438912, 1734440959},     // 103.97.112.0/21
-	{1734448128, 1734449151},     // 103.97.148.0/22
-	{1734458368, 1734459391},     // 103.97.188.0/22
-	{1734459392, 1734460415},     // 103.97.192.0/22
-	{1734486016, 1734488063},     // 103.98.40.0/21
-	{1734488064, 1734489087},     // 103.98.48.0/22
-	{1734490112, 1734491135},     // 103.98.56.0/22
-	{1734496256, 1734497279},     // 103.98.80.0/22
-	{1734498304, 1734499327},     // 103.98.88.0/22
-	{1734501376, 1734502399},     // 103.98.100.0/22
-	{1734507520, 1734507775},     // 103.98.124.0/24
-	{1734508032, 1734508543},     // 103.98.126.0/23
-	{1734510592, 1734512639},     // 103.98.136.0/21
-	{1734512640, 1734513663},     // 103.98.144.0/22
-	{1734517760, 1734518783},     // 103.98.164.0/22
-	{1734518784, 1734519807},     // 103.98.168.0/22
-	{1734521856, 1734522879},     // 103.98.180.0/22
-	{1734525952, 1734526975},     // 103.98.196.0/22
-	{1734531072, 1734533119},     // 103.98.216.0/21
-	{1734533120, 1734535167},     // 103.98.224.0/21
-	{1734535168, 1734536191},     // 103.98.232.0/22
-	{1734537216, 1734539263},     // 103.98.240.0/21
-	{1734539264, 1734539775},     // 103.98.248.0/23
-	{1734539776, 1734540031},     // 103.98.250.0/24
-	{1734540288, 1734541311},     // 103.98.252.0/22
-	{1734555648, 1734556671},     // 103.99.56.0/22
-	{1734567936, 1734568959},     // 103.99.104.0/22
-	{1734571008, 1734572031},     // 103.99.116.0/22
-	{1734572032, 1734573055},     // 103.99.120.0/22
-	{1734575104, 1734576127},     // 103.99.132.0/22
-	{1734576128, 1734578175},     // 103.99.136.0/21
-	{1734578176, 1734579199},     // 103.99.144.0/22
-	{1734580224, 1734581247},     // 103.99.152.0/22
-	{1734597632, 1734598655},     // 103.99.220.0/22
-	{1734600704, 1734602751},     // 103.99.232.0/21
-	{1734606848, 1734607871},     // 103.100.0.0/22
-	{1734615040, 1734616063},     // 103.100.32.0/22
-	{1734617088, 1734618111},     // 103.100.40.0/22
-	{1734619136, 1734620159},     // 103.100.48.0/22
-	{1734621184, 1734622207},     // 103.100.56.0/22
-	{1734623232, 1734624255},     // 103.100.64.0/22
-	{1734629376, 1734630399},     // 103.100.88.0/22
-	{1734636544, 1734637567},     // 103.100.116.0/22
-	{1734643712, 1734644735},     // 103.100.144.0/22
-	{1734668288, 1734669311},     // 103.100.240.0/22
-	{1734670336, 1734672383},     // 103.100.248.0/21
-	{1734673408, 1734674431},     // 103.101.4.0/22
-	{1734674432, 1734676479},     // 103.101.8.0/21
-	{1734687744, 1734688767},     // 103.101.60.0/22
-	{1734703360, 1734703615},     // 103.101.121.0/24
-	{1734703616, 1734704127},     // 103.101.122.0/23
-	{1734704128, 1734704383},     // 103.101.124.0/24
-	{1734704640, 1734705151},     // 103.101.126.0/23
-	{1734709248, 1734711295},     // 103.101.144.0/21
-	{1734718464, 1734719487},     // 103.101.180.0/22
-	{1734719488, 1734720511},     // 103.101.184.0/22
-	{1734757376, 1734758399},     // 103.102.76.0/22
-	{1734758400, 1734759423},     // 103.102.80.0/22
-	{1734780928, 1734782975},     // 103.102.168.0/21
-	{1734784000, 1734785023},     // 103.102.180.0/22
-	{1734785024, 1734787071},     // 103.102.184.0/21
-	{1734787072, 1734788095},     // 103.102.192.0/22
-	{1734788096, 1734788351},     // 103.102.196.0/24
-	{1734789120, 1734790143},     // 103.102.200.0/22
-	{1734791168, 1734793215},     // 103.102.208.0/21
-	{1734806528, 1734807551},     // 103.103.12.0/22
-	{1734807552, 1734808575},     // 103.103.16.0/22
-	{1734812672, 1734813695},     // 103.103.36.0/22
-	{1734821888, 1734822911},     // 103.103.72.0/22
-	{1734851584, 1734852607},     // 103.103.188.0/22
-	{1734855680, 1734856703},     // 103.103.204.0/22
-	{1734859776, 1734860799},     // 103.103.220.0/22
-	{1734878208, 1734879231},     // 103.104.36.0/22
-	{1734879232, 1734880255},     // 103.104.40.0/22
-	{1734885376, 1734886399},     // 103.104.64.0/22
-	{1734907904, 1734908927},     // 103.104.152.0/22
-	{1734933504, 1734934527},     // 103.104.252.0/22
-	{1734934528, 1734936575},     // 103.105.0.0/21
-	{1734937600, 1734938623},     // 103.105.12.0/22
-	{1734938624, 1734939647},     // 103.105.16.0/22
-	{1734949888, 1734950911},     // 103.105.60.0/22
-	{1734964224, 1734965247},     // 103.105.116.0/22
-	{1734980608, 1734981631},     // 103.105.180.0/22
-	{1734981632, 1734982655},     // 103.105.184.0/22
-	{1734985728, 1734987775},     // 103.105.200.0/21
-	{1734990848, 1734991871},     // 103.105.220.0/22
-	{1735009280, 1735010303},     // 103.106.36.0/22
-	{1735010304, 1735012351},     // 103.106.40.0/21
-	{1735015424, 1735016447},     // 103.106.60.0/22
-	{1735017472, 1735018495},     // 103.106.68.0/22
-	{1735024640, 1735025663},     // 103.106.96.0/22
-	{1735030784, 1735031807},     // 103.106.120.0/22
-	{1735032832, 1735034879},     // 103.106.128.0/21
-	{1735048704, 1735049215},     // 103.106.190.0/23
-	{1735050240, 1735051263},     // 103.106.196.0/22
-	{1735054336, 1735055359},     // 103.106.212.0/22
-	{1735064576, 1735065599},     // 103.106.252.0/22
-	{1735065600, 1735066623},     // 103.107.0.0/22
-	{1735072768, 1735073791},     // 103.107.28.0/22
-	{1735073792, 1735074815},     // 103.107.32.0/22
-	{1735076864, 1735077887},     // 103.107.44.0/22
-	{1735084032, 1735085055},     // 103.107.72.0/22
-	{1735107584, 1735108607},     // 103.107.164.0/22
-	{1735108608, 1735109631},     // 103.107.168.0/22
-	{1735113728, 1735114751},     // 103.107.188.0/22
-	{1735114752, 1735115775},     // 103.107.192.0/22
-	{1735118848, 1735122943},     // 103.107.208.0/20
-	{1735144448, 1735145471},     // 103.108.52.0/22
-	{1735172096, 1735174143},     // 103.108.160.0/21
-	{1735181312, 1735182335},     // 103.108.196.0/22
-	{1735184384, 1735186431},     // 103.108.208.0/21
-	{1735188480, 1735189503},     // 103.108.224.0/22
-	{1735193600, 1735194623},     // 103.108.244.0/22
-	{1735195392, 1735195647},     // 103.108.251.0/24
-	{1735201792, 1735202815},     // 103.109.20.0/22
-	{1735208960, 1735209983},     // 103.109.48.0/22
-	{1735219200, 1735220223},     // 103.109.88.0/22
-	{1735260160, 1735261183},     // 103.109.248.0/22
-	{1735270400, 1735271423},     // 103.110.32.0/22
-	{1735285760, 1735286783},     // 103.110.92.0/22
-	{1735292672, 1735292927},     // 103.110.119.0/24
-	{1735294720, 1735294975},     // 103.110.127.0/24
-	{1735294976, 1735295487},     // 103.110.128.0/23
-	{1735295744, 1735295999},     // 103.110.131.0/24
-	{1735296000, 1735297023},     // 103.110.132.0/22
-	{1735297024, 1735298047},     // 103.110.136.0/22
-	{1735302144, 1735303167},     // 103.110.156.0/22
-	{1735310336, 1735311359},     // 103.110.188.0/22
-	{1735314432, 1735315455},     // 103.110.204.0/22
-	{1735344128, 1735345151},     // 103.111.64.0/22
-	{1735371776, 1735372799},     // 103.111.172.0/22
-	{1735392256, 1735393279},     // 103.111.252.0/22
-	{1735411712, 1735412735},     // 103.112.72.0/22
-	{1735415808, 1735417855},     // 103.112.88.0/21
-	{1735420928, 1735421951},     // 103.112.108.0/22
-	{1735421952, 1735422975},     // 103.112.112.0/22
-	{1735429120, 1735430143},     // 103.112.140.0/22
-	{1735459840, 1735460863},     // 103.113.4.0/22
-	{1735495680, 1735496703},     // 103.113.144.0/22
-	{1735515136, 1735516159},     // 103.113.220.0/22
-	{1735518208, 1735520255},     // 103.113.232.0/21
-	{1735525376, 1735526399},     // 103.114.4.0/22
-	{1735541760, 1735542783},     // 103.114.68.0/22
-	{1735549952, 1735550975},     // 103.114.100.0/22
-	{1735562240, 1735563263},     // 103.114.148.0/22
-	{1735564288, 1735564799},     // 103.114.156.0/23
-	{1735569408, 1735570431},     // 103.114.176.0/22
-	{1735578624, 1735579647},     // 103.114.212.0/22
-	{1735584768, 1735585791},     // 103.114.236.0/22
-	{1735585792, 1735586815},     // 103.114.240.0/22
-	{1735603200, 1735604223},     // 103.115.52.0/22
-	{1735607296, 1735608319},     // 103.115.68.0/22
-	{1735613440, 1735614463},     // 103.115.92.0/22
-	{1735620608, 1735621631},     // 103.115.120.0/22
-	{1735627776, 1735628799},     // 103.115.148.0/22
-	{1735653376, 1735654399},     // 103.115.248.0/22
-	{1735674880, 1735675903},     // 103.116.76.0/22
-	{1735678976, 1735679999},     // 103.116.92.0/22
-	{1735686144, 1735687167},     // 103.116.120.0/22
-	{1735688192, 1735689215},     // 103.116.128.0/22
-	{1735702528, 1735703551},     // 103.116.184.0/22
-	{1735711744, 1735712767},     // 103.116.220.0/22
-	{1735712768, 1735714815},     // 103.116.224.0/21
-	{1735725056, 1735726079},     // 103.117.16.0/22
-	{1735743488, 1735744511},     // 103.117.88.0/22
-	{1735769088, 1735770111},     // 103.117.188.0/22
-	{1735777280, 1735778303},     // 103.117.220.0/22
-	{1735791360, 1735791615},     // 103.118.19.0/24
-	{1735799808, 1735800831},     // 103.118.52.0/22
-	{1735800832, 1735802879},     // 103.118.56.0/21
-	{1735802880, 1735804927},     // 103.118.64.0/21
-	{1735804928, 1735805951},     // 103.118.72.0/22
-	{1735809024, 1735810047},     // 103.118.88.0/22
-	{1735830784, 1735831039},     // 103.118.173.0/24
-	{1735881472, 1735881727},     // 103.119.115.0/24
-	{1735891968, 1735892991},     // 103.119.156.0/22
-	{1735898112, 1735899135},     // 103.119.180.0/22
-	{1735903232, 1735904255},     // 103.119.200.0/22
-	{1735909376, 1735910399},     // 103.119.224.0/22
-	{1735930880, 1735931903},     // 103.120.52.0/22
-	{1735936000, 1735937023},     // 103.120.72.0/22
-	{1735937024, 1735937279},     // 103.120.76.0/24
-	{1735940096, 1735941119},     // 103.120.88.0/22
-	{1735942144, 1735943167},     // 103.120.96.0/22
-	{1735953408, 1735954431},     // 103.120.140.0/22
-	{1735967744, 1735968767},     // 103.120.196.0/22
-	{1735974912, 1735975935},     // 103.120.224.0/22
-	{1735996416, 1735997439},     // 103.121.52.0/22
-	{1736024064, 1736026111},     // 103.121.160.0/21
-	{1736047104, 1736047359},     // 103.121.250.0/24
-	{1736047616, 1736048639},     // 103.121.252.0/22
-	{1736060928, 1736061951},     // 103.122.48.0/22
-	{1736094464, 1736094719},     // 103.122.179.0/24
-	{1736097792, 1736098815},     // 103.122.192.0/22
-	{1736110080, 1736110591},     // 103.122.240.0/23
-	{1736110592, 1736110847},     // 103.122.242.0/24
-	{1736115200, 1736116223},     // 103.123.4.0/22
-	{1736128512, 1736129535},     // 103.123.56.0/22
-	{1736136704, 1736138751},     // 103.123.88.0/21
-	{1736143872, 1736144895},     // 103.123.116.0/22
-	{1736159232, 1736160255},     // 103.123.176.0/22
-	{1736165376, 1736167423},     // 103.123.200.0/21
-	{1736167424, 1736169471},     // 103.123.208.0/21
-	{1736185856, 1736186879},     // 103.124.24.0/22
-	{1736192000, 1736193023},     // 103.124.48.0/22
-	{1736196096, 1736197119},     // 103.124.64.0/22
-	{1736233984, 1736235007},     // 103.124.212.0/22
-	{1736235008, 1736236031},     // 103.124.216.0/22
-	{1736250368, 1736251391},     // 103.125.20.0/22
-	{1736256512, 1736257535},     // 103.125.44.0/22
-	{1736279040, 1736280063},     // 103.125.132.0/22
-	{1736287232, 1736288255},     // 103.125.164.0/22
-	{1736295424, 1736296447},     // 103.125.196.0/22
-	{1736305664, 1736306687},     // 103.125.236.0/22
-	{1736310784, 1736311807},     // 103.126.0.0/22
-	{1736314880, 1736315391},     // 103.126.16.0/23
-	{1736322048, 1736323071},     // 103.126.44.0/22
-	{1736342528, 1736343551},     // 103.126.124.0/22
-	{1736343552, 1736344575},     // 103.126.128.0/22
-	{1736520960, 1736521215},     // 103.129.53.0/24
-	{1736521216, 1736521727},     // 103.129.54.0/23
-	{1736545280, 1736546303},     // 103.129.148.0/22
-	{1736606720, 1736607743},     // 103.130.132.0/22
-	{1736613888, 1736614911},     // 103.130.160.0/22
-	{1736631296, 1736632319},     // 103.130.228.0/22
-	{1736643584, 1736644607},     // 103.131.20.0/22
-	{1736647680, 1736648703},     // 103.131.36.0/22
-	{1736677376, 1736678399},     // 103.131.152.0/22
-	{1736681472, 1736682495},     // 103.131.168.0/22
-	{1736695808, 1736697855},     // 103.131.224.0/21
-	{1736699904, 1736700927},     // 103.131.240.0/22
-	{1736719360, 1736720383},     // 103.132.60.0/22
-	{1736720384, 1736724479},     // 103.132.64.0/20
-	{1736724480, 1736725503},     // 103.132.80.0/22
-	{1736730624, 1736732671},     // 103.132.104.0/21
-	{1736732672, 1736734719},     // 103.132.112.0/21
-	{1736734720, 1736735743},     // 103.132.120.0/22
-	{1736752128, 1736753151},     // 103.132.188.0/22
-	{1736757248, 1736759295},     // 103.132.208.0/21
-	{1736772608, 1736773631},     // 103.133.12.0/22
-	{1736779776, 1736780799},     // 103.133.40.0/22
-	{1736802304, 1736803327},     // 103.133.128.0/22
-	{1736828928, 1736829951},     // 103.133.232.0/22
-	{1736885248, 1736886271},     // 103.134.196.0/22
-	{1736921088, 1736922111},     // 103.135.80.0/22
-	{1736932352, 1736933375},     // 103.135.124.0/22
-	{1736938496, 1736939519},     // 103.135.148.0/22
-	{1736940544, 1736941567},     // 103.135.156.0/22
-	{1736941568, 1736943615},     // 103.135.160.0/21
-	{1736945664, 1736946687},     // 103.135.176.0/22
-	{1736947712, 1736948735},     // 103.135.184.0/22
-	{1736949760, 1736951807},     // 103.135.192.0/21
-	{1736961024, 1736962047},     // 103.135.236.0/22
-	{1736998912, 1736999935},     // 103.136.128.0/22
-	{1737025536, 1737026559},     // 103.136.232.0/22
-	{1737046528, 1737047039},     // 103.137.58.0/23
-	{1737047040, 1737047295},     // 103.137.60.0/24
-	{1737066496, 1737067007},     // 103.137.136.0/23
-	{1737069824, 1737070079},     // 103.137.149.0/24
-	{1737077760, 1737078783},     // 103.137.180.0/22
-	{1737092096, 1737093119},     // 103.137.236.0/22
-	{1737097728, 1737098239},     // 103.138.2.0/23
-	{1737131776, 1737132031},     // 103.138.135.0/24
-	{1737150464, 1737150975},     // 103.138.208.0/23
-	{1737153536, 1737154047},     // 103.138.220.0/23
-	{1737160704, 1737161215},     // 103.138.248.0/23
-	{1737168384, 1737168895},     // 103.139.22.0/23
-	{1737197056, 1737197567},     // 103.139.134.0/23
-	{1737197568, 1737198079},     // 103.139.136.0/23
-	{1737206784, 1737207295},     // 103.139.172.0/23
-	{1737214976, 1737215487},     // 103.139.204.0/23
-	{1737217024, 1737217535},     // 103.139.212.0/23
-	{1737231872, 1737232383},     // 103.140.14.0/23
-	{1737240064, 1737240575},     // 103.140.46.0/23
-	{1737264128, 1737264639},     // 103.140.140.0/23
-	{1737265152, 1737265663},     // 103.140.144.0/23
-	{1737277440, 1737277951},     // 103.140.192.0/23
-	{1737296384, 1737296895},     // 103.141.10.0/23
-	{1737308672, 1737309183},     // 103.141.58.0/23
-	{1737326592, 1737327103},     // 103.141.128.0/23
-	{1737341440, 1737341951},     // 103.141.186.0/23
-	{1737355776, 1737356287},     // 103.141.242.0/23
-	{1737359360, 1737359871},     // 103.142.0.0/23
-	{1737366528, 1737367039},     // 103.142.28.0/23
-	{1737374208, 1737374719},     // 103.142.58.0/23
-	{1737380352, 1737380863},     // 103.142.82.0/23
-	{1737383936, 1737384447},     // 103.142.96.0/23
-	{1737390592, 1737391103},     // 103.142.122.0/23
-	{1737392128, 1737392639},     // 103.142.128.0/23
-	{1737398784, 1737399295},     // 103.142.154.0/23
-	{1737399296, 1737399807},     // 103.142.156.0/23
-	{1737405440, 1737405951},     // 103.142.180.0/23
-	{1737406976, 1737407487},     // 103.142.186.0/23
-	{1737419264, 1737419775},     // 103.142.234.0/23
-	{1737420288, 1737420799},     // 103.142.238.0/23
-	{1737428992, 1737430015},     // 103.143.16.0/22
-	{1737432832, 1737433087},     // 103.143.31.0/24
-	{1737443840, 1737444351},     // 103.143.74.0/23
-	{1737456640, 1737457151},     // 103.143.124.0/23
-	{1737458688, 1737459711},     // 103.143.132.0/22
-	{1737469440, 1737469951},     // 103.143.174.0/23
-	{1737483264, 1737483775},     // 103.143.228.0/23
-	{1737507328, 1737507839},     // 103.144.66.0/23
-	{1737508352, 1737508863},     // 103.144.70.0/23
-	{1737508864, 1737509375},     // 103.144.72.0/23
-	{1737512960, 1737513215},     // 103.144.88.0/24
-	{1737525248, 1737525759},     // 103.144.136.0/23
-	{1737530880, 1737531391},     // 103.144.158.0/23
-	{1737566720, 1737567231},     // 103.145.42.0/23
-	{1737580032, 1737580543},     // 103.145.94.0/23
-	{1737581056, 1737581567},     // 103.145.98.0/23
-	{1737604096, 1737604607},     // 103.145.188.0/23
-	{1737623040, 1737623551},     // 103.146.6.0/23
-	{1737639936, 1737640447},     // 103.146.72.0/23
-	{1737653760, 1737654271},     // 103.146.126.0/23
-	{1737656832, 1737657343},     // 103.146.138.0/23
-	{1737681920, 1737682431},     // 103.146.236.0/23
-	{1737686016, 1737686527},     // 103.146.252.0/23
-	{1737718784, 1737719295},     // 103.147.124.0/23
-	{1737739776, 1737740287},     // 103.147.206.0/23
-	{1737797120, 1737797631},     // 103.148.174.0/23
-	{1737819648, 1737820159},     // 103.149.6.0/23
-	{1737822464, 1737822719},     // 103.149.17.0/24
-	{1737829376, 1737829887},     // 103.149.44.0/23
-	{1737871872, 1737872383},     // 103.149.210.0/23
-	{1737872896, 1737873407},     // 103.149.214.0/23
-	{1737874432, 1737874943},     // 103.149.220.0/23
-	{1737880064, 1737880575},     // 103.149.242.0/23
-	{1737880576, 1737881599},     // 103.149.244.0/22
-	{1737889792, 1737890303},     // 103.150.24.0/23
-	{1737900544, 1737901055},     // 103.150.66.0/23
-	{1737902080, 1737902591},     // 103.150.72.0/23
-	{1737914880, 1737915391},     // 103.150.122.0/23
-	{1737915904, 1737916415},     // 103.150.126.0/23
-	{1737916416, 1737916927},     // 103.150.128.0/23
-	{1737921024, 1737921535},     // 103.150.146.0/23
-	{1737925632, 1737926143},     // 103.150.164.0/23
-	{1737934848, 1737935359},     // 103.150.200.0/23
-	{1737938944, 1737939455},     // 103.150.216.0/23
-	{1737946112, 1737946623},     // 103.150.244.0/23
-	{1737950208, 1737950719},     // 103.151.4.0/23
-	{1737985536, 1737986047},     // 103.151.142.0/23
-	{1737987072, 1737987583},     // 103.151.148.0/23
-	{1737989632, 1737990143},     // 103.151.158.0/23
-	{1738007552, 1738008063},     // 103.151.228.0/23
-	{1738021888, 1738022911},     // 103.152.28.0/22
-	{1738034176, 1738034687},     // 103.152.76.0/23
-	{1738035200, 1738035711},     // 103.152.80.0/23
-	{1738045440, 1738046463},     // 103.152.120.0/22
-	{1738053632, 1738054143},     // 103.152.152.0/23
-	{1738057728, 1738058239},     // 103.152.168.0/23
-	{1738062336, 1738062847},     // 103.152.186.0/23
-	{1738063360, 1738063871},     // 103.152.190.0/23
-	{1738063872, 1738064383},     // 103.152.192.0/23
-	{1738065920, 1738066431},     // 103.152.200.0/23
-	{1738067968, 1738068479},     // 103.152.208.0/23
-	{1738072064, 1738072575},     // 103.152.224.0/23
-	{1738078720, 1738079231},     // 103.152.250.0/23
-	{1738081280, 1738081791},     // 103.153.4.0/23
-	{1738105600, 1738105855},     // 103.153.99.0/24
-	{1738109440, 1738109951},     // 103.153.114.0/23
-	{1738111488, 1738111999},     // 103.153.122.0/23
-	{1738113024, 1738113279},     // 103.153.128.0/24
-	{1738114048, 1738114559},     // 103.153.132.0/23
-	{1738115584, 1738116095},     // 103.153.138.0/23
-	{1738117632, 1738118143},     // 103.153.146.0/23
-	{1738121216, 1738121727},     // 103.153.160.0/23
-	{1738150400, 1738150911},     // 103.154.18.0/23
-	{1738153472, 1738153983},     // 103.154.30.0/23
-	{1738153984, 1738154495},     // 103.154.32.0/23
-	{1738156032, 1738156543},     // 103.154.40.0/23
-	{1738162688, 1738163199},     // 103.154.66.0/23
-	{1738187264, 1738187775},     // 103.154.162.0/23
-	{1738187776, 1738188287},     // 103.154.164.0/23
-	{1738188800, 1738189311},     // 103.154.168.0/23
-	{1738214912, 1738215423},     // 103.155.14.0/23
-	{1738215680, 1738215935},     // 103.155.17.0/24
-	{1738220032, 1738220543},     // 103.155.34.0/23
-	{1738223616, 1738224127},     // 103.155.48.0/23
-	{1738230784, 1738231295},     // 103.155.76.0/23
-	{1738236928, 1738237439},     // 103.155.100.0/23
-	{1738239488, 1738239999},     // 103.155.110.0/23
-	{1738242048, 1738242559},     // 103.155.120.0/23
-	{1738266112, 1738266623},     // 103.155.214.0/23
-	{1738274816, 1738275327},     // 103.155.248.0/23
-	{1738284032, 1738284543},     // 103.156.28.0/23
-	{1738294272, 1738294783},     // 103.156.68.0/23
-	{1738296832, 1738297343},     // 103.156.78.0/23
-	{1738303488, 1738303999},     // 103.156.104.0/23
-	{1738317312, 1738317823},     // 103.156.158.0/23
-	{1738319360, 1738319871},     // 103.156.166.0/23
-	{1738321408, 1738321919},     // 103.156.174.0/23
-	{1738324480, 1738324991},     // 103.156.186.0/23
-	{1738335232, 1738335743},     // 103.156.228.0/23
-	{1738350080, 1738350591},     // 103.157.30.0/23
-	{1738377728, 1738378239},     // 103.157.138.0/23
-	{1738386944, 1738387455},     // 103.157.174.0/23
-	{1738396672, 1738397183},     // 103.157.212.0/23
-	{1738402304, 1738402815},     // 103.157.234.0/23
-	{1738407424, 1738407935},     // 103.157.254.0/23
-	{1738407936, 1738408447},     // 103.158.0.0/23
-	{1738409984, 1738410495},     // 103.158.8.0/23
-	{1738412032, 1738412543},     // 103.158.16.0/23
-	{1738459136, 1738459647},     // 103.158.200.0/23
-	{1738465280, 1738465791},     // 103.158.224.0/23
-	{1738493952, 1738494463},     // 103.159.80.0/23
-	{1738504704, 1738505215},     // 103.159.122.0/23
-	{1738505216, 1738505727},     // 103.159.124.0/23
-	{1738507776, 1738508287},     // 103.159.134.0/23
-	{1738509824, 1738510335},     // 103.159.142.0/23
-	{1738547200, 1738548223},     // 103.160.32.0/22
-	{1738567680, 1738568703},     // 103.160.112.0/22
-	{1738601472, 1738601983},     // 103.160.244.0/23
-	{1738604032, 1738604543},     // 103.160.254.0/23
-	{1738608128, 1738608639},     // 103.161.14.0/23
-	{1738630656, 1738631167},     // 103.161.102.0/23
-	{1738639872, 1738640383},     // 103.161.138.0/23
-	{1738657792, 1738658303},     // 103.161.208.0/23
-	{1738660864, 1738661375},     // 103.161.220.0/23
-	{1738669568, 1738670079},     // 103.161.254.0/23
-	{1738672640, 1738673151},     // 103.162.10.0/23
-	{1738678272, 1738678783},     // 103.162.32.0/23
-	{1738679808, 1738680319},     // 103.162.38.0/23
-	{1740636160, 1740644351},     // 103.192.0.0/19
-	{1740648448, 1740650495},     // 103.192.48.0/21
-	{1740650496, 1740651519},     // 103.192.56.0/22
-	{1740657664, 1740658687},     // 103.192.84.0/22
-	{1740658688, 1740660735},     // 103.192.88.0/21
-	{1740660736, 1740664831},     // 103.192.96.0/20
-	{1740664832, 1740665855},     // 103.192.112.0/22
-	{1740668928, 1740673023},     // 103.192.128.0/20
-	{1740673024, 1740674047},     // 103.192.144.0/22
-	{1740678144, 1740679167},     // 103.192.164.0/22
-	{1740684288, 1740685311},     // 103.192.188.0/22
-	{1740689408, 1740691455},     // 103.192.208.0/21
-	{1740691456, 1740692479},     // 103.192.216.0/22
-	{1740700672, 1740701695},     // 103.192.252.0/22
-	{1740711936, 1740713983},     // 103.193.40.0/21
-	{1740732416, 1740733439},     // 103.193.120.0/22
-	{1740737536, 1740738559},     // 103.193.140.0/22
-	{1740742656, 1740743679},     // 103.193.160.0/22
-	{1740749824, 1740750847},     // 103.193.188.0/22
-	{1740750848, 1740751871},     // 103.193.192.0/22
-	{1740755968, 1740756991},     // 103.193.212.0/22
-	{1740756992, 1740759039},     // 103.193.216.0/21
-	{1740759040, 1740763135},     // 103.193.224.0/20
-	{1740771328, 1740772351},     // 103.194.16.0/22
-	{1740861440, 1740862463},     // 103.195.112.0/22
-	{1740871680, 1740872703},     // 103.195.152.0/22
-	{1740873728, 1740874751},     // 103.195.160.0/22
-	{1740914688, 1740915711},     // 103.196.64.0/22
-	{1740916736, 1740917759},     // 103.196.72.0/22
-	{1740920832, 1740922879},     // 103.196.88.0/21
-	{1740922880, 1740923903},     // 103.196.96.0/22
-	{1740941312, 1740942335},     // 103.196.168.0/22
-	{1740945664, 1740945919},     // 103.196.185.0/24
-	{1740945920, 1740946431},     // 103.196.186.0/23
-	{1741010176, 1741010431},     // 103.197.181.0/24
-	{1741010688, 1741010943},     // 103.197.183.0/24
-	{1741022208, 1741023231},     // 103.197.228.0/22
-	{1741028608, 1741028863},     // 103.197.253.0/24
-	{1741028864, 1741029375},     // 103.197.254.0/23
-	{1741034496, 1741035519},     // 103.198.20.0/22
-	{1741044736, 1741045759},     // 103.198.60.0/22
-	{1741045760, 1741046783},     // 103.198.64.0/22
-	{1741047808, 1741048831},     // 103.198.72.0/22
-	{1741061120, 1741062143},     // 103.198.124.0/22
-	{1741069312, 1741070335},     // 103.198.156.0/22
-	{1741075456, 1741076479},     // 103.198.180.0/22
-	{1741079552, 1741080575},     // 103.198.196.0/22
-	{1741080576, 1741081599},     // 103.198.200.0/22
-	{1741136896, 1741137919},     // 103.199.164.0/22
-	{1741145088, 1741146111},     // 103.199.196.0/22
-	{1741153280, 1741154303},     // 103.199.228.0/22
-	{1741159424, 1741160447},     // 103.199.252.0/22
-	{1741173760, 1741174783},     // 103.200.52.0/22
-	{1741176832, 1741178879},     // 103.200.64.0/21
-	{1741195264, 1741197311},     // 103.200.136.0/21
-	{1741197312, 1741201407},     // 103.200.144.0/20
-	{1741201408, 1741209599},     // 103.200.160.0/19
-	{1741209600, 1741210623},     // 103.200.192.0/22
-	{1741216768, 1741217791},     // 103.200.220.0/22
-	{1741217792, 1741225983},     // 103.200.224.0/19
-	{1741225984, 1741230079},     // 103.201.0.0/20
-	{1741230080, 1741232127},     // 103.201.16.0/21
-	{1741233152, 1741234175},     // 103.201.28.0/22
-	{1741234176, 1741242367},     // 103.201.32.0/19
-	{1741242368, 1741243391},     // 103.201.64.0/22
-	{1741245440, 1741246463},     // 103.201.76.0/22
-	{1741246464, 1741250559},     // 103.201.80.0/20
-	{1741250560, 1741254655},     // 103.201.96.0/20
-	{1741254656, 1741256703},     // 103.201.112.0/21
-	{1741256704, 1741257727},     // 103.201.120.0/22
-	{1741264896, 1741266943},     // 103.201.152.0/21
-	{1741266944, 1741275135},     // 103.201.160.0/19
-	{1741275136, 1741291519},     // 103.201.192.0/18
-	{1741291520, 1741299711},     // 103.202.0.0/19
-	{1741299712, 1741303807},     // 103.202.32.0/20
-	{1741305856, 1741307903},     // 103.202.56.0/21
-	{1741307904, 1741324287},     // 103.202.64.0/18
-	{1741324288, 1741328383},     // 103.202.128.0/20
-	{1741328384, 1741329407},     // 103.202.144.0/22
-	{1741330432, 1741332479},     // 103.202.152.0/21
-	{1741332480, 1741340671},     // 103.202.160.0/19
-	{1741340672, 1741344767},     // 103.202.192.0/20
-	{1741345792, 1741346815},     // 103.202.212.0/22
-	{1741349888, 1741350911},     // 103.202.228.0/22
-	{1741351936, 1741352959},     // 103.202.236.0/22
-	{1741352960, 1741357055},     // 103.202.240.0/20
-	{1741357056, 1741365247},     // 103.203.0.0/19
-	{1741365248, 1741366271},     // 103.203.32.0/22
-	{1741381632, 1741389823},     // 103.203.96.0/19
-	{1741389824, 1741390847},     // 103.203.128.0/22
-	{1741392896, 1741393919},     // 103.203.140.0/22
-	{1741399040, 1741400063},     // 103.203.164.0/22
-	{1741400064, 1741401087},     // 103.203.168.0/22
-	{1741406208, 1741407231},     // 103.203.192.0/22
-	{1741408256, 1741409279},     // 103.203.200.0/22
-	{1741411328, 1741412351},     // 103.203.212.0/22
-	{1741412352, 1741413375},     // 103.203.216.0/22
-	{1741428736, 1741429759},     // 103.204.24.0/22
-	{1741441024, 1741442047},     // 103.204.72.0/22
-	{1741445120, 1741446143},     // 103.204.88.0/22
-	{1741451264, 1741452287},     // 103.204.112.0/22
-	{1741457408, 1741459455},     // 103.204.136.0/21
-	{1741459456, 1741461503},     // 103.204.144.0/21
-	{1741461504, 1741462527},     // 103.204.152.0/22
-	{1741472768, 1741473791},     // 103.204.196.0/22
-	{1741481984, 1741484031},     // 103.204.232.0/21
-	{1741489152, 1741490175},     // 103.205.4.0/22
-	{1741498368, 1741500415},     // 103.205.40.0/21
-	{1741501440, 1741502463},     // 103.205.52.0/22
-	{1741515776, 1741516799},     // 103.205.108.0/22
-	{1741517824, 1741518847},     // 103.205.116.0/22
-	{1741522944, 1741523967},     // 103.205.136.0/22
-	{1741529600, 1741529855},     // 103.205.162.0/24
-	{1741536256, 1741537279},     // 103.205.188.0/22
-	{1741537280, 1741539327},     // 103.205.192.0/21
-	{1741539328, 1741540351},     // 103.205.200.0/22
-	{1741548544, 1741549567},     // 103.205.236.0/22
-	{1741551616, 1741553663},     // 103.205.248.0/21
-	{1741553664, 1741554687},     // 103.206.0.0/22
-	{1741564928, 1741565951},     // 103.206.44.0/22
-	{1741591552, 1741592575},     // 103.206.148.0/22
-	{1741645824, 1741646847},     // 103.207.104.0/22
-	{1741666304, 1741668351},     // 103.207.184.0/21
-	{1741668352, 1741672447},     // 103.207.192.0/20
-	{1741672448, 1741674495},     // 103.207.208.0/21
-	{1741675520, 1741676543},     // 103.207.220.0/22
-	{1741677568, 1741678591},     // 103.207.228.0/22
-	{1741678592, 1741679615},     // 103.207.232.0/22
-	{1741687808, 1741688831},     // 103.208.12.0/22
-	{1741688832, 1741689855},     // 103.208.16.0/22
-	{1741691904, 1741692927},     // 103.208.28.0/22
-	{1741697024, 1741698047},     // 103.208.48.0/22
-	{1741722624, 1741723647},     // 103.208.148.0/22
-	{1741778944, 1741779967},     // 103.209.112.0/22
-	{1741785088, 1741786111},     // 103.209.136.0/22
-	{1741801472, 1741802495},     // 103.209.200.0/22
-	{1741803520, 1741804543},     // 103.209.208.0/22
-	{1741805568, 1741806591},     // 103.209.216.0/22
-	{1741815808, 1741816831},     // 103.210.0.0/22
-	{1741840384, 1741841407},     // 103.210.96.0/22
-	{1741855744, 1741856767},     // 103.210.156.0/22
-	{1741856768, 1741864959},     // 103.210.160.0/19
-	{1741871360, 1741871615},     // 103.210.217.0/24
-	{1741871616, 1741872127},     // 103.210.218.0/23
-	{1741892608, 1741893631},     // 103.211.44.0/22
-	{1741905920, 1741906431},     // 103.211.96.0/23
-	{1741906432, 1741906687},     // 103.211.98.0/24
-	{1741907456, 1741907967},     // 103.211.102.0/23
-	{1741921280, 1741922303},     // 103.211.156.0/22
-	{1741923584, 1741923839},     // 103.211.165.0/24
-	{1741924352, 1741925375},     // 103.211.168.0/22
-	{1741937664, 1741938687},     // 103.211.220.0/22
-	{1741944832, 1741945855},     // 103.211.248.0/22
-	{1741946880, 1741950975},     // 103.212.0.0/20
-	{1741958144, 1741959167},     // 103.212.44.0/22
-	{1741959168, 1741960191},     // 103.212.48.0/22
-	{1741968384, 1741969407},     // 103.212.84.0/22
-	{1741972480, 1741973503},     // 103.212.100.0/22
-	{1741984768, 1741985791},     // 103.212.148.0/22
-	{1741988864, 1741989887},     // 103.212.164.0/22
-	{1741997056, 1741998079},     // 103.212.196.0/22
-	{1741998080, 1741999103},     // 103.212.200.0/22
-	{1742011392, 1742012415},     // 103.212.252.0/22
-	{1742022656, 1742024703},     // 103.213.40.0/21
-	{1742024704, 1742028799},     // 103.213.48.0/20
-	{1742028800, 1742036991},     // 103.213.64.0/19
-	{1742036992, 1742038015},     // 103.213.96.0/22
-	{1742046208, 1742047231},     // 103.213.132.0/22
-	{1742047232, 1742049279},     // 103.213.136.0/21
-	{1742049280, 1742053375},     // 103.213.144.0/20
-	{1742053376, 1742061567},     // 103.213.160.0/19
-	{1742076928, 1742077951},     // 103.213.252.0/22
-	{1742090240, 1742091263},     // 103.214.48.0/22
-	{1742099456, 1742100479},     // 103.214.84.0/22
-	{1742132224, 1742133247},     // 103.214.212.0/22
-	{1742139392, 1742141439},     // 103.214.240.0/21
-	{1742150656, 1742151679},     // 103.215.28.0/22
-	{1742151680, 1742153727},     // 103.215.32.0/21
-	{1742154752, 1742155775},     // 103.215.44.0/22
-	{1742169088, 1742169599},     // 103.215.100.0/23
-	{1742171136, 1742172159},     // 103.215.108.0/22
-	{1742173184, 1742174207},     // 103.215.116.0/22
-	{1742174208, 1742175231},     // 103.215.120.0/22
-	{1742179328, 1742180351},     // 103.215.140.0/22
-	{1742210048, 1742211071},     // 103.216.4.0/22
-	{1742211072, 1742213119},     // 103.216.8.0/21
-	{1742213120, 1742217215},     // 103.216.16.0/20
-	{1742217216, 1742221311},     // 103.216.32.0/20
-	{1742225408, 1742226431},     // 103.216.64.0/22
-	{1742236672, 1742237695},     // 103.216.108.0/22
-	{1742243840, 1742244863},     // 103.216.136.0/22
-	{1742247936, 1742248959},     // 103.216.152.0/22
-	{1742266368, 1742268415},     // 103.216.224.0/21
-	{1742270464, 1742274559},     // 103.216.240.0/20
-	{1742274560, 1742290943},     // 103.217.0.0/18
-	{1742317568, 1742318591},     // 103.217.168.0/22
-	{1742320640, 1742321663},     // 103.217.180.0/22
-	{1742321664, 1742323711},     // 103.217.184.0/21
-	{1742323712, 1742327807},     // 103.217.192.0/20
-	{1742342144, 1742344191},     // 103.218.8.0/21
-	{1742344192, 1742346239},     // 103.218.16.0/21
-	{1742347264, 1742348287},     // 103.218.28.0/22
-	{1742348288, 1742356479},     // 103.218.32.0/19
-	{1742356480, 1742364671},     // 103.218.64.0/19
-	{1742389248, 1742393343},     // 103.218.192.0/20
-	{1742393344, 1742395391},     // 103.218.208.0/21
-	{1742395392, 1742396415},     // 103.218.216.0/22
-	{1742411776, 1742413823},     // 103.219.24.0/21
-	{1742413824, 1742415871},     // 103.219.32.0/21
-	{1742422016, 1742423039},     // 103.219.64.0/22
-	{1742427136, 1742428159},     // 103.219.84.0/22
-	{1742428160, 1742430207},     // 103.219.88.0/21
-	{1742430208, 1742432255},     // 103.219.96.0/21
-	{1742450688, 1742451711},     // 103.219.176.0/22
-	{1742452736, 1742453759},     // 103.219.184.0/22
-	{1742483456, 1742487551},     // 103.220.48.0/20
-	{1742487552, 1742488575},     // 103.220.64.0/22
-	{1742494720, 1742495743},     // 103.220.92.0/22
-	{1742495744, 1742496767},     // 103.220.96.0/22
-	{1742497792, 1742499839},     // 103.220.104.0/21
-	{1742500864, 1742501887},     // 103.220.116.0/22
-	{1742501888, 1742503935},     // 103.220.120.0/21
-	{1742503936, 1742508031},     // 103.220.128.0/20
-	{1742508032, 1742510079},     // 103.220.144.0/21
-	{1742510080, 1742511103},     // 103.220.152.0/22
-	{1742512128, 1742520319},     // 103.220.160.0/19
-	{1742520320, 1742522367},     // 103.220.192.0/21
-	{1742522368, 1742523391},     // 103.220.200.0/22
-	{1742532608, 1742534655},     // 103.220.240.0/21
-	{1742535680, 1742536703},     // 103.220.252.0/22
-	{1742538752, 1742540799},     // 103.221.8.0/21
-	{1742544128, 1742544383},     // 103.221.29.0/24
-	{1742544384, 1742544895},     // 103.221.30.0/23
-	{1742544896, 1742545919},     // 103.221.32.0/22
-	{1742559232, 1742560255},     // 103.221.88.0/22
-	{1742561280, 1742569471},     // 103.221.96.0/19
-	{1742569472, 1742585855},     // 103.221.128.0/18
-	{1742585856, 1742589951},     // 103.221.192.0/20
-	{1742602240, 1742606335},     // 103.222.0.0/20
-	{1742606336, 1742607359},     // 103.222.16.0/22
-	{1742608384, 1742610431},     // 103.222.24.0/21
-	{1742610688, 1742610943},     // 103.222.33.0/24
-	{1742610944, 1742611455},     // 103.222.34.0/23
-	{1742611456, 1742612479},     // 103.222.36.0/22
-	{1742612480, 1742614527},     // 103.222.40.0/21
-	{1742614528, 1742618623},     // 103.222.48.0/20
-	{1742618624, 1742635007},     // 103.222.64.0/18
-	{1742635008, 1742651391},     // 103.222.128.0/18
-	{1742651392, 1742659583},     // 103.222.192.0/19
-	{1742659584, 1742661631},     // 103.222.224.0/21
-	{1742661632, 1742662655},     // 103.222.232.0/22
-	{1742663680, 1742665727},     // 103.222.240.0/21
-	{1742671872, 1742675967},     // 103.223.16.0/20
-	{1742675968, 1742684159},     // 103.223.32.0/19
-	{1742684160, 1742692351},     // 103.223.64.0/19
-	{1742692352, 1742696447},     // 103.223.96.0/20
-	{1742696448, 1742698495},     // 103.223.112.0/21
-	{1742699520, 1742700543},     // 103.223.124.0/22
-	{1742700544, 1742702591},     // 103.223.128.0/21
-	{1742703616, 1742704639},     // 103.223.140.0/22
-	{1742704640, 1742708735},     // 103.223.144.0/20
-	{1742708736, 1742712831},     // 103.223.160.0/20
-	{1742712832, 1742714879},     // 103.223.176.0/21
-	{1742715904, 1742716927},     // 103.223.188.0/22
-	{1742716928, 1742733311},     // 103.223.192.0/18
-	{1742733312, 1742734335},     // 103.224.0.0/22
-	{1742743552, 1742745599},     // 103.224.40.0/21
-	{1742748672, 1742749695},     // 103.224.60.0/22
-	{1742789632, 1742790655},     // 103.224.220.0/22
-	{1742790656, 1742792703},     // 103.224.224.0/21
-	{1742792704, 1742793727},     // 103.224.232.0/22
-	{1742807040, 1742808063},     // 103.225.32.0/22
-	{1742874624, 1742875647},     // 103.226.40.0/22
-	{1742878976, 1742879231},     // 103.226.57.0/24
-	{1742879232, 1742879743},     // 103.226.58.0/23
-	{1742879744, 1742880767},     // 103.226.60.0/22
-	{1742884864, 1742885887},     // 103.226.80.0/22
-	{1742894080, 1742895103},     // 103.226.116.0/22
-	{1742898176, 1742899199},     // 103.226.132.0/22
-	{1742904320, 1742905343},     // 103.226.156.0/22
-	{1742910464, 1742911487},     // 103.226.180.0/22
-	{1742914560, 1742915583},     // 103.226.196.0/22
-	{1742942208, 1742943231},     // 103.227.48.0/22
-	{1742948352, 1742950399},     // 103.227.72.0/21
-	{1742950400, 1742951423},     // 103.227.80.0/22
-	{1742955520, 1742956543},     // 103.227.100.0/22
-	{1742960640, 1742961663},     // 103.227.120.0/22
-	{1742963712, 1742964735},     // 103.227.132.0/22
-	{1742964736, 1742965759},     // 103.227.136.0/22
-	{1742980096, 1742981119},     // 103.227.196.0/22
-	{1742982144, 1742982655},     // 103.227.204.0/23
-	{1742982656, 1742982911},     // 103.227.206.0/24
-	{1742984192, 1742985215},     // 103.227.212.0/22
-	{1742988288, 1742989311},     // 103.227.228.0/22
-	{1742998528, 1742999551},     // 103.228.12.0/22
-	{1743017984, 1743019007},     // 103.228.88.0/22
-	{1743030272, 1743031295},     // 103.228.136.0/22
-	{1743036416, 1743037439},     // 103.228.160.0/22
-	{1743040512, 1743041535},     // 103.228.176.0/22
-	{1743047680, 1743048703},     // 103.228.204.0/22
-	{1743048704, 1743049727},     // 103.228.208.0/22
-	{1743053824, 1743054847},     // 103.228.228.0/22
-	{1743054848, 1743055871},     // 103.228.232.0/22
-	{1743066112, 1743067135},     // 103.229.20.0/22
-	{1743095808, 1743096831},     // 103.229.136.0/22
-	{1743098880, 1743099903},     // 103.229.148.0/22
-	{1743105024, 1743106047},     // 103.229.172.0/22
-	{1743115264, 1743116287},     // 103.229.212.0/22
-	{1743116288, 1743118335},     // 103.229.216.0/21
-	{1743119360, 1743120383},     // 103.229.228.0/22
-	{1743121408, 1743122431},     // 103.229.236.0/22
-	{1743122432, 1743123455},     // 103.229.240.0/22
-	{1743126528, 1743127551},     // 103.230.0.0/22
-	{1743133696, 1743134719},     // 103.230.28.0/22
-	{1743136768, 1743138815},     // 103.230.40.0/21
-	{1743151104, 1743152127},     // 103.230.96.0/22
-	{1743176704, 1743177727},     // 103.230.196.0/22
-	{1743177728, 1743179775},     // 103.230.200.0/21
-	{1743180800, 1743181823},     // 103.230.212.0/22
-	{1743186944, 1743187967},     // 103.230.236.0/22
-	{1743196160, 1743198207},     // 103.231.16.0/21
-	{1743208448, 1743210495},     // 103.231.64.0/21
-	{1743228928, 1743229951},     // 103.231.144.0/22
-	{1743238144, 1743239167},     // 103.231.180.0/22
-	{1743254528, 1743255551},     // 103.231.244.0/22
-	{1743258624, 1743259647},     // 103.232.4.0/22
-	{1743262120, 1743262127},     // 103.232.17.168/29
-	{1743294464, 1743295487},     // 103.232.144.0/22
-	{1743324160, 1743325183},     // 103.233.4.0/22
-	{1743334400, 1743335423},     // 103.233.44.0/22
-	{1743336448, 1743337471},     // 103.233.52.0/22
-	{1743349760, 1743350783},     // 103.233.104.0/22
-	{1743355904, 1743356927},     // 103.233.128.0/22
-	{1743357952, 1743358975},     // 103.233.136.0/22
-	{1743381504, 1743382527},     // 103.233.228.0/22
-	{1743388672, 1743389695},     // 103.234.0.0/22
-	{1743393792, 1743394815},     // 103.234.20.0/22
-	{1743403008, 1743404031},     // 103.234.56.0/22
-	{1743420416, 1743421439},     // 103.234.124.0/22
-	{1743421440, 1743422463},     // 103.234.128.0/22
-	{1743432704, 1743433727},     // 103.234.172.0/22
-	{1743434752, 1743435775},     // 103.234.180.0/22
-	{1743468544, 1743470591},     // 103.235.56.0/21
-	{1743474688, 1743475711},     // 103.235.80.0/22
-	{1743475968, 1743476223},     // 103.235.85.0/24
-	{1743476480, 1743476735},     // 103.235.87.0/24
-	{1743486976, 1743491071},     // 103.235.128.0/20
-	{1743491072, 1743493119},     // 103.235.144.0/21
-	{1743501312, 1743502335},     // 103.235.184.0/22
-	{1743503360, 1743504383},     // 103.235.192.0/22
-	{1743505408, 1743506431},     // 103.235.200.0/22
-	{1743510528, 1743511551},     // 103.235.220.0/22
-	{1743511552, 1743519743},     // 103.235.224.0/19
-	{1743519744, 1743536127},     // 103.236.0.0/18
-	{1743536128, 1743544319},     // 103.236.64.0/19
-	{1743544320, 1743545343},     // 103.236.96.0/22
-	{1743550464, 1743551487},     // 103.236.120.0/22
-	{1743566848, 1743567871},     // 103.236.184.0/22
-	{1743581184, 1743585279},     // 103.236.240.0/20
-	{1743585280, 1743589375},     // 103.237.0.0/20
-	{1743591424, 1743593471},     // 103.237.24.0/21
-	{1743602688, 1743603711},     // 103.237.68.0/22
-	{1743607808, 1743608831},     // 103.237.88.0/22
-	{1743624192, 1743625215},     // 103.237.152.0/22
-	{1743630336, 1743634431},     // 103.237.176.0/20
-	{1743634432, 1743650815},     // 103.237.192.0/18
-	{1743650816, 1743652863},     // 103.238.0.0/21
-	{1743655424, 1743655935},     // 103.238.18.0/23
-	{1743655936, 1743656959},     // 103.238.20.0/22
-	{1743656960, 1743659007},     // 103.238.24.0/21
-	{1743659008, 1743663103},     // 103.238.32.0/20
-	{1743663104, 1743665151},     // 103.238.48.0/21
-	{1743665152, 1743666175},     // 103.238.56.0/22
-	{1743673344, 1743675391},     // 103.238.88.0/21
-	{1743675392, 1743676415},     // 103.238.96.0/22
-	{1743684608, 1743685631},     // 103.238.132.0/22
-	{1743686656, 1743687679},     // 103.238.140.0/22
-	{1743687680, 1743688703},     // 103.238.144.0/22
-	{1743691776, 1743692799},     // 103.238.160.0/22
-	{1743693056, 1743693311},     // 103.238.165.0/24
-	{1743693312, 1743693823},     // 103.238.166.0/23
-	{1743693824, 1743695871},     // 103.238.168.0/21
-	{1743695872, 1743699967},     // 103.238.176.0/20
-	{1743700992, 1743702015},     // 103.238.196.0/22
-	{1743703040, 1743704063},     // 103.238.204.0/22
-	{1743715328, 1743716351},     // 103.238.252.0/22
-	{1743716352, 1743717375},     // 103.239.0.0/22
-	{1743727616, 1743728639},     // 103.239.44.0/22
-	{1743733760, 1743734783},     // 103.239.68.0/22
-	{1743755264, 1743757311},     // 103.239.152.0/21
-	{1743762432, 1743763455},     // 103.239.180.0/22
-	{1743763456, 1743764479},     // 103.239.184.0/22
-	{1743765504, 1743767551},     // 103.239.192.0/21
-	{1743768576, 1743769599},     // 103.239.204.0/22
-	{1743769600, 1743770623},     // 103.239.208.0/22
-	{1743773696, 1743774719},     // 103.239.224.0/22
-	{1743778816, 1743779839},     // 103.239.244.0/22
-	{1743785984, 1743787007},     // 103.240.16.0/22
-	{1743791104, 1743792127},     // 103.240.36.0/22
-	{1743800320, 1743801343},     // 103.240.72.0/22
-	{1743803392, 1743804415},     // 103.240.84.0/22
-	{1743813632, 1743814655},     // 103.240.124.0/22
-	{1743825920, 1743826943},     // 103.240.172.0/22
-	{1743830016, 1743831039},     // 103.240.188.0/22
-	{1743844352, 1743845375},     // 103.240.244.0/22
-	{1743850496, 1743851519},     // 103.241.12.0/22
-	{1743870976, 1743871999},     // 103.241.92.0/22
-	{1743872000, 1743873023},     // 103.241.96.0/22
-	{1743888384, 1743889407},     // 103.241.160.0/22
-	{1743894528, 1743896575},     // 103.241.184.0/21
-	{1743903744, 1743904767},     // 103.241.220.0/22
-	{1743929344, 1743929855},     // 103.242.64.0/23
-	{1743945728, 1743946239},     // 103.242.128.0/23
-	{1743953920, 1743954943},     // 103.242.160.0/22
-	{1743955968, 1743958015},     // 103.242.168.0/21
-	{1743958016, 1743959039},     // 103.242.176.0/22
-	{1743964160, 1743965183},     // 103.242.200.0/22
-	{1743967232, 1743968255},     // 103.242.212.0/22
-	{1743969280, 1743970303},     // 103.242.220.0/22
-	{1743974400, 1743975423},     // 103.242.240.0/22
-	{1744013312, 1744014335},     // 103.243.136.0/22
-	{1744043008, 1744044031},     // 103.243.252.0/22
-	{1744048128, 1744049151},     // 103.244.16.0/22
-	{1744058880, 1744059391},     // 103.244.58.0/23
-	{1744059392, 1744060415},     // 103.244.60.0/22
-	{1744060416, 1744064511},     // 103.244.64.0/20
-	{1744064512, 1744066559},     // 103.244.80.0/21
-	{1744073728, 1744074751},     // 103.244.116.0/22
-	{1744086016, 1744087039},     // 103.244.164.0/22
-	{1744103424, 1744104447},     // 103.244.232.0/22
-	{1744108544, 1744109567},     // 103.244.252.0/22
-	{1744115456, 1744115711},     // 103.245.23.0/24
-	{1744122880, 1744123903},     // 103.245.52.0/22
-	{1744124928, 1744125951},     // 103.245.60.0/22
-	{1744130048, 1744131071},     // 103.245.80.0/22
-	{1744141312, 1744142335},     // 103.245.124.0/22
-	{1744142336, 1744143359},     // 103.245.128.0/22
-	{1744177152, 1744179199},     // 103.246.8.0/21
-	{1744205824, 1744207871},     // 103.246.120.0/21
-	{1744208896, 1744209919},     // 103.246.132.0/22
-	{1744214016, 1744215039},     // 103.246.152.0/22
-	{1744283648, 1744285695},     // 103.247.168.0/21
-	{1744285696, 1744286719},     // 103.247.176.0/22
-	{1744291840, 1744292863},     // 103.247.200.0/22
-	{1744294912, 1744295935},     // 103.247.212.0/22
-	{1744322560, 1744323071},     // 103.248.64.0/23
-	{1744331776, 1744332799},     // 103.248.100.0/22
-	{1744337920, 1744338943},     // 103.248.124.0/22
-	{1744345088, 1744346111},     // 103.248.152.0/22
-	{1744349184, 1744350207},     // 103.248.168.0/22
-	{1744355328, 1744356351},     // 103.248.192.0/22
-	{1744360448, 1744361471},     // 103.248.212.0/22
-	{1744363520, 1744365567},     // 103.248.224.0/21
-	{1744373760, 1744375807},     // 103.249.8.0/21
-	{1744385024, 1744386047},     // 103.249.52.0/22
-	{1744404480, 1744405503},     // 103.249.128.0/22
-	{1744406528, 1744407551},     // 103.249.136.0/22
-	{1744408576, 1744409599},     // 103.249.144.0/22
-	{1744413696, 1744414719},     // 103.249.164.0/22
-	{1744414720, 1744416767},     // 103.249.168.0/21
-	{1744416768, 1744417791},     // 103.249.176.0/22
-	{1744419840, 1744420863},     // 103.249.188.0/22
-	{1744420864, 1744421887},     // 103.249.192.0/22
-	{1744434176, 1744435199},     // 103.249.244.0/22
-	{1744436224, 1744437247},     // 103.249.252.0/22
-	{1744445440, 1744446463},     // 103.250.32.0/22
-	{1744463872, 1744464895},     // 103.250.104.0/22
-	{1744468992, 1744470015},     // 103.250.124.0/22
-	{1744483328, 1744484351},     // 103.250.180.0/22
-	{1744486400, 1744487423},     // 103.250.192.0/22
-	{1744492544, 1744493567},     // 103.250.216.0/22
-	{1744494592, 1744495615},     // 103.250.224.0/22
-	{1744497664, 1744498687},     // 103.250.236.0/22
-	{1744500736, 1744502783},     // 103.250.248.0/21
-	{1744510976, 1744511999},     // 103.251.32.0/22
-	{1744524288, 1744525311},     // 103.251.84.0/22
-	{1744527360, 1744528383},     // 103.251.96.0/22
-	{1744534528, 1744535551},     // 103.251.124.0/22
-	{1744543744, 1744544767},     // 103.251.160.0/22
-	{1744551936, 1744552959},     // 103.251.192.0/22
-	{1744555008, 1744556031},     // 103.251.204.0/22
-	{1744564224, 1744565247},     // 103.251.240.0/22
-	{1744575488, 1744576511},     // 103.252.28.0/22
-	{1744577536, 1744578559},     // 103.252.36.0/22
-	{1744584704, 1744585727},     // 103.252.64.0/22
-	{1744592896, 1744593919},     // 103.252.96.0/22
-	{1744594944, 1744595967},     // 103.252.104.0/22
-	{1744612352, 1744613375},     // 103.252.172.0/22
-	{1744620544, 1744621567},     // 103.252.204.0/22
-	{1744621568, 1744622591},     // 103.252.208.0/22
-	{1744627712, 1744628735},     // 103.252.232.0/22
-	{1744631808, 1744632831},     // 103.252.248.0/22
-	{1744634880, 1744635903},     // 103.253.4.0/22
-	{1744649216, 1744650239},     // 103.253.60.0/22
-	{1744686080, 1744687103},     // 103.253.204.0/22
-	{1744690176, 1744691199},     // 103.253.220.0/22
-	{1744691200, 1744692223},     // 103.253.224.0/22
-	{1744693248, 1744694271},     // 103.253.232.0/22
-	{1744701440, 1744702463},     // 103.254.8.0/22
-	{1744704512, 1744705535},     // 103.254.20.0/22
-	{1744715776, 1744717823},     // 103.254.64.0/21
-	{1744718848, 1744719871},     // 103.254.76.0/22
-	{1744728064, 1744729087},     // 103.254.112.0/22
-	{1744744448, 1744745471},     // 103.254.176.0/22
-	{1744747520, 1744748543},     // 103.254.188.0/22
-	{1744782336, 1744783359},     // 103.255.68.0/22
-	{1744787456, 1744789503},     // 103.255.88.0/21
-	{1744799744, 1744801791},     // 103.255.136.0/21
-	{1744812032, 1744813055},     // 103.255.184.0/22
-	{1744816128, 1744817151},     // 103.255.200.0/22
-	{1744818176, 1744819199},     // 103.255.208.0/22
-	{1744823296, 1744824319},     // 103.255.228.0/22
-	{1778384896, 1778385151},     // 106.0.0.0/24
-	{1778385408, 1778385919},     // 106.0.2.0/23
-	{1778385920, 1778386943},     // 106.0.4.0/22
-	{1778386944, 1778388991},     // 106.0.8.0/21
-	{1778388992, 1778393087},     // 106.0.16.0/20
-	{1778396160, 1778397183},     // 106.0.44.0/22
-	{1778401280, 1778417663},     // 106.0.64.0/18
-	{1778515968, 1778548735},     // 106.2.0.0/17
-	{1778548736, 1778552831},     // 106.2.128.0/20
-	{1778552832, 1778554879},     // 106.2.144.0/21
-	{1778554880, 1778555903},     // 106.2.152.0/22
-	{1778555904, 1778556415},     // 106.2.156.0/23
-	{1778556928, 1778565119},     // 106.2.160.0/19
-	{1778565120, 1778581503},     // 106.2.192.0/18
-	{1778585600, 1778589695},     // 106.3.16.0/20
-	{1778589696, 1778597887},     // 106.3.32.0/19
-	{1778597888, 1778601983},     // 106.3.64.0/20
-	{1778601984, 1778603007},     // 106.3.80.0/22
-	{1778604032, 1778606079},     // 106.3.88.0/21
-	{1778606080, 1778614271},     // 106.3.96.0/19
-	{1778614272, 1778622463},     // 106.3.128.0/19
-	{1778623488, 1778624511},     // 106.3.164.0/22
-	{1778624512, 1778626559},     // 106.3.168.0/21
-	{1778626560, 1778630655},     // 106.3.176.0/20
-	{1778630656, 1778647039},     // 106.3.192.0/18
-	{1778647040, 1778909183},     // 106.4.0.0/14
-	{1778909184, 1779040255},     // 106.8.0.0/15
-	{1779105792, 1779171327},     // 106.11.0.0/16
-	{1779171328, 1779433471},     // 106.12.0.0/14
-	{1779433472, 1780482047},     // 106.16.0.0/12
-	{1780482048, 1781530623},     // 106.32.0.0/12
-	{1781530624, 1781532671},     // 106.48.0.0/21
-	{1781532672, 1781533695},     // 106.48.8.0/22
-	{1781534720, 1781538815},     // 106.48.16.0/20
-	{1781538816, 1781542911},     // 106.48.32.0/20
-	{1781545216, 1781545471},     // 106.48.57.0/24
-	{1781545984, 1781546239},     // 106.48.60.0/24
-	{1781546752, 1781547007},     // 106.48.63.0/24
-	{1781547008, 1781563391},     // 106.48.64.0/18
-	{1781563392, 1781596159},     // 106.48.128.0/17
-	{1781596416, 1781596671},     // 106.49.1.0/24
-	{1781596672, 1781597183},     // 106.49.2.0/23
-	{1781597184, 1781598207},     // 106.49.4.0/22
-	{1781598208, 1781600255},     // 106.49.8.0/21
-	{1781600256, 1781604351},     // 106.49.16.0/20
-	{1781604352, 1781612543},     // 106.49.32.0/19
-	{1781612544, 1781620735},     // 106.49.64.0/19
-	{1781620736, 1781620991},     // 106.49.96.0/24
-	{1781621248, 1781621759},     // 106.49.98.0/23
-	{1781621760, 1781622783},     // 106.49.100.0/22
-	{1781622784, 1781624831},     // 106.49.104.0/21
-	{1781624832, 1781628927},     // 106.49.112.0/20
-	{1781628928, 1781661695},     // 106.49.128.0/17
-	{1781661696, 1781727231},     // 106.50.0.0/16
-	{1781792768, 1782054911},     // 106.52.0.0/14
-	{1782054912, 1782579199},     // 106.56.0.0/13
-	{1783234560, 1783300095},     // 106.74.0.0/16
-	{1783300096, 1783332863},     // 106.75.0.0/17
-	{1783332864, 1783349247},     // 106.75.128.0/18
-	{1783351552, 1783351807},     // 106.75.201.0/24
-	{1783352320, 1783353343},     // 106.75.204.0/22
-	{1783353344, 1783357439},     // 106.75.208.0/20
-	{1783357440, 1783365631},     // 106.75.224.0/19
-	{1783627776, 1784676351},     // 106.80.0.0/12
-	{1785462784, 1785724927},     // 106.108.0.0/14
-	{1785724928, 1786773503},     // 106.112.0.0/12
-	{1793064960, 1794113535},     // 106.224.0.0/12
-	{1806696448, 1806827519},     // 107.176.0.0/15
-	{1833370624, 1833370879},     // 109.71.4.0/24
-	{1844707328, 1844772863},     // 109.244.0.0/16
-	{1845886976, 1846018047},     // 110.6.0.0/15
-	{1846542336, 1846804479},     // 110.16.0.0/14
-	{1847732224, 1847734271},     // 110.34.40.0/21
-	{1848115200, 1848246271},     // 110.40.0.0/15
-	{1848246272, 1848311807},     // 110.42.0.0/16
-	{1848311808, 1848328191},     // 110.43.0.0/18
-	{1848328192, 1848330239},     // 110.43.64.0/21
-	{1848330240, 1848331263},     // 110.43.72.0/22
-	{1848331264, 1848331775},     // 110.43.76.0/23
-	{1848332288, 1848336383},     // 110.43.80.0/20
-	{1848336384, 1848344575},     // 110.43.96.0/19
-	{1848344576, 1848377343},     // 110.43.128.0/17
-	{1848380416, 1848381439},     // 110.44.12.0/22
-	{1848414208, 1848418303},     // 110.44.144.0/20
-	{1848639488, 1848705023},     // 110.48.0.0/16
-	{1848836096, 1848901631},     // 110.51.0.0/16
-	{1848901632, 1849032703},     // 110.52.0.0/15
-	{1849163776, 1849688063},     // 110.56.0.0/13
-	{1849688064, 1849819135},     // 110.64.0.0/15
-	{1850212352, 1850343423},     // 110.72.0.0/15
-	{1850408960, 1850474495},     // 110.75.0.0/16
-	{1850474496, 1850478591},     // 110.76.0.0/20
-	{1850478592, 1850479615},     // 110.76.16.0/22
-	{1850479616, 1850479871},     // 110.76.20.0/24
-	{1850480128, 1850480383},     // 110.76.22.0/24
-	{1850480640, 1850482687},     // 110.76.24.0/21
-	{1850482688, 1850490879},     // 110.76.32.0/19
-	{1850508288, 1850509311},     // 110.76.132.0/22
-	{1850514432, 1850515455},     // 110.76.156.0/22
-	{1850521600, 1850522623},     // 110.76.184.0/22
-	{1850523648, 1850540031},     // 110.76.192.0/18
-	{1850540032, 1850572799},     // 110.77.0.0/17
-	{1850736640, 1851260927},     // 110.80.0.0/13
-	{1851260928, 1851523071},     // 110.88.0.0/14
-	{1851540480, 1851541503},     // 110.92.68.0/22
-	{1851596800, 1851604991},     // 110.93.32.0/19
-	{1851654144, 1851785215},     // 110.94.0.0/15
-	{1851785216, 1853882367},     // 110.96.0.0/11
-	{1855455232, 1855717375},     // 110.152.0.0/14
-	{1855717376, 1855848447},     // 110.156.0.0/15
-	{1856372736, 1856503807},     // 110.166.0.0/15
-	{1856815104, 1856831487},     // 110.172.192.0/18
-	{1856831488, 1856839679},     // 110.173.0.0/19
-	{1856839680, 1856843775},     // 110.173.32.0/20
-	{1856847872, 1856856063},     // 110.173.64.0/19
-	{1856880640, 1856888831},     // 110.173.192.0/19
-	{1857028096, 1858076671},     // 110.176.0.0/12
-	{1858076672, 1860173823},     // 110.192.0.0/11
-	{1860435968, 1860698111},     // 110.228.0.0/14
-	{1860706304, 1860714495},     // 110.232.32.0/19
-	{1860960256, 1861091327},     // 110.236.0.0/15
-	{1861222400, 1862270975},     // 110.240.0.0/12
-	{1862270976, 1866465279},     // 111.0.0.0/10
-	{1866596352, 1866661887},     // 111.66.0.0/16
-	{1866711040, 1866715135},     // 111.67.192.0/20
-	{1866743808, 1866751999},     // 111.68.64.0/19
-	{1866989568, 1867513855},     // 111.72.0.0/13
-	{1867841536, 1867907071},     // 111.85.0.0/16
-	{1868283904, 1868292095},     // 111.91.192.0/19
-	{1868363776, 1868365823},     // 111.92.248.0/21
-	{1869611008, 1869873151},     // 111.112.0.0/14
-	{1869873152, 1870004223},     // 111.116.0.0/15
-	{1870055424, 1870057471},     // 111.118.200.0/21
-	{1870086144, 1870102527},     // 111.119.64.0/18
-	{1870102528, 1870110719},     // 111.119.128.0/19
-	{1870135296, 1870397439},     // 111.120.0.0/14
-	{1870397440, 1870462975},     // 111.124.0.0/16
-	{1870528512, 1870659583},     // 111.126.0.0/15
-	{1870659584, 1872756735},     // 111.128.0.0/11
-	{1872756736, 1873281023},     // 111.160.0.0/13
-	{1873412096, 1873477631},     // 111.170.0.0/16
-	{1873543168, 1873805311},     // 111.172.0.0/14
-	{1873805312, 1874329599},     // 111.176.0.0/13
-	{1874460672, 1874591743},     // 111.186.0.0/15
-	{1874853888, 1875902463},     // 111.192.0.0/12
-	{1875902464, 1876426751},     // 111.208.0.0/13
-	{1876761600, 1876761855},     // 111.221.28.0/24
-	{1876787200, 1876819967},     // 111.221.128.0/17
-	{1876819968, 1876885503},     // 111.222.0.0/16
-	{1876886528, 1876887551},     // 111.223.4.0/22
-	{1876887552, 1876889599},     // 111.223.8.0/21
-	{1876889600, 1876890623},     // 111.223.16.0/22
-	{1876946944, 1876947967},     // 111.223.240.0/22
-	{1876949248, 1876949503},     // 111.223.249.0/24
-	{1876949504, 1876950015},     // 111.223.250.0/23
-	{1876951040, 1877475327},     // 111.224.0.0/13
-	{1877696512, 1877704703},     // 111.235.96.0/19
-	{1877711872, 1877712895},     // 111.235.156.0/22
-	{1877712896, 1877714943},     // 111.235.160.0/21
-	{1877715456, 1877715967},     // 111.235.170.0/23
-	{1877715968, 1877716991},     // 111.235.172.0/22
-	{1877716992, 1877721087},     // 111.235.176.0/20
-	{1879048192, 1883242495},     // 112.0.0.0/10
-	{1883242496, 1883504639},     // 112.64.0.0/14
-	{1883848704, 1883865087},     // 112.73.64.0/18
-	{1883897856, 1883963391},     // 112.74.0.0/16
-	{1884291072, 1885339647},     // 112.80.0.0/12
-	{1885339648, 1885863935},     // 112.96.0.0/13
-	{1886224384, 1886257151},     // 112.109.128.0/17
-	{1886322688, 1886388223},     // 112.111.0.0/16
-	{1886388224, 1886650367},     // 112.112.0.0/14
-	{1886650368, 1886781439},     // 112.116.0.0/15
-	{1887043584, 1887174655},     // 112.122.0.0/15
-	{1887174656, 1887436799},     // 112.124.0.0/14
-	{1887436800, 1887698943},     // 112.128.0.0/14
-	{1887698944, 1887764479},     // 112.132.0.0/16
-	{1888038912, 1888040959},     // 112.137.48.0/21
-	{1891631104, 1891893247},     // 112.192.0.0/14
-	{1893728256, 1895825407},     // 112.224.0.0/11
-	{1895825408, 1896349695},     // 113.0.0.0/13
-	{1896349696, 1896480767},     // 113.8.0.0/15
-	{1896595456, 1896603647},     // 113.11.192.0/19
-	{1896611840, 1896873983},     // 113.12.0.0/14
-	{1896873984, 1897005055},     // 113.16.0.0/15
-	{1897005056, 1897070591},     // 113.18.0.0/16
-	{1897261056, 1897263103},     // 113.21.232.0/21
-	{1897398272, 1897660415},     // 113.24.0.0/14
-	{1897857024, 1897922559},     // 113.31.0.0/16
-	{1898708992, 1898971135},     // 113.44.0.0/14
-	{1898971136, 1899233279},     // 113.48.0.0/14
-	{1899274240, 1899282431},     // 113.52.160.0/19
-	{1899291648, 1899292671},     // 113.52.228.0/22
-	{1899364352, 1899495423},     // 113.54.0.0/15
-	{1899495424, 1899626495},     // 113.56.0.0/15
-	{1899626496, 1899692031},     // 113.58.0.0/16
-	{1899692032, 1899724799},     // 113.59.0.0/17
-	{1899749376, 1899750399},     // 113.59.224.0/22
-	{1899888640, 1900019711},     // 113.62.0.0/15
-	{1900019712, 1904214015},     // 113.64.0.0/10
-	{1904214016, 1904345087},     // 113.128.0.0/15
-	{1904369664, 1904373759},     // 113.130.96.0/20
-	{1904373760, 1904375807},     // 113.130.112.0/21
-	{1904476160, 1904738303},     // 113.132.0.0/14
-	{1904738304, 1905262591},     // 113.136.0.0/13
-	{1908539392, 1908670463},     // 113.194.0.0/15
-	{1908761600, 1908762111},     // 113.197.100.0/23
-	{1908762112, 1908762367},     // 113.197.102.0/24
-	{1908762624, 1908763647},     // 113.197.104.0/22
-	{1908932608, 1909063679},     // 113.200.0.0/15
-	{1909063680, 1909129215},     // 113.202.0.0/16
-	{1909194752, 1909456895},     // 113.204.0.0/14
-	{1909481472, 1909489663},     // 113.208.96.0/19
-	{1909489664, 1909522431},     // 113.208.128.0/17
-	{1909522432, 1909587967},     // 113.209.0.0/16
-	{1909719040, 1909735423},     // 113.212.0.0/18
-	{1909744640, 1909745663},     // 113.212.100.0/22
-	{1909766144, 1909768191},     // 113.212.184.0/21
-	{1909784576, 1909817343},     // 113.213.0.0/17
-	{1909850112, 1909981183},     // 113.214.0.0/15
-	{1910112256, 1910243327},     // 113.218.0.0/15
-	{1910243328, 1910505471},     // 113.220.0.0/14
-	{1910505472, 1911554047},     // 113.224.0.0/12
-	{1911554048, 1912078335},     // 113.240.0.0/13
-	{1912078336, 1912340479},     // 113.248.0.0/14
-	{1914437632, 1914470399},     // 114.28.0.0/17
-	{1914470400, 1914486783},     // 114.28.128.0/18
-	{1914487296, 1914487807},     // 114.28.194.0/23
-	{1914487808, 1914488831},     // 114.28.196.0/22
-	{1914488832, 1914490879},     // 114.28.200.0/21
-	{1914490880, 1914494975},     // 114.28.208.0/20
-	{1914497024, 1914498047},     // 114.28.232.0/22
-	{1914499072, 1914503167},     // 114.28.240.0/20
-	{1914650624, 1914652671},     // 114.31.64.0/21
-	{1916141568, 1916272639},     // 114.54.0.0/15
-	{1916534784, 1916796927},     // 114.60.0.0/14
-	{1916796928, 1917059071},     // 114.64.0.0/14
-	{1917059072, 1917124607},     // 114.68.0.0/16
-	{1917796352, 1917812735},     // 114.79.64.0/18
-	{1917845504, 1918894079},     // 114.80.0.0/12
-	{1918894080, 1919418367},     // 114.96.0.0/13
-	{1919418368, 1919680511},     // 114.104.0.0/14
-	{1919811584, 1919815679},     // 114.110.0.0/20
-	{1919827968, 1919844351},     // 114.110.64.0/18
-	{1919877120, 1919885311},     // 114.111.0.0/19
-	{1919918080, 1919926271},     // 114.111.160.0/19
-	{1919943680, 1919944703},     // 114.112.4.0/22
-	{1919944704, 1919945727},     // 114.112.8.0/22
-	{1919948800, 1919950847},     // 114.112.24.0/21
-	{1919950848, 1919959039},     // 114.112.32.0/19
-	{1919959040, 1919967231},     // 114.112.64.0/19
-	{1919967232, 1919971327},     // 114.112.96.0/20
-	{1919972352, 1919973375},     // 114.112.116.0/22
-	{1919973376, 1919975423},     // 114.112.120.0/21
-	{1919977472, 1919979519},     // 114.112.136.0/21
-	{1919979520, 1919983615},     // 114.112.144.0/20
-	{1919983616, 1919991807},     // 114.112.160.0/19
-	{1919991808, 1919999999},     // 114.112.192.0/19
-	{1920008192, 1920040959},     // 114.113.0.0/17
-	{1920040960, 1920043007},     // 114.113.128.0/21
-	{1920044032, 1920045055},     // 114.113.140.0/22
-	{1920045056, 1920049151},     // 114.113.144.0/20
-	{1920049152, 1920057343},     // 114.113.160.0/19
-	{1920058368, 1920059391},     // 114.113.196.0/22
-	{1920059392, 1920061439},     // 114.113.200.0/21
-	{1920061440, 1920065535},     // 114.113.208.0/20
-	{1920065536, 1920069631},     // 114.113.224.0/20
-	{1920073728, 1920204799},     // 114.114.0.0/15
-	{1920204800, 1920335871},     // 114.116.0.0/15
-	{1920335872, 1920401407},     // 114.118.0.0/16
-	{1920401408, 1920434175},     // 114.119.0.0/17
-	{1920450560, 1920466943},     // 114.119.192.0/18
-	{1921253376, 1921318911},     // 114.132.0.0/16
-	{1921449984, 1921515519},     // 114.135.0.0/16
-	{1921646592, 1921777663},     // 114.138.0.0/15
-	{1921859584, 1921861631},     // 114.141.64.0/21
-	{1921863680, 1921865727},     // 114.141.80.0/21
-	{1921875968, 1921892351},     // 114.141.128.0/18
-	{1925447680, 1925578751},     // 114.196.0.0/15
-	{1925642240, 1925644287},     // 114.198.248.0/21
-	{1926234112, 1927282687},     // 114.208.0.0/12
-	{1927282688, 1929379839},     // 114.224.0.0/11
-	{1930952704, 1931214847},     // 115.24.0.0/14
-	{1931214848, 1931345919},     // 115.28.0.0/15
-	{1931427840, 1931431935},     // 115.31.64.0/20
-	{1931476992, 1931739135},     // 115.32.0.0/14
-	{1932146688, 1932147711},     // 115.42.56.0/22
-	{1932263424, 1932525567},     // 115.44.0.0/14
-	{1932525568, 1933574143},     // 115.48.0.0/12
-	{1933918208, 1933922303},     // 115.69.64.0/20
-	{1934884864, 1934901247},     // 115.84.0.0/18
-	{1934934016, 1934942207},     // 115.84.192.0/19
-	{1934999552, 1935015935},     // 115.85.192.0/18
-	{1935933440, 1936195583},     // 115.100.0.0/14
-	{1936195584, 1936457727},     // 115.104.0.0/14
-	{1937244160, 1937506303},     // 115.120.0.0/14
-	{1937510400, 1937514495},     // 115.124.16.0/20
-	{1939079168, 1939341311},     // 115.148.0.0/14
-	{1939341312, 1939865599},     // 115.152.0.0/13
-	{1940275200, 1940283391},     // 115.166.64.0/19
-	{1940389888, 1940455423},     // 115.168.0.0/16
-	{1940455424, 1940455935},     // 115.169.0.0/23
-	{1940456192, 1940456447},     // 115.169.3.0/24
-	{1940456960, 1940457215},     // 115.169.6.0/24
-	{1940457728, 1940457983},     // 115.169.9.0/24
-	{1940459008, 1940459519},     // 115.169.14.0/23
-	{1940459520, 1940463615},     // 115.169.16.0/20
-	{1940465408, 1940465663},     // 115.169.39.0/24
-	{1940466176, 1940466687},     // 115.169.42.0/23
-	{1940466688, 1940467711},     // 115.169.44.0/22
-	{1940467712, 1940471807},     // 115.169.48.0/20
-	{1940471808, 1940488191},     // 115.169.64.0/18
-	{1940488192, 1940520959},     // 115.169.128.0/17
-	{1940520960, 1940652031},     // 115.170.0.0/15
-	{1940652032, 1940914175},     // 115.172.0.0/14
-	{1941176320, 1941438463},     // 115.180.0.0/14
-	{1941635072, 1941639167},     // 115.187.0.0/20
-	{1941831680, 1941962751},     // 115.190.0.0/15
-	{1941962752, 1944059903},     // 115.192.0.0/11
-	{1944059904, 1945108479},     // 115.224.0.0/12
-	{1946159104, 1946161151},     // 116.0.8.0/21
-	{1946163200, 1946165247},     // 116.0.24.0/21
-	{1946222592, 1946288127},     // 116.1.0.0/16
-	{1946288128, 1946419199},     // 116.2.0.0/15
-	{1946419200, 1946681343},     // 116.4.0.0/14
-	{1946681344, 1946943487},     // 116.8.0.0/14
-	{1947009024, 1947074559},     // 116.13.0.0/16
-	{1947205632, 1948254207},     // 116.16.0.0/12
-	{1949433856, 1949437951},     // 116.50.0.0/20
-	{1949564928, 1949827071},     // 116.52.0.0/14
-	{1949827072, 1949958143},     // 116.56.0.0/15
-	{1949990912, 1949995007},     // 116.58.128.0/20
-	{1950011392, 1950015487},     // 116.58.208.0/20
-	{1950089216, 1950351359},     // 116.60.0.0/14
-	{1950482432, 1950498815},     // 116.66.0.0/18
-	{1950498816, 1950507007},     // 116.66.64.0/19
-	{1950507008, 1950511103},     // 116.66.96.0/20
-	{1950513152, 1950514175},     // 116.66.120.0/22
-	{1950648320, 1950650367},     // 116.68.136.0/21
-	{1950658560, 1950660607},     // 116.68.176.0/21
-	{1950679040, 1950744575},     // 116.69.0.0/16
-	{1950744576, 1950777343},     // 116.70.0.0/17
-	{1951137792, 1951399935},     // 116.76.0.0/14
-	{1951727616, 1951760383},     // 116.85.0.0/17
-	{1951760384, 1951776767},     // 116.85.128.0/18
-	{1951776768, 1951784959},     // 116.85.192.0/19
-	{1951784960, 1951789055},     // 116.85.224.0/20
-	{1951789056, 1951791103},     // 116.85.240.0/21
-	{1951791104, 1951791615},     // 116.85.248.0/23
-	{1951791616, 1951791871},     // 116.85.250.0/24
-	{1951792128, 1951793151},     // 116.85.252.0/22
-	{1952026624, 1952030719},     // 116.89.144.0/20
-	{1952075776, 1952079871},     // 116.90.80.0/20
-	{1952102400, 1952104447},     // 116.90.184.0/21
-	{1952382976, 1952448511},     // 116.95.0.0/16
-	{1953497088, 1953759231},     // 116.112.0.0/14
-	{1953759232, 1953890303},     // 116.116.0.0/15
-	{1954545664, 1958739967},     // 116.128.0.0/10
-	{1958739968, 1958805503},     // 116.192.0.0/16
-	{1958809600, 1958813695},     // 116.193.16.0/20
-	{1958813696, 1958821887},     // 116.193.32.0/19
-	{1958850560, 1958852607},     // 116.193.176.0/21
-	{1958871040, 1959002111},     // 116.194.0.0/15
-	{1959002112, 1959004159},     // 116.196.0.0/21
-	{1959004160, 1959005183},     // 116.196.8.0/22
-	{1959005184, 1959005695},     // 116.196.12.0/23
-	{1959006208, 1959010303},     // 116.196.16.0/20
-	{1959010304, 1959018495},     // 116.196.32.0/19
-	{1959018496, 1959034879},     // 116.196.64.0/18
-	{1959034880, 1959051263},     // 116.196.128.0/18
-	{1959051264, 1959053311},     // 116.196.192.0/21
-	{1959053312, 1959053823},     // 116.196.200.0/23
-	{1959054080, 1959054335},     // 116.196.203.0/24
-	{1959054336, 1959055359},     // 116.196.204.0/22
-	{1959055360, 1959059455},     // 116.196.208.0/20
-	{1959059456, 1959067647},     // 116.196.224.0/19
-	{1959108608, 1959110655},     // 116.197.160.0/21
-	{1959113728, 1959114239},     // 116.197.180.0/23
-	{1959133184, 1959198719},     // 116.198.0.0/16
-	{1959198720, 1959231487},     // 116.199.0.0/17
-	{1959231488, 1959239679},     // 116.199.128.0/19
-	{1959526400, 1959559167},     // 116.204.0.0/17
-	{1959585792, 1959586815},     // 116.204.232.0/22
-	{1959591936, 1959657471},     // 116.205.0.0/16
-	{1959723008, 1959788543},     // 116.207.0.0/16
-	{1959788544, 1960050687},     // 116.208.0.0/14
-	{1960091648, 1960095743},     // 116.212.160.0/20
-	{1960132608, 1960148991},     // 116.213.64.0/18
-	{1960148992, 1960181759},     // 116.213.128.0/17
-	{1960189952, 1960198143},     // 116.214.32.0/19
-	{1960198144, 1960202239},     // 116.214.64.0/20
-	{1960214528, 1960247295},     // 116.214.128.0/17
-	{1960247296, 1960312831},     // 116.215.0.0/16
-	{1960312832, 1960574975},     // 116.216.0.0/14
-	{1960837120, 1961885695},     // 116.224.0.0/12
-	{1962016768, 1962147839},     // 116.242.0.0/15
-	{1962147840, 1962409983},     // 116.244.0.0/14
-	{1962409984, 1962541055},     // 116.248.0.0/15
-	{1962672128, 1962803199},     // 116.252.0.0/15
-	{1962829824, 1962831871},     // 116.254.104.0/21
-	{1962836224, 1962836479},     // 116.254.129.0/24
-	{1962836480, 1962836991},     // 116.254.130.0/23
-	{1962836992, 1962838015},     // 116.254.132.0/22
-	{1962838016, 1962840063},     // 116.254.136.0/21
-	{1962840064, 1962844159},     // 116.254.144.0/20
-	{1962844160, 1962852351},     // 116.254.160.0/19
-	{1962852352, 1962868735},     // 116.254.192.0/18
-	{1962901504, 1962934271},     // 116.255.128.0/17
-	{1963458560, 1963982847},     // 117.8.0.0/13
-	{1964310528, 1964376063},     // 117.21.0.0/16
-	{1964376064, 1964507135},     // 117.22.0.0/15
-	{1964507136, 1965031423},     // 117.24.0.0/13
-	{1965031424, 1965555711},     // 117.32.0.0/13
-	{1965555712, 1965817855},     // 117.40.0.0/14
-	{1965817856, 1965948927},     // 117.44.0.0/15
-	{1966080000, 1966211071},     // 117.48.0.0/15
-	{1966211072, 1966276607},     // 117.50.0.0/16
-	{1966309376, 1966309887},     // 117.51.128.0/23
-	{1966310144, 1966310399},     // 117.51.131.0/24
-	{1966310400, 1966311423},     // 117.51.132.0/22
-	{1966311424, 1966313471},     // 117.51.136.0/21
-	{1966313472, 1966317567},     // 117.51.144.0/20
-	{1966317568, 1966325759},     // 117.51.160.0/19
-	{1966325760, 1966342143},     // 117.51.192.0/18
-	{1966419968, 1966424063},     // 117.53.48.0/20
-	{1966452736, 1966456831},     // 117.53.176.0/20
-	{1966669824, 1966735359},     // 117.57.0.0/16
-	{1966735360, 1966751743},     // 117.58.0.0/18
-	{1966800896, 1966866431},     // 117.59.0.0/16
-	{1966866432, 1967128575},     // 117.60.0.0/14
-	{1967128576, 1967652863},     // 117.64.0.0/13
-	{1967652864, 1967783935},     // 117.72.0.0/15
-	{1967800320, 1967808511},     // 117.74.64.0/19
-	{1967816704, 1967849471},     // 117.74.128.0/17
-	{1967849472, 1967915007},     // 117.75.0.0/16
-	{1967915008, 1968177151},     // 117.76.0.0/14
-	{1968177152, 1969225727},     // 117.80.0.0/12
-	{1969487872, 1969618943},     // 117.100.0.0/15
-	{1969688576, 1969692671},     // 117.103.16.0/20
-	{1969694720, 1969696767},     // 117.103.40.0/21
-	{1969702912, 1969704959},     // 117.103.72.0/21
-	{1969717248, 1969721343},     // 117.103.128.0/20
-	{1969793024, 1969795071},     // 117.104.168.0/21
-	{1969881088, 1970012159},     // 117.106.0.0/15
-	{1970274304, 1970798591},     // 117.112.0.0/13
-	{1970814976, 1970831359},     // 117.120.64.0/18
-	{1970831360, 1970864127},     // 117.120.128.0/17
-	{1970864128, 1970872319},     // 117.121.0.0/19
-	{1970872320, 1970874367},     // 117.121.32.0/21
-	{1970874368, 1970875391},     // 117.121.40.0/22
-	{1970875392, 1970875903},     // 117.121.44.0/23
-	{1970875904, 1970876159},     // 117.121.46.0/24
-	{1970876416, 1970880511},     // 117.121.48.0/20
-	{1970880512, 1970896895},     // 117.121.64.0/18
-	{1970896896, 1970900991},     // 117.121.128.0/20
-	{1970902016, 1970903039},     // 117.121.148.0/22
-	{1970903040, 1970905087},     // 117.121.152.0/21
-	{1970905088, 1970913279},     // 117.121.160.0/19
-	{1970913280, 1970915327},     // 117.121.192.0/21
-	{1970962432, 1970995199},     // 117.122.128.0/17
-	{1971060736, 1971322879},     // 117.124.0.0/14
-	{1971322880, 1975517183},     // 117.128.0.0/10
-	{1981284352, 1981415423},     // 118.24.0.0/15
-	{1981415424, 1981423615},     // 118.26.0.0/19
-	{1981425664, 1981427711},     // 118.26.40.0/21
-	{1981427712, 1981431807},     // 118.26.48.0/20
-	{1981431808, 1981439999},     // 118.26.64.0/19
-	{1981444096, 1981446143},     // 118.26.112.0/21
-	{1981446400, 1981446655},     // 118.26.121.0/24
-	{1981446656, 1981447167},     // 118.26.122.0/23
-	{1981447168, 1981447679},     // 118.26.124.0/23
-	{1981448192, 1981480959},     // 118.26.128.0/17
-	{1981546496, 1981677567},     // 118.28.0.0/15
-	{1981677568, 1981681663},     // 118.30.0.0/20
-	{1981681664, 1981683711},     // 118.30.16.0/21
-	{1981683712, 1981684735},     // 118.30.24.0/22
-	{1981685760, 1981693951},     // 118.30.32.0/19
-	{1981693952, 1981710335},     // 118.30.64.0/18
-	{1981710336, 1981743103},     // 118.30.128.0/17
-	{1981743104, 1981808639},     // 118.31.0.0/16
-	{1983905792, 1984036863},     // 118.64.0.0/15
-	{1984036864, 1984102399},     // 118.66.0.0/16
-	{1984131072, 1984135167},     // 118.67.112.0/20
-	{1984430080, 1984954367},     // 118.72.0.0/13
-	{1984954368, 1985085439},     // 118.80.0.0/15
-	{1985216512, 1985347583},     // 118.84.0.0/15
-	{1985486848, 1985495039},     // 118.88.32.0/19
-	{1985495040, 1985511423},     // 118.88.64.0/18
-	{1985511424, 1985544191},     // 118.88.128.0/17
-	{1985544192, 1985609727},     // 118.89.0.0/16
-	{1986400256, 1986404351},     // 118.102.16.0/20
-	{1986404352, 1986406399},     // 118.102.32.0/21
-	{1986503680, 1986504703},     // 118.103.164.0/22
-	{1986504704, 1986506751},     // 118.103.168.0/21
-	{1986506752, 1986507775},     // 118.103.176.0/22
-	{1986524416, 1986524671},     // 118.103.245.0/24
-	{1986524672, 1986525183},     // 118.103.246.0/23
-	{1987051520, 1987575807},     // 118.112.0.0/13
-	{1987575808, 1987837951},     // 118.120.0.0/14
-	{1987837952, 1987969023},     // 118.124.0.0/15
-	{1987969280, 1987969535},     // 118.126.1.0/24
-	{1987969536, 1987970047},     // 118.126.2.0/23
-	{1987970048, 1987971071},     // 118.126.4.0/22
-	{1987971072, 1987973119},     // 118.126.8.0/21
-	{1987973120, 1987973631},     // 118.126.16.0/23
-	{1987973632, 1987973887},     // 118.126.18.0/24
-	{1987977216, 1987985407},     // 118.126.32.0/19
-	{1987985408, 1988001791},     // 118.126.64.0/18
-	{1988001792, 1988034559},     // 118.126.128.0/17
-	{1988067328, 1988075519},     // 118.127.128.0/19
-	{1988362240, 1988624383},     // 118.132.0.0/14
-	{1989148672, 1989410815},     // 118.144.0.0/14
-	{1991376896, 1991442431},     // 118.178.0.0/16
-	{1991507968, 1991770111},     // 118.180.0.0/14
-	{1991771392, 1991771647},     // 118.184.5.0/24
-	{1991802880, 1991819263},     // 118.184.128.0/18
-	{1991819264, 1991827455},     // 118.184.192.0/19
-	{1991831552, 1991835647},     // 118.184.240.0/20
-	{1991901184, 1992032255},     // 118.186.0.0/15
-	{1992032256, 1992097791},     // 118.188.0.0/16
-	{1992163328, 1992228863},     // 118.190.0.0/16
-	{1992228864, 1992232959},     // 118.191.0.0/20
-	{1992237056, 1992245247},     // 118.191.32.0/19
-	{1992245248, 1992261631},     // 118.191.64.0/18
-	{1992265728, 1992267775},     // 118.191.144.0/21
-	{1992268032, 1992268287},     // 118.191.153.0/24
-	{1992268288, 1992268799},     // 118.191.154.0/23
-	{1992268800, 1992269823},     // 118.191.156.0/22
-	{1992269824, 1992278015},     // 118.191.160.0/19
-	{1992278016, 1992282111},     // 118.191.192.0/20
-	{1992282368, 1992282623},     // 118.191.209.0/24
-	{1992282624, 1992283135},     // 118.191.210.0/23
-	{1992283136, 1992284159},     // 118.191.212.0/22
-	{1992292352, 1992294399},     // 118.191.248.0/21
-	{1992294400, 1992359935},     // 118.192.0.0/16
-	{1992359936, 1992360959},     // 118.193.0.0/22
-	{1992384512, 1992392703},     // 118.193.96.0/19
-	{1992425472, 1992458239},     // 118.194.0.0/17
-	{1992458240, 1992474623},     // 118.194.128.0/18
-	{1992474624, 1992482815},     // 118.194.192.0/19
-	{1992486912, 1992488959},     // 118.194.240.0/21
-	{1992491008, 1992556543},     // 118.195.0.0/16
-	{1992556544, 1992818687},     // 118.196.0.0/14
-	{1992949760, 1993080831},     // 118.202.0.0/15
-	{1993080832, 1993342975},     // 118.204.0.0/14
-	{1993605120, 1993736191},     // 118.212.0.0/15
-	{1993850880, 1993867263},     // 118.215.192.0/18
-	{1994391552, 1994653695},     // 118.224.0.0/14
-	{1994653696, 1994686463},     // 118.228.0.0/17
-	{1994686464, 1994690559},     // 118.228.128.0/20
-	{1994690560, 1994692607},     // 118.228.144.0/21
-	{1994693632, 1994694655},     // 118.228.156.0/22
-	{1994694656, 1994702847},     // 118.228.160.0/19
-	{1994702848, 1994719231},     // 118.228.192.0/18
-	{1994719232, 1994784767},     // 118.229.0.0/16
-	{1994784768, 1994850303},     // 118.230.0.0/16
-	{1995374592, 1995440127},     // 118.239.0.0/16
-	{1995571200, 1995636735},     // 118.242.0.0/16
-	{1995702272, 1995964415},     // 118.244.0.0/14
-	{1995964416, 1996488703},     // 118.248.0.0/13
-	{1996488704, 1996619775},     // 119.0.0.0/15
-	{1996619776, 1996627967},     // 119.2.0.0/19
-	{1996652544, 1996685311},     // 119.2.128.0/17
-	{1996685312, 1996750847},     // 119.3.0.0/16
-	{1996750848, 1997012991},     // 119.4.0.0/14
-	{1997144064, 1997176831},     // 119.10.0.0/17
-	{1997506560, 1997508607},     // 119.15.136.0/21
-	{1997537280, 1997602815},     // 119.16.0.0/16
-	{1997717504, 1997721599},     // 119.18.192.0/20
-	{1997721600, 1997723647},     // 119.18.208.0/21
-	{1997725696, 1997733887},     // 119.18.224.0/19
-	{1997733888, 1997799423},     // 119.19.0.0/16
-	{1997799424, 1998061567},     // 119.20.0.0/14
-	{1998274560, 1998290943},     // 119.27.64.0/18
-	{1998290944, 1998323711},     // 119.27.128.0/17
-	{1998330880, 1998331135},     // 119.28.28.0/24
-	{1998389248, 1998454783},     // 119.29.0.0/16
-	{1998467072, 1998471167},     // 119.30.48.0/20
-	{1998569472, 1998577663},     // 119.31.192.0/19
-	{1998585856, 1998847999},     // 119.32.0.0/14
-	{1998848000, 1998979071},     // 119.36.0.0/15
-	{1998979072, 1999011839},     // 119.38.0.0/17
-	{1999011840, 1999028223},     // 119.38.128.0/18
-	{1999028224, 1999032319},     // 119.38.192.0/20
-	{1999032320, 1999033343},     // 119.38.208.0/22
-	{1999033344, 1999033855},     // 119.38.212.0/23
-	{1999033856, 1999033887},     // 119.38.214.0/27
-	{1999033912, 1999033919},     // 119.38.214.56/29
-	{1999033920, 1999033983},     // 119.38.214.64/26
-	{1999033984, 1999034111},     // 119.38.214.128/25
-	{1999034112, 1999034367},     // 119.38.215.0/24
-	{1999034368, 1999036415},     // 119.38.216.0/21
-	{1999044608, 1999110143},     // 119.39.0.0/16
-	{1999110144, 1999126527},     // 119.40.0.0/18
-	{1999126528, 1999130623},     // 119.40.64.0/20
-	{1999142912, 1999175679},     // 119.40.128.0/17
-	{1999175680, 1999241215},     // 119.41.0.0/16
-	{1999241216, 1999249407},     // 119.42.0.0/19
-	{1999273984, 1999278079},     // 119.42.128.0/20
-	{1999298560, 1999306751},     // 119.42.224.0/19
-	{1999372288, 1999503359},     // 119.44.0.0/15
-	{1999634432, 2000158719},     // 119.48.0.0/13
-	{2000224256, 2000289791},     // 119.57.0.0/16
-	{2000289792, 2000355327},     // 119.58.0.0/16
-	{2000388096, 2000420863},     // 119.59.128.0/17
-	{2000420864, 2000551935},     // 119.60.0.0/15
-	{2000551936, 2000617471},     // 119.62.0.0/16
-	{2000625664, 2000633855},     // 119.63.32.0/19
-	{2001457152, 2001461247},     // 119.75.208.0/20
-	{2001600512, 2001731583},     // 119.78.0.0/15
-	{2001731584, 2001797119},     // 119.80.0.0/16
-	{2001915904, 2001919999},     // 119.82.208.0/20
-	{2001993728, 2002255871},     // 119.84.0.0/14
-	{2002255872, 2002321407},     // 119.88.0.0/16
-	{2002321408, 2002354175},     // 119.89.0.0/17
-	{2002354176, 2002356223},     // 119.89.128.0/21
-	{2002356224, 2002356735},     // 119.89.136.0/23
-	{2002356992, 2002357247},     // 119.89.139.0/24
-	{2002357248, 2002358271},     // 119.89.140.0/22
-	{2002358272, 2002362367},     // 119.89.144.0/20
-	{2002362368, 2002366463},     // 119.89.160.0/20
-	{2002366464, 2002367487},     // 119.89.176.0/22
-	{2002367488, 2002367999},     // 119.89.180.0/23
-	{2002368256, 2002368511},     // 119.89.183.0/24
-	{2002368512, 2002370559},     // 119.89.184.0/21
-	{2002370560, 2002371071},     // 119.89.192.0/23
-	{2002371072, 2002371327},     // 119.89.194.0/24
-	{2002371584, 2002372607},     // 119.89.196.0/22
-	{2002372608, 2002374655},     // 119.89.200.0/21
-	{2002374656, 2002376703},     // 119.89.208.0/21
-	{2002376960, 2002377215},     // 119.89.217.0/24
-	{2002377216, 2002377727},     // 119.89.218.0/23
-	{2002377728, 2002378751},     // 119.89.220.0/22
-	{2002378752, 2002386943},     // 119.89.224.0/19
-	{2002386944, 2002518015},     // 119.90.0.0/15
-	{2002780160, 2003304447},     // 119.96.0.0/13
-	{2003566592, 2003697663},     // 119.108.0.0/15
-	{2003828736, 2004877311},     // 119.112.0.0/12
-	{2004877312, 2005925887},     // 119.128.0.0/12
-	{2005925888, 2006188031},     // 119.144.0.0/14
-	{2006228992, 2006237183},     // 119.148.160.0/19
-	{2006433792, 2006450175},     // 119.151.192.0/18
-	{2007025664, 2007027711},     // 119.160.200.0/21
-	{2007070720, 2007072767},     // 119.161.120.0/21
-	{2007072768, 2007074815},     // 119.161.128.0/21
-	{2007080960, 2007089151},     // 119.161.160.0/19
-	{2007089152, 2007105535},     // 119.161.192.0/18
-	{2007105536, 2007236607},     // 119.162.0.0/15
-	{2007236608, 2007498751},     // 119.164.0.0/14
-	{2008023040, 2009071615},     // 119.176.0.0/12
-	{2011693056, 2011824127},     // 119.232.0.0/15
-	{2011922432, 2011930623},     // 119.235.128.0/19
-	{2011930624, 2011934719},     // 119.235.160.0/20
-	{2011936768, 2011937791},     // 119.235.184.0/22
-	{2012741632, 2013003775},     // 119.248.0.0/14
-	{2013028352, 2013030399},     // 119.252.96.0/21
-	{2013065216, 2013067263},     // 119.252.240.0/21
-	{2013067520, 2013067775},     // 119.252.249.0/24
-	{2013068288, 2013068799},     // 119.252.252.0/23
-	{2013069312, 2013134847},     // 119.253.0.0/16
-	{2013134848, 2013265919},     // 119.254.0.0/15
-	{2013265920, 2014314495},     // 120.0.0.0/12
-	{2014838784, 2015100927},     // 120.24.0.0/14
-	{2015232000, 2015363071},     // 120.30.0.0/15
-	{2015363072, 2016411647},     // 120.32.0.0/12
-	{2016411648, 2016542719},     // 120.48.0.0/15
-	{2016673792, 2016935935},     // 120.52.0.0/14
-	{2017460224, 2017984511},     // 120.64.0.0/13
-	{2017992704, 2018000895},     // 120.72.32.0/19
-	{2018017280, 2018050047},     // 120.72.128.0/17
-	{2018246656, 2018508799},     // 120.76.0.0/14
-	{2018508800, 2019033087},     // 120.80.0.0/13
-	{2019035136, 2019037183},     // 120.88.8.0/21
-	{2019164160, 2019295231},     // 120.90.0.0/15
-	{2019295232, 2019327999},     // 120.92.0.0/17
-	{2019328000, 2019344383},     // 120.92.128.0/18
-	{2019344384, 2019345407},     // 120.92.192.0/22
-	{2019345920, 2019346431},     // 120.92.198.0/23
-	{2019346432, 2019348479},     // 120.92.200.0/21
-	{2019348480, 2019352575},     // 120.92.208.0/20
-	{2019352576, 2019360767},     // 120.92.224.0/19
-	{2019426304, 2019557375},     // 120.94.0.0/15
-	{2021654528, 2022178815},     // 120.128.0.0/13
-	{2022182912, 2022184959},     // 120.136.16.0/21
-	{2022211584, 2022227967},     // 120.136.128.0/18
-	{2022244352, 2022277119},     // 120.137.0.0/17
-	{2022670336, 2022678527},     // 120.143.128.0/19
-	{2025848832, 2030043135},     // 120.192.0.0/10
-	{2030045184, 2030047231},     // 121.0.8.0/21
-	{2030047232, 2030051327},     // 121.0.16.0/20
-	{2030305280, 2030436351},     // 121.4.0.0/15
-	{2030567424, 2031091711},     // 121.8.0.0/13
-	{2031091712, 2032140287},     // 121.16.0.0/12
-	{2032140288, 2032664575},     // 121.32.0.0/13
-	{2032664576, 2032926719},     // 121.40.0.0/14
-	{2033057792, 2033074175},     // 121.46.0.0/18
-	{2033077248, 2033078271},     // 121.46.76.0/22
-	{2033090560, 2033123327},     // 121.46.128.0/17
-	{2033123328, 2033188863},     // 121.47.0.0/16
-	{2033188864, 2033319935},     // 121.48.0.0/15
-	{2033321984, 2033324031},     // 121.50.8.0/21
-	{2033385472, 2033451007},     // 121.51.0.0/16
-	{2033491968, 2033500159},     // 121.52.160.0/19
-	{2033504256, 2033508351},     // 121.52.208.0/20
-	{2033508352, 2033516543},     // 121.52.224.0/19
-	{2033627136, 2033629183},     // 121.54.176.0/21
-	{2033647616, 2033663999},     // 121.55.0.0/18
-	{2033713152, 2033844223},     // 121.56.0.0/15
-	{2033844224, 2033876991},     // 121.58.0.0/17
-	{2033879040, 2033881087},     // 121.58.136.0/21
-	{2033881088, 2033885183},     // 121.58.144.0/20
-	{2033885184, 2033887231},     // 121.58.160.0/21
-	{2033909760, 2033913855},     // 121.59.0.0/20
-	{2033913856, 2033915903},     // 121.59.16.0/21
-	{2033915904, 2033916927},     // 121.59.24.0/22
-	{2033916928, 2033917183},     // 121.59.28.0/24
-	{2033917696, 2033917951},     // 121.59.31.0/24
-	{2033918208, 2033918463},     // 121.59.33.0/24
-	{2033918976, 2033919999},     // 121.59.36.0/22
-	{2033920000, 2033922047},     // 121.59.40.0/21
-	{2033922048, 2033926143},     // 121.59.48.0/20
-	{2033926144, 2033942527},     // 121.59.64.0/18
-	{2033942528, 2033946623},     // 121.59.128.0/20
-	{2033946624, 2033948671},     // 121.59.144.0/21
-	{2033948672, 2033948927},     // 121.59.152.0/24
-	{2033949184, 2033949695},     // 121.59.154.0/23
-	{2033949696, 2033950719},     // 121.59.156.0/22
-	{2033950720, 2033958911},     // 121.59.160.0/19
-	{2033958912, 2033975295},     // 121.59.192.0/18
-	{2033975296, 2034237439},     // 121.60.0.0/14
-	{2034499584, 2034761727},     // 121.68.0.0/14
-	{2035023872, 2035154943},     // 121.76.0.0/15
-	{2035253248, 2035269631},     // 121.79.128.0/18
-	{2035875840, 2035941375},     // 121.89.0.0/16
-	{2036629504, 2036662271},     // 121.100.128.0/17
-	{2036662272, 2036678655},     // 121.101.0.0/18
-	{2036715520, 2036719615},     // 121.101.208.0/20
-	{2042626048, 2043150335},     // 121.192.0.0/13
-	{2043199488, 2043199999},     // 121.200.192.0/23
-	{2043200000, 2043200255},     // 121.200.194.0/24
-	{2043200512, 2043201535},     // 121.200.196.0/22
-	{2043215872, 2043281407},     // 121.201.0.0/16
-	{2043412480, 2043674623},     // 121.204.0.0/14
-	{2044723200, 2045771775},     // 121.224.0.0/12
-	{2046296064, 2046558207},     // 121.248.0.0/14
-	{2046754816, 2046820351},     // 121.255.0.0/16
-	{2046836736, 2046853119},     // 122.0.64.0/18
-	{2046853120, 2046885887},     // 122.0.128.0/17
-	{2047082496, 2047344639},     // 122.4.0.0/14
-	{2047410176, 2047475711},     // 122.9.0.0/16
-	{2047509504, 2047510015},     // 122.10.132.0/23
-	{2047510528, 2047511039},     // 122.10.136.0/23
-	{2047531008, 2047532031},     // 122.10.216.0/22
-	{2047534080, 2047535103},     // 122.10.228.0/22
-	{2047535104, 2047537151},     // 122.10.232.0/21
-	{2047537152, 2047538175},     // 122.10.240.0/22
-	{2047541248, 2047574015},     // 122.11.0.0/17
-	{2047606784, 2047737855},     // 122.12.0.0/15
-	{2047737856, 2047770623},     // 122.14.0.0/17
-	{2047787008, 2047803391},     // 122.14.192.0/18
-	{2049966080, 2050031615},     // 122.48.0.0/16
-	{2050031616, 2050047999},     // 122.49.0.0/18
-	{2050162688, 2050228223},     // 122.51.0.0/16
-	{2051014656, 2051276799},     // 122.64.0.0/14
-	{2051276800, 2051407871},     // 122.68.0.0/15
-	{2051407872, 2051424255},     // 122.70.0.0/18
-	{2051424256, 2051432447},     // 122.70.64.0/19
-	{2051432448, 2051436543},     // 122.70.96.0/20
-	{2051436544, 2051438591},     // 122.70.112.0/21
-	{2051438592, 2051439615},     // 122.70.120.0/22
-	{2051439616, 2051440127},     // 122.70.124.0/23
-	{2051440128, 2051440383},     // 122.70.126.0/24
-	{2051440640, 2051473407},     // 122.70.128.0/17
-	{2051473408, 2051538943},     // 122.71.0.0/16
-	{2051538944, 2052063231},     // 122.72.0.0/13
-	{2052063232, 2053111807},     // 122.80.0.0/12
-	{2053111808, 2053242879},     // 122.96.0.0/15
-	{2053279744, 2053283839},     // 122.98.144.0/20
-	{2053283840, 2053285887},     // 122.98.160.0/21
-	{2053286912, 2053287935},     // 122.98.172.0/22
-	{2053287936, 2053292031},     // 122.98.176.0/20
-	{2053292032, 2053294079},     // 122.98.192.0/21
-	{2053302272, 2053304319},     // 122.98.232.0/21
-	{2053304320, 2053308415},     // 122.98.240.0/20
-	{2053505024, 2053509119},     // 122.102.0.0/20
-	{2053521408, 2053529599},     // 122.102.64.0/19
-	{2054160384, 2054176767},     // 122.112.0.0/18
-	{2054176768, 2054184959},     // 122.112.64.0/19
-	{2054184960, 2054185983},     // 122.112.96.0/22
-	{2054190592, 2054190847},     // 122.112.118.0/24
-	{2054191616, 2054191871},     // 122.112.122.0/24
-	{2054192384, 2054192639},     // 122.112.125.0/24
-	{2054193152, 2054225919},     // 122.112.128.0/17
-	{2054225920, 2054291455},     // 122.113.0.0/16
-	{2054291456, 2054356991},     // 122.114.0.0/16
-	{2054356992, 2054373375},     // 122.115.0.0/18
-	{2054377472, 2054381567},     // 122.115.80.0/20
-	{2054381568, 2054389759},     // 122.115.96.0/19
-	{2054389760, 2054422527},     // 122.115.128.0/17
-	{2054619136, 2054684671},     // 122.119.0.0/16
-	{2055234560, 2055235583},     // 122.128.100.0/22
-	{2055239680, 2055241727},     // 122.128.120.0/21
-	{2055733248, 2056257535},     // 122.136.0.0/13
-	{2056290304, 2056323071},     // 122.144.128.0/17
-	{2056830976, 2056847359},     // 122.152.192.0/18
-	{2057043968, 2057306111},     // 122.156.0.0/14
-	{2059141120, 2059403263},     // 122.188.0.0/14
-	{2059403264, 2059665407},     // 122.192.0.0/14
-	{2059796480, 2059862015},     // 122.198.0.0/16
-	{2059937792, 2059939839},     // 122.200.40.0/21
-	{2059943936, 2059960319},     // 122.200.64.0/18
-	{2060005376, 2060009471},     // 122.201.48.0/20
-	{2060189696, 2060451839},     // 122.204.0.0/14
-	{2061500416, 2062548991},     // 122.224.0.0/12
-	{2062548992, 2063073279},     // 122.240.0.0/13
-	{2063079424, 2063081471},     // 122.248.24.0/21
-	{2063085568, 2063089663},     // 122.248.48.0/20
-	{2063548416, 2063550463},     // 122.255.64.0/21
-	{2063630336, 2063632383},     // 123.0.128.0/21
-	{2063632384, 2063632895},     // 123.0.136.0/23
-	{2063633152, 2063633407},     // 123.0.139.0/24
-	{2063633408, 2063634431},     // 123.0.140.0/22
-	{2063634432, 2063638527},     // 123.0.144.0/20
-	{2063638528, 2063646719},     // 123.0.160.0/19
-	{2063859712, 2064121855},     // 123.4.0.0/14
-	{2064121856, 2064646143},     // 123.8.0.0/13
-	{2066842112, 2066842623},     // 123.49.130.0/23
-	{2066842624, 2066843647},     // 123.49.132.0/22
-	{2066843648, 2066844671},     // 123.49.136.0/22
-	{2066847744, 2066849791},     // 123.49.152.0/21
-	{2066849792, 2066857983},     // 123.49.160.0/19
-	{2066857984, 2066874367},     // 123.49.192.0/18
-	{2066915328, 2066923519},     // 123.50.160.0/19
-	{2067005440, 2067267583},     // 123.52.0.0/14
-	{2067267584, 2067398655},     // 123.56.0.0/15
-	{2067398656, 2067415039},     // 123.58.0.0/18
-	{2067415040, 2067419135},     // 123.58.64.0/20
-	{2067419136, 2067421183},     // 123.58.80.0/21
-	{2067421184, 2067422207},     // 123.58.88.0/22
-	{2067423232, 2067431423},     // 123.58.96.0/19
-	{2067431424, 2067447807},     // 123.58.128.0/18
-	{2067456000, 2067464191},     // 123.58.224.0/19
-	{2067464192, 2067529727},     // 123.59.0.0/16
-	{2067529728, 2067660799},     // 123.60.0.0/15
-	{2067660800, 2067726335},     // 123.62.0.0/16
-	{2067791872, 2069889023},     // 123.64.0.0/11
-	{2069889024, 2070020095},     // 123.96.0.0/15
-	{2070020096, 2070052863},     // 123.98.0.0/17
-	{2070118400, 2070151167},     // 123.99.128.0/17
-	{2070151168, 2070159359},     // 123.100.0.0/19
-	{2070210560, 2070210815},     // 123.100.232.0/24
-	{2070216704, 2070282239},     // 123.101.0.0/16
-	{2070347776, 2070351871},     // 123.103.0.0/20
-	{2070351872, 2070353919},     // 123.103.16.0/21
-	{2070353920, 2070354943},     // 123.103.24.0/22
-	{2070354944, 2070355455},     // 123.103.28.0/23
-	{2070355456, 2070355711},     // 123.103.30.0/24
-	{2070355968, 2070364159},     // 123.103.32.0/19
-	{2070364160, 2070380543},     // 123.103.64.0/18
-	{2070709760, 2070710015},     // 123.108.134.0/24
-	{2070710784, 2070711295},     // 123.108.138.0/23
-	{2070711296, 2070711551},     // 123.108.140.0/24
-	{2070711808, 2070712063},     // 123.108.142.0/24
-	{2070728704, 2070732799},     // 123.108.208.0/20
-	{2070937600, 2071986175},     // 123.112.0.0/12
-	{2071986176, 2072510463},     // 123.128.0.0/13
-	{2072576000, 2072641535},     // 123.137.0.0/16
-	{2072641536, 2072772607},     // 123.138.0.0/15
-	{2073034752, 2074083327},     // 123.144.0.0/12
-	{2074083328, 2075131903},     // 123.160.0.0/12
-	{2075147264, 2075148287},     // 123.176.60.0/22
-	{2075152384, 2075156479},     // 123.176.80.0/20
-	{2075197440, 2075262975},     // 123.177.0.0/16
-	{2075262976, 2075394047},     // 123.178.0.0/15
-	{2075394048, 2075656191},     // 123.180.0.0/14
-	{2075656192, 2076180479},     // 123.184.0.0/13
-	{2076442624, 2076573695},     // 123.196.0.0/15
-	{2076672000, 2076704767},     // 123.199.128.0/17
-	{2077097984, 2077229055},     // 123.206.0.0/15
-	{2078801920, 2079064063},     // 123.232.0.0/14
-	{2079457280, 2079490047},     // 123.242.0.0/17
-	{2079506432, 2079508479},     // 123.242.192.0/21
-	{2079588352, 2079850495},     // 123.244.0.0/14
-	{2079916032, 2079981567},     // 123.249.0.0/16
-	{2080239616, 2080240639},     // 123.253.240.0/22
-	{2080268288, 2080270335},     // 123.254.96.0/21
-	{2080784384, 2080800767},     // 124.6.64.0/18
-	{2081292288, 2081423359},     // 124.14.0.0/15
-	{2081423360, 2081554431},     // 124.16.0.0/15
-	{2081685504, 2081947647},     // 124.20.0.0/14
-	{2082258944, 2082275327},     // 124.28.192.0/18
-	{2082275328, 2082308095},     // 124.29.0.0/17
-	{2082406400, 2082471935},     // 124.31.0.0/16
-	{2083024896, 2083028991},     // 124.40.112.0/20
-	{2083028992, 2083045375},     // 124.40.128.0/18
-	{2083045376, 2083053567},     // 124.40.192.0/19
-	{2083057664, 2083058687},     // 124.40.240.0/22
-	{2083127296, 2083192831},     // 124.42.0.0/16
-	{2083454976, 2083471359},     // 124.47.0.0/18
-	{2084569088, 2084700159},     // 124.64.0.0/15
-	{2084700160, 2084732927},     // 124.66.0.0/17
-	{2084765696, 2084831231},     // 124.67.0.0/16
-	{2084831232, 2084839423},     // 124.68.0.0/19
-	{2084839424, 2084843519},     // 124.68.32.0/20
-	{2084843520, 2084845567},     // 124.68.48.0/21
-	{2084845568, 2084846591},     // 124.68.56.0/22
-	{2084846592, 2084847103},     // 124.68.60.0/23
-	{2084847360, 2084847615},     // 124.68.63.0/24
-	{2084847616, 2084863999},     // 124.68.64.0/18
-	{2084864000, 2084880383},     // 124.68.128.0/18
-	{2084880384, 2084888575},     // 124.68.192.0/19
-	{2084888576, 2084889087},     // 124.68.224.0/23
-	{2084889088, 2084889343},     // 124.68.226.0/24
-	{2084889600, 2084890623},     // 124.68.228.0/22
-	{2084890624, 2084892671},     // 124.68.232.0/21
-	{2084892672, 2084893183},     // 124.68.240.0/23
-	{2084893184, 2084893439},     // 124.68.242.0/24
-	{2084893696, 2084894207},     // 124.68.244.0/23
-	{2084896256, 2084896767},     // 124.68.254.0/23
-	{2084896768, 2084962303},     // 124.69.0.0/16
-	{2084962304, 2085093375},     // 124.70.0.0/15
-	{2085093376, 2085617663},     // 124.72.0.0/13
-	{2086141952, 2086666239},     // 124.88.0.0/13
-	{2087454720, 2087456767},     // 124.108.8.0/21
-	{2087462912, 2087464959},     // 124.108.40.0/21
-	{2087542784, 2087544831},     // 124.109.96.0/21
-	{2087714816, 2087976959},     // 124.112.0.0/14
-	{2087976960, 2088108031},     // 124.116.0.0/15
-	{2088108032, 2088173567},     // 124.118.0.0/16
-	{2088173568, 2088206335},     // 124.119.0.0/17
-	{2088206336, 2088222719},     // 124.119.128.0/18
-	{2088222720, 2088230911},     // 124.119.192.0/19
-	{2088230912, 2088235007},     // 124.119.224.0/20
-	{2088235008, 2088236031},     // 124.119.240.0/22
-	{2088236032, 2088236543},     // 124.119.244.0/23
-	{2088236544, 2088236671},     // 124.119.246.0/25
-	{2088236672, 2088236735},     // 124.119.246.128/26
-	{2088236736, 2088236767},     // 124.119.246.192/27
-	{2088236768, 2088236783},     // 124.119.246.224/28
-	{2088236784, 2088236791},     // 124.119.246.240/29
-	{2088236792, 2088236795},     // 124.119.246.248/30
-	{2088236798, 2088236799},     // 124.119.246.254/31
-	{2088236800, 2088237055},     // 124.119.247.0/24
-	{2088237056, 2088239103},     // 124.119.248.0/21
-	{2088632320, 2088763391},     // 124.126.0.0/15
-	{2088763392, 2089287679},     // 124.128.0.0/13
-	{2090041344, 2090074111},     // 124.147.128.0/17
-	{2090240256, 2090240511},     // 124.150.137.0/24
-	{2090270720, 2090336255},     // 124.151.0.0/16
-	{2090336256, 2090401791},     // 124.152.0.0/16
-	{2090860544, 2091384831},     // 124.160.0.0/13
-	{2091646976, 2091712511},     // 124.172.0.0/16
-	{2091720704, 2091728895},     // 124.173.32.0/19
-	{2091728896, 2091745279},     // 124.173.64.0/18
-	{2091745280, 2091778047},     // 124.173.128.0/17
-	{2091778048, 2091909119},     // 124.174.0.0/15
-	{2092957696, 2093088767},     // 124.192.0.0/15
-	{2093219840, 2093285375},     // 124.196.0.0/16
-	{2093481984, 2094006271},     // 124.200.0.0/13
-	{2094792704, 2095054847},     // 124.220.0.0/14
-	{2095054848, 2096103423},     // 124.224.0.0/12
-	{2096103424, 2096136191},     // 124.240.0.0/17
-	{2096136192, 2096152575},     // 124.240.128.0/18
-	{2096234496, 2096300031},     // 124.242.0.0/16
-	{2096349184, 2096365567},     // 124.243.192.0/18
-	{2096627712, 2096660479},     // 124.248.0.0/17
-	{2096693248, 2096758783},     // 124.249.0.0/16
-	{2096758784, 2096889855},     // 124.250.0.0/15
-	{2097020928, 2097037311},     // 124.254.0.0/18
-	{2099232768, 2099249151},     // 125.31.192.0/18
-	{2099249152, 2100297727},     // 125.32.0.0/12
-	{2100985856, 2101018623},     // 125.58.128.0/17
-	{2101182464, 2101215231},     // 125.61.128.0/17
-	{2101215232, 2101231615},     // 125.62.0.0/18
-	{2101346304, 2103443455},     // 125.64.0.0/11
-	{2103443456, 2103574527},     // 125.96.0.0/15
-	{2103574528, 2103640063},     // 125.98.0.0/16
-	{2103967744, 2104492031},     // 125.104.0.0/13
-	{2104492032, 2105540607},     // 125.112.0.0/12
-	{2108227584, 2108293119},     // 125.169.0.0/16
-	{2108358656, 2108424191},     // 125.171.0.0/16
-	{2110783488, 2110791679},     // 125.208.0.0/19
-	{2110792960, 2110793215},     // 125.208.37.0/24
-	{2110793728, 2110793983},     // 125.208.40.0/24
-	{2110795008, 2110795263},     // 125.208.45.0/24
-	{2110795264, 2110795775},     // 125.208.46.0/23
-	{2110795776, 2110799871},     // 125.208.48.0/20
-	{2110914560, 2111045631},     // 125.210.0.0/15
-	{2111111168, 2111143935},     // 125.213.0.0/17
-	{2111201280, 2111209471},     // 125.214.96.0/19
-	{2111242240, 2111258623},     // 125.215.0.0/18
-	{2111307776, 2111832063},     // 125.216.0.0/13
-	{2113830912, 2113863679},     // 125.254.128.0/17
-	{2154561536, 2154627071},     // 128.108.0.0/16
-	{2166095872, 2166161407},     // 129.28.0.0/16
-	{2177630208, 2177695743},     // 129.204.0.0/16
-	{2178088960, 2178154495},     // 129.211.0.0/16
-	{2178940416, 2178940671},     // 129.223.254.0/24
-	{2183434752, 2183435263},     // 130.36.146.0/23
-	{2195118592, 2195119103},     // 130.214.218.0/23
-	{2212782080, 2212782335},     // 131.228.96.0/24
-	{2214398976, 2214398983},     // 131.253.12.0/29
-	{2214399056, 2214399071},     // 131.253.12.80/28
-	{2214399216, 2214399223},     // 131.253.12.240/29
-	{2229796864, 2229862399},     // 132.232.0.0/16
-	{2230158848, 2230159103},     // 132.237.134.0/24
-	{2259615744, 2259681279},     // 134.175.0.0/16
-	{2270492416, 2270492671},     // 135.84.247.0/24
-	{2270494208, 2270494719},     // 135.84.254.0/23
-	{2275397632, 2275401727},     // 135.159.208.0/20
-	{2280935424, 2280939519},     // 135.244.80.0/20
-	{2302360320, 2302360575},     // 137.59.59.0/24
-	{2302367744, 2302368767},     // 137.59.88.0/22
-	{2317415424, 2317415679},     // 138.32.244.0/24
-	{2332375040, 2332376063},     // 139.5.56.0/22
-	{2332376320, 2332376575},     // 139.5.61.0/24
-	{2332376576, 2332377087},     // 139.5.62.0/23
-	{2332381184, 2332382207},     // 139.5.80.0/22
-	{2332384256, 2332385279},     // 139.5.92.0/22
-	{2332393472, 2332394495},     // 139.5.128.0/22
-	{2332401664, 2332402687},     // 139.5.160.0/22
-	{2332409856, 2332410879},     // 139.5.192.0/22
-	{2332412928, 2332413951},     // 139.5.204.0/22
-	{2332423168, 2332424191},     // 139.5.244.0/22
-	{2332622848, 2332688383},     // 139.9.0.0/16
-	{2340487168, 2340552703},     // 139.129.0.0/16
-	{2341137920, 2341137935},     // 139.138.238.0/28
-	{2341732352, 2341797887},     // 139.148.0.0/16
-	{2342191104, 2342256639},     // 139.155.0.0/16
-	{2342453248, 2342461439},     // 139.159.0.0/19
-	{2342461440, 2342463487},     // 139.159.32.0/21
-	{2342463488, 2342464511},     // 139.159.40.0/22
-	{2342466560, 2342467583},     // 139.159.52.0/22
-	{2342467584, 2342469631},     // 139.159.56.0/21
-	{2342469632, 2342477823},     // 139.159.64.0/19
-	{2342477824, 2342481919},     // 139.159.96.0/20
-	{2342481920, 2342482175},     // 139.159.112.0/24
-	{2342482176, 2342482179},     // 139.159.113.0/30
-	{2342482192, 2342482207},     // 139.159.113.16/28
-	{2342482208, 2342482239},     // 139.159.113.32/27
-	{2342482240, 2342482303},     // 139.159.113.64/26
-	{2342482304, 2342482431},     // 139.159.113.128/25
-	{2342482432, 2342482943},     // 139.159.114.0/23
-	{2342482944, 2342483455},     // 139.159.116.0/23
-	{2342483968, 2342486015},     // 139.159.120.0/21
-	{2342486016, 2342518783},     // 139.159.128.0/17
-	{2343174144, 2343239679},     // 139.170.0.0/16
-	{2343567360, 2343632895},     // 139.176.0.0/16
-	{2344026112, 2344091647},     // 139.183.0.0/16
-	{2344222720, 2344288255},     // 139.186.0.0/16
-	{2344419328, 2344484863},     // 139.189.0.0/16
-	{2344878080, 2345009151},     // 139.196.0.0/15
-	{2345009152, 2345025535},     // 139.198.0.0/18
-	{2345026048, 2345026559},     // 139.198.66.0/23
-	{2345026560, 2345027583},     // 139.198.68.0/22
-	{2345027584, 2345029631},     // 139.198.72.0/21
-	{2345029632, 2345033727},     // 139.198.80.0/20
-	{2345033728, 2345037823},     // 139.198.96.0/20
-	{2345038848, 2345039871},     // 139.198.116.0/22
-	{2345040384, 2345040895},     // 139.198.122.0/23
-	{2345040896, 2345041919},     // 139.198.124.0/22
-	{2345041920, 2345074687},     // 139.198.128.0/17
-	{2345074688, 2345140223},     // 139.199.0.0/16
-	{2345140224, 2345664511},     // 139.200.0.0/13
-	{2345664512, 2346188799},     // 139.208.0.0/13
-	{2346254336, 2346319871},     // 139.217.0.0/16
-	{2346385408, 2346450943},     // 139.219.0.0/16
-	{2346450944, 2346483711},     // 139.220.0.0/17
-	{2346483712, 2346500095},     // 139.220.128.0/18
-	{2346500096, 2346501119},     // 139.220.192.0/22
-	{2346501120, 2346501631},     // 139.220.196.0/23
-	{2346502144, 2346504191},     // 139.220.200.0/21
-	{2346504192, 2346504703},     // 139.220.208.0/23
-	{2346505216, 2346506239},     // 139.220.212.0/22
-	{2346506240, 2346508287},     // 139.220.216.0/21
-	{2346508288, 2346516479},     // 139.220.224.0/19
-	{2346516480, 2346582015},     // 139.221.0.0/16
-	{2346713088, 2346778623},     // 139.224.0.0/16
-	{2346844160, 2346975231},     // 139.226.0.0/15
-	{2353725440, 2353790975},     // 140.75.0.0/16
-	{2355482624, 2355482879},     // 140.101.208.0/24
-	{2358181888, 2358247423},     // 140.143.0.0/16
-	{2360541184, 2360606719},     // 140.179.0.0/16
-	{2362245120, 2362310655},     // 140.205.0.0/16
-	{2362310656, 2362441727},     // 140.206.0.0/15
-	{2362572800, 2362638335},     // 140.210.0.0/16
-	{2363490304, 2363555839},     // 140.224.0.0/16
-	{2364342272, 2364407807},     // 140.237.0.0/16
-	{2364538880, 2364604415},     // 140.240.0.0/16
-	{2364727040, 2364727295},     // 140.242.223.0/24
-	{2364727296, 2364727551},     // 140.242.224.0/24
-	{2364735488, 2364801023},     // 140.243.0.0/16
-	{2364932096, 2364997631},     // 140.246.0.0/16
-	{2365128704, 2365194239},     // 140.249.0.0/16
-	{2365194240, 2365259775},     // 140.250.0.0/16
-	{2365521920, 2365587455},     // 140.255.0.0/16
-	{2386952192, 2387017727},     // 142.70.0.0/16
-	{2388000768, 2388066303},     // 142.86.0.0/16
-	{2415919104, 2415984639},     // 144.0.0.0/16
-	{2416377856, 2416443391},     // 144.7.0.0/16
-	{2416705536, 2416771071},     // 144.12.0.0/16
-	{2418315776, 2418316287},     // 144.36.146.0/23
-	{2419081216, 2419082239},     // 144.48.64.0/22
-	{2419087360, 2419088383},     // 144.48.88.0/22
-	{2419104768, 2419105791},     // 144.48.156.0/22
-	{2419110912, 2419111935},     // 144.48.180.0/22
-	{2419111936, 2419112959},     // 144.48.184.0/22
-	{2419117056, 2419118079},     // 144.48.204.0/22
-	{2419118080, 2419120127},     // 144.48.208.0/21
-	{2419326976, 2419392511},     // 144.52.0.0/16
-	{2423980032, 2424045567},     // 144.123.0.0/16
-	{2429767680, 2429767935},     // 144.211.80.0/24
-	{2429782528, 2429782783},     // 144.211.138.0/24
-	{2432630784, 2432696319},     // 144.255.0.0/16
-	{2453192704, 2453209087},     // 146.56.192.0/18
-	{2455285504, 2455285759},     // 146.88.175.0/24
-	{2462332928, 2462333951},     // 146.196.56.0/22
-	{2462336000, 2462337023},     // 146.196.68.0/22
-	{2462342144, 2462343167},     // 146.196.92.0/22
-	{2462347264, 2462349311},     // 146.196.112.0/21
-	{2462350336, 2462351359},     // 146.196.124.0/22
-	{2463729920, 2463730175},     // 146.217.137.0/24
-	{2464042752, 2464043007},     // 146.222.79.0/24
-	{2464043264, 2464043519},     // 146.222.81.0/24
-	{2464046592, 2464046847},     // 146.222.94.0/24
-	{2482179360, 2482179391},     // 147.243.13.32/27
-	{2482179392, 2482179423},     // 147.243.13.64/27
-	{2482179616, 2482179647},     // 147.243.14.32/27
-	{2487615488, 2487681023},     // 148.70.0.0/16
-	{2516582400, 2516647935},     // 150.0.0.0/16
-	{2524119040, 2524184575},     // 150.115.0.0/16
-	{2524512256, 2524577791},     // 150.121.0.0/16
-	{2524577792, 2524643327},     // 150.122.0.0/16
-	{2525071360, 2525072383},     // 150.129.136.0/22
-	{2525085696, 2525086719},     // 150.129.192.0/22
-	{2525101056, 2525102079},     // 150.129.252.0/22
-	{2525626368, 2525757439},     // 150.138.0.0/15
-	{2526937088, 2527002623},     // 150.158.0.0/16
-	{2531153920, 2531154431},     // 150.222.88.0/23
-	{2531196928, 2531262463},     // 150.223.0.0/16
-	{2532442112, 2532444159},     // 150.242.0.0/21
-	{2532444160, 2532445183},     // 150.242.8.0/22
-	{2532449280, 2532450303},     // 150.242.28.0/22
-	{2532453376, 2532454399},     // 150.242.44.0/22
-	{2532454400, 2532456447},     // 150.242.48.0/21
-	{2532456448, 2532457471},     // 150.242.56.0/22
-	{2532461568, 2532462591},     // 150.242.76.0/22
-	{2532462592, 2532463615},     // 150.242.80.0/22
-	{2532465664, 2532466687},     // 150.242.92.0/22
-	{2532466688, 2532467711},     // 150.242.96.0/22
-	{2532470784, 2532472831},     // 150.242.112.0/21
-	{2532472832, 2532473855},     // 150.242.120.0/22
-	{2532481024, 2532482047},     // 150.242.152.0/22
-	{2532482560, 2532482815},     // 150.242.158.0/24
-	{2532483072, 2532485119},     // 150.242.160.0/21
-	{2532485120, 2532486143},     // 150.242.168.0/22
-	{2532489216, 2532491263},     // 150.242.184.0/21
-	{2532491264, 2532492287},     // 150.242.192.0/22
-	{2532499968, 2532500479},     // 150.242.226.0/23
-	{2532501504, 2532503551},     // 150.242.232.0/21
-	{2532503552, 2532505599},     // 150.242.240.0/21
-	{2532505600, 2532506623},     // 150.242.248.0/22
-	{2532835328, 2532900863},     // 150.248.0.0/16
-	{2533294080, 2533359615},     // 150.255.0.0/16
-	{2552279552, 2552280063},     // 152.32.178.0/23
-	{2556985344, 2557018111},     // 152.104.128.0/17
-	{2559049728, 2559115263},     // 152.136.0.0/16
-	{2566914048, 2566979583},     // 153.0.0.0/16
-	{2567110656, 2567176191},     // 153.3.0.0/16
-	{2569142272, 2569273343},     // 153.34.0.0/15
-	{2569273344, 2569404415},     // 153.36.0.0/15
-	{2573402112, 2573467647},     // 153.99.0.0/16
-	{2573533184, 2573598719},     // 153.101.0.0/16
-	{2574647296, 2574778367},     // 153.118.0.0/15
-	{2584248320, 2584281087},     // 154.8.128.0/17
-	{2608771072, 2608771583},     // 155.126.176.0/23
-	{2624299008, 2624299263},     // 156.107.160.0/24
-	{2624301568, 2624301823},     // 156.107.170.0/24
-	{2624303872, 2624304127},     // 156.107.179.0/24
-	{2624304384, 2624304639},     // 156.107.181.0/24
-	{2627354112, 2627354623},     // 156.154.62.0/23
-	{2634022912, 2634088447},     // 157.0.0.0/16
-	{2635202560, 2635268095},     // 157.18.0.0/16
-	{2638020608, 2638086143},     // 157.61.0.0/16
-	{2641823744, 2641825791},     // 157.119.8.0/21
-	{2641825792, 2641826815},     // 157.119.16.0/22
-	{2641828864, 2641829887},     // 157.119.28.0/22
-	{2641855488, 2641856511},     // 157.119.132.0/22
-	{2641856512, 2641858559},     // 157.119.136.0/21
-	{2641858560, 2641862655},     // 157.119.144.0/20
-	{2641862656, 2641864703},     // 157.119.160.0/21
-	{2641865728, 2641866751},     // 157.119.172.0/22
-	{2641870848, 2641872895},     // 157.119.192.0/21
-	{2641883136, 2641884159},     // 157.119.240.0/22
-	{2641886208, 2641887231},     // 157.119.252.0/22
-	{2642018304, 2642083839},     // 157.122.0.0/16
-	{2642786816, 2642787327},     // 157.133.186.0/23
-	{2642788352, 2642790399},     // 157.133.192.0/21
-	{2642793472, 2642793727},     // 157.133.212.0/24
-	{2642799616, 2642799871},     // 157.133.236.0/24
-	{2643722240, 2643787775},     // 157.148.0.0/16
-	{2644246528, 2644312063},     // 157.156.0.0/16
-	{2650734592, 2650800127},     // 157.255.0.0/16
-	{2655977472, 2655977727},     // 158.79.0.0/24
-	{2655977984, 2655978495},     // 158.79.2.0/23
-	{2655978496, 2655979519},     // 158.79.4.0/22
-	{2655979520, 2655981567},     // 158.79.8.0/21
-	{2655981568, 2655985663},     // 158.79.16.0/20
-	{2655985664, 2655993855},     // 158.79.32.0/19
-	{2655993856, 2656010239},     // 158.79.64.0/18
-	{2656010240, 2656043007},     // 158.79.128.0/17
-	{2672492544, 2672558079},     // 159.75.0.0/16
-	{2682120192, 2682121215},     // 159.221.232.0/22
-	{2682388480, 2682454015},     // 159.226.0.0/16
-	{2685654016, 2685655039},     // 160.19.212.0/22
-	{2685655040, 2685656063},     // 160.19.216.0/22
-	{2685677568, 2685678591},     // 160.20.48.0/22
-	{2688420352, 2688420607},     // 160.62.10.0/24
-	{2689821952, 2689822207},     // 160.83.109.0/24
-	{2689822208, 2689822719},     // 160.83.110.0/23
-	{2697608192, 2697608703},     // 160.202.60.0/23
-	{2697608704, 2697608959},     // 160.202.62.0/24
-	{2697630720, 2697631743},     // 160.202.148.0/22
-	{2697631744, 2697632767},     // 160.202.152.0/22
-	{2697647104, 2697648127},     // 160.202.212.0/22
-	{2697648128, 2697650175},     // 160.202.216.0/21
-	{2697650176, 2697658367},     // 160.202.224.0/19
-	{2699968512, 2699969535},     // 160.238.64.0/22
-	{2708996096, 2709061631},     // 161.120.0.0/16
-	{2711814144, 2711816191},     // 161.163.0.0/21
-	{2711821312, 2711821823},     // 161.163.28.0/23
-	{2713518080, 2713583615},     // 161.189.0.0/16
-	{2714697728, 2714763263},     // 161.207.0.0/16
-	{2718826496, 2718828543},     // 162.14.0.0/21
-	{2718829568, 2718830591},     // 162.14.12.0/22
-	{2718830592, 2718832639},     // 162.14.16.0/21
-	{2718833152, 2718833663},     // 162.14.26.0/23
-	{2718833664, 2718834687},     // 162.14.28.0/22
-	{2718834688, 2718842879},     // 162.14.32.0/19
-	{2718842880, 2718859263},     // 162.14.64.0/18
-	{2718859264, 2718892031},     // 162.14.128.0/17
-	{2724790272, 2724855807},     // 162.105.0.0/16
-	{2734686208, 2734751743},     // 163.0.0.0/16
-	{2737767424, 2737768447},     // 163.47.4.0/22
-	{2738159616, 2738163711},     // 163.53.0.0/20
-	{2738168832, 2738169855},     // 163.53.36.0/22
-	{2738169856, 2738170879},     // 163.53.40.0/22
-	{2738171904, 2738175999},     // 163.53.48.0/20
-	{2738176000, 2738177023},     // 163.53.64.0/22
-	{2738182144, 2738184191},     // 163.53.88.0/21
-	{2738184192, 2738192383},     // 163.53.96.0/19
-	{2738192384, 2738194431},     // 163.53.128.0/21
-	{2738194432, 2738195455},     // 163.53.136.0/22
-	{2738200576, 2738204671},     // 163.53.160.0/20
-	{2738207744, 2738208767},     // 163.53.188.0/22
-	{2738215936, 2738216959},     // 163.53.220.0/22
-	{2738221056, 2738222079},     // 163.53.240.0/22
-	{2742340096, 2742340607},     // 163.116.202.0/23
-	{2742878208, 2742943743},     // 163.125.0.0/16
-	{2743992320, 2744057855},     // 163.142.0.0/16
-	{2746286080, 2746351615},     // 163.177.0.0/16
-	{2746417152, 2746482687},     // 163.179.0.0/16
-	{2748055552, 2748121087},     // 163.204.0.0/16
-	{2750739968, 2750740223},     // 163.244.246.0/24
-	{2754891776, 2754892031},     // 164.52.80.0/24
-	{2773796096, 2773796351},     // 165.84.197.0/24
-	{2773809920, 2773810047},     // 165.84.251.0/25
-	{2773810048, 2773810111},     // 165.84.251.128/26
-	{2773810112, 2773810143},     // 165.84.251.192/27
-	{2773810144, 2773810159},     // 165.84.251.224/28
-	{2773810160, 2773810167},     // 165.84.251.240/29
-	{2773810168, 2773810171},     // 165.84.251.248/30
-	{2773810173, 2773810173},     // 165.84.251.253/32
-	{2773810174, 2773810175},     // 165.84.251.254/31
-	{2778471936, 2778472191},     // 165.156.30.0/24
-	{2784907803, 2784907803},     // 165.254.82.27/32
-	{2792292352, 2792357887},     // 166.111.0.0/16
-	{2810904576, 2810970111},     // 167.139.0.0/16
-	{2814181376, 2814246911},     // 167.189.0.0/16
-	{2816275456, 2816276479},     // 167.220.244.0/22
-	{2829029376, 2829031423},     // 168.159.144.0/21
-	{2829031424, 2829032447},     // 168.159.152.0/22
-	{2829032448, 2829032959},     // 168.159.156.0/23
-	{2829032960, 2829033215},     // 168.159.158.0/24
-	{2829058048, 2829123583},     // 168.160.0.0/16
-	{2833645568, 2833645823},     // 168.230.0.0/24
-	{2863857664, 2863923199},     // 170.179.0.0/16
-	{2866929664, 2866930175},     // 170.225.224.0/23
-	{2868680704, 2868682751},     // 170.252.152.0/21
-	{2869428224, 2869952511},     // 171.8.0.0/13
-	{2871132160, 2871263231},     // 171.34.0.0/15
-	{2871263232, 2871525375},     // 171.36.0.0/14
-	{2871525376, 2872049663},     // 171.40.0.0/13
-	{2874146816, 2875195391},     // 171.80.0.0/12
-	{2875719680, 2876243967},     // 171.104.0.0/13
-	{2876243968, 2877292543},     // 171.112.0.0/12
-	{2882535424, 2883583999},     // 171.208.0.0/12
-	{2891038720, 2891055103},     // 172.81.192.0/18
-	{2905065472, 2905065983},     // 173.39.200.0/23
-	{2936012800, 2937061375},     // 175.0.0.0/12
-	{2937061376, 2937585663},     // 175.16.0.0/13
-	{2937585664, 2937847807},     // 175.24.0.0/14
-	{2937978880, 2938109951},     // 175.30.0.0/15
-	{2938765312, 2938896383},     // 175.42.0.0/15
-	{2938896384, 2938961919},     // 175.44.0.0/16
-	{2939027456, 2939158527},     // 175.46.0.0/15
-	{2939158528, 2940207103},     // 175.48.0.0/12
-	{2940207104, 2942304255},     // 175.64.0.0/11
-	{2942697472, 2942763007},     // 175.102.0.0/16
-	{2942992384, 2943025151},     // 175.106.128.0/17
-	{2943324160, 2943328255},     // 175.111.144.0/20
-	{2943328256, 2943332351},     // 175.111.160.0/20
-	{2943334400, 2943335423},     // 175.111.184.0/22
-	{2945581056, 2945712127},     // 175.146.0.0/15
-	{2945712128, 2945974271},     // 175.148.0.0/14
-	{2945974272, 2946236415},     // 175.152.0.0/14
-	{2946392064, 2946393087},     // 175.158.96.0/22
-	{2946498560, 2947547135},     // 175.160.0.0/12
-	{2947587072, 2947588095},     // 175.176.156.0/22
-	{2947595264, 2947596287},     // 175.176.188.0/22
-	{2947678208, 2947743743},     // 175.178.0.0/16
-	{2948104192, 2948120575},     // 175.184.128.0/18
-	{2948136960, 2948202495},     // 175.185.0.0/16
-	{2948202496, 2948333567},     // 175.186.0.0/15
-	{2948333568, 2948595711},     // 175.188.0.0/14
-	{3024883712, 3024887807},     // 180.76.16.0/20
-	{3024887808, 3024895999},     // 180.76.32.0/19
-	{3024896000, 3024912383},     // 180.76.64.0/18
-	{3024912384, 3024928767},     // 180.76.128.0/18
-	{3024928768, 3024936959},     // 180.76.192.0/19
-	{3024936960, 3024941055},     // 180.76.224.0/20
-	{3024941056, 3024941311},     // 180.76.240.0/24
-	{3024941568, 3024942079},     // 180.76.242.0/23
-	{3024942080, 3024943103},     // 180.76.244.0/22
-	{3024943104, 3024944127},     // 180.76.248.0/22
-	{3024944128, 3024944639},     // 180.76.252.0/23
-	{3024944896, 3024945151},     // 180.76.255.0/24
-	{3024945152, 3025010687},     // 180.77.0.0/16
-	{3025010688, 3025141759},     // 180.78.0.0/15
-	{3025403904, 3025534975},     // 180.84.0.0/15
-	{3025534976, 3025600511},     // 180.86.0.0/16
-	{3025624418, 3025624418},     // 180.87.93.98/32
-	{3025666048, 3025928191},     // 180.88.0.0/14
-	{3025973248, 3025973759},     // 180.92.176.0/23
-	{3026073600, 3026075647},     // 180.94.56.0/21
-	{3026083840, 3026084351},     // 180.94.96.0/23
-	{3026084352, 3026084607},     // 180.94.98.0/24
-	{3026084864, 3026085887},     // 180.94.100.0/22
-	{3026085888, 3026087935},     // 180.94.104.0/21
-	{3026089984, 3026092031},     // 180.94.120.0/21
-	{3026157568, 3026190335},     // 180.95.128.0/17
-	{3026190336, 3028287487},     // 180.96.0.0/11
-	{3028385792, 3028418559},     // 180.129.128.0/17
-	{3028418560, 3028484095},     // 180.130.0.0/16
-	{3028811776, 3029336063},     // 180.136.0.0/13
-	{3029602304, 3029604351},     // 180.148.16.0/21
-	{3029637120, 3029639167},     // 180.148.152.0/21
-	{3029653504, 3029655551},     // 180.148.216.0/21
-	{3029655552, 3029663743},     // 180.148.224.0/19
-	{3029696512, 3029704703},     // 180.149.128.0/19
-	{3029770240, 3029772287},     // 180.150.160.0/21
-	{3029774336, 3029778431},     // 180.150.176.0/20
-	{3029860352, 3030384639},     // 180.152.0.0/13
-	{3030384640, 3031433215},     // 180.160.0.0/12
-	{3031592960, 3031595007},     // 180.178.112.0/21
-	{3031613440, 3031629823},     // 180.178.192.0/18
-	{3031957504, 3032219647},     // 180.184.0.0/14
-	{3032219648, 3032252415},     // 180.188.0.0/17
-	{3032323072, 3032324095},     // 180.189.148.0/22
-	{3033070592, 3033071615},     // 180.200.252.0/22
-	{3033071616, 3033137151},     // 180.201.0.0/16
-	{3033137152, 3033268223},     // 180.202.0.0/15
-	{3033530368, 3033661439},     // 180.208.0.0/15
-	{3033715712, 3033716735},     // 180.210.212.0/22
-	{3033721088, 3033721343},     // 180.210.233.0/24
-	{3033721856, 3033722879},     // 180.210.236.0/22
-	{3033792512, 3033923583},     // 180.212.0.0/15
-	{3034505216, 3034513407},     // 180.222.224.0/19
-	{3034513408, 3034529791},     // 180.223.0.0/18
-	{3034533888, 3034537983},     // 180.223.80.0/20
-	{3034537984, 3034546175},     // 180.223.96.0/19
-	{3034546432, 3034546687},     // 180.223.129.0/24
-	{3034546688, 3034547199},     // 180.223.130.0/23
-	{3034547200, 3034548223},     // 180.223.132.0/22
-	{3034548224, 3034550271},     // 180.223.136.0/21
-	{3034550272, 3034554367},     // 180.223.144.0/20
-	{3034554368, 3034562559},     // 180.223.160.0/19
-	{3034562560, 3034578943},     // 180.223.192.0/18
-	{3035168768, 3035185151},     // 180.233.0.0/18
-	{3035185152, 3035193343},     // 180.233.64.0/19
-	{3035205632, 3035206655},     // 180.233.144.0/22
-	{3035316224, 3035324415},     // 180.235.64.0/19
-	{3035328512, 3035329535},     // 180.235.112.0/22
-	{3054538752, 3054540799},     // 182.16.144.0/21
-	{3054551040, 3054559231},     // 182.16.192.0/19
-	{3054632960, 3054665727},     // 182.18.0.0/17
-	{3055007744, 3055009791},     // 182.23.184.0/21
-	{3055011840, 3055013887},     // 182.23.200.0/21
-	{3055550464, 3056599039},     // 182.32.0.0/12
-	{3056623616, 3056631807},     // 182.48.96.0/19
-	{3056664576, 3056730111},     // 182.49.0.0/16
-	{3056730112, 3056731135},     // 182.50.0.0/22
-	{3056732160, 3056734207},     // 182.50.8.0/21
-	{3056758784, 3056762879},     // 182.50.112.0/20
-	{3056795648, 3056861183},     // 182.51.0.0/16
-	{3056992256, 3057025023},     // 182.54.0.0/17
-	{3057451008, 3057467391},     // 182.61.0.0/18
-	{3057483776, 3057491967},     // 182.61.128.0/19
-	{3057500160, 3057516543},     // 182.61.192.0/18
-	{3058696192, 3059220479},     // 182.80.0.0/13
-	{3059220480, 3059482623},     // 182.88.0.0/14
-	{3059482624, 3059548159},     // 182.92.0.0/16
-	{3059744768, 3061841919},     // 182.96.0.0/11
-	{3061841920, 3062890495},     // 182.128.0.0/12
-	{3062890496, 3063414783},     // 182.144.0.0/13
-	{3063742464, 3063807999},     // 182.157.0.0/16
-	{3063952384, 3063953407},     // 182.160.52.0/22
-	{3063953408, 3063954431},     // 182.160.56.0/22
-	{3063954432, 3063954943},     // 182.160.60.0/23
-	{3063954944, 3063955199},     // 182.160.62.0/24
-	{3063955456, 3063963647},     // 182.160.64.0/19
-	{3064856576, 3064987647},     // 182.174.0.0/15
-	{3066560512, 3067084799},     // 182.200.0.0/13
-	{3068952576, 3068985343},     // 182.236.128.0/17
-	{3068991488, 3068993535},     // 182.237.24.0/21
-	{3069050880, 3069116415},     // 182.238.0.0/16
-	{3069116416, 3069124607},     // 182.239.0.0/19
-	{3069181952, 3069706239},     // 182.240.0.0/13
-	{3070099456, 3070115839},     // 182.254.0.0/18
-	{3070115840, 3070124031},     // 182.254.64.0/19
-	{3070124032, 3070128127},     // 182.254.96.0/20
-	{3070128128, 3070129151},     // 182.254.112.0/22
-	{3070129408, 3070129663},     // 182.254.117.0/24
-	{3070129920, 3070130175},     // 182.254.119.0/24
-	{3070130176, 3070132223},     // 182.254.120.0/21
-	{3070132224, 3070164991},     // 182.254.128.0/17
-	{3070230528, 3074424831},     // 183.0.0.0/10
-	{3074424832, 3074949119},     // 183.64.0.0/13
-	{3075383296, 3075385343},     // 183.78.160.0/21
-	{3075388416, 3075389439},     // 183.78.180.0/22
-	{3075585024, 3075586047},     // 183.81.180.0/22
-	{3075735552, 3075866623},     // 183.84.0.0/15
-	{3076227072, 3076228095},     // 183.91.128.0/22
-	{3076229120, 3076231167},     // 183.91.136.0/21
-	{3076231168, 3076235263},     // 183.91.144.0/20
-	{3076259840, 3076521983},     // 183.92.0.0/14
-	{3078619136, 3080716287},     // 183.128.0.0/11
-	{3080716288, 3081240575},     // 183.160.0.0/13
-	{3081240576, 3081371647},     // 183.168.0.0/15
-	{3081371648, 3081437183},     // 183.170.0.0/16
-	{3081502720, 3081764863},     // 183.172.0.0/14
-	{3082289152, 3082813439},     // 183.184.0.0/13
-	{3082813440, 3087007743},     // 183.192.0.0/10
-	{3110988800, 3110989055},     // 185.109.236.0/24
-	{3117970944, 3117971007},     // 185.216.118.0/26
-	{3117971008, 3117971039},     // 185.216.118.64/27
-	{3117971056, 3117971071},     // 185.216.118.112/28
-	{3117971072, 3117971199},     // 185.216.118.128/25
-	{3162734592, 3162767359},     // 188.131.128.0/17
-	{3221952256, 3221952511},     // 192.11.23.0/24
-	{3221953024, 3221953279},     // 192.11.26.0/24
-	{3221956352, 3221956607},     // 192.11.39.0/24
-	{3222006784, 3222007039},     // 192.11.236.0/24
-	{3222781696, 3222781951},     // 192.23.191.0/24
-	{3224832512, 3224833023},     // 192.55.10.0/23
-	{3224840192, 3224840447},     // 192.55.40.0/24
-	{3224841728, 3224841983},     // 192.55.46.0/24
-	{3224847360, 3224848383},     // 192.55.68.0/22
-	{3227962368, 3227963391},     // 192.102.204.0/22
-	{3229391360, 3229391615},     // 192.124.154.0/24
-	{3230211840, 3230212095},     // 192.137.31.0/24
-	{3230433280, 3230435327},     // 192.140.128.0/21
-	{3230435328, 3230436351},     // 192.140.136.0/22
-	{3230440448, 3230441471},     // 192.140.156.0/22
-	{3230441472, 3230449663},     // 192.140.160.0/19
-	{3230449664, 3230453759},     // 192.140.192.0/20
-	{3230453760, 3230455807},     // 192.140.208.0/21
-	{3230695424, 3230728191},     // 192.144.128.0/17
-	{3231910656, 3231910911},     // 192.163.11.0/24
-	{3236454656, 3236454911},     // 192.232.97.0/24
-	{3239147520, 3239148543},     // 193.17.120.0/22
-	{3239329792, 3239330815},     // 193.20.64.0/22
-	{3245342720, 3245408255},     // 193.112.0.0/16
-	{3251160064, 3251160319},     // 193.200.196.0/24
-	{3251166880, 3251166895},     // 193.200.222.160/28
-	{3263858688, 3263858943},     // 194.138.136.0/24
-	{3263875584, 3263876095},     // 194.138.202.0/23
-	{3263886592, 3263886847},     // 194.138.245.0/24
-	{3280918272, 3280918527},     // 195.142.215.0/24
-	{3333383168, 3333384191},     // 198.175.100.0/22
-	{3335524608, 3335524863},     // 198.208.17.0/24
-	{3335525120, 3335525375},     // 198.208.19.0/24
-	{3339143168, 3339143423},     // 199.7.72.0/24
-	{3342974976, 3342977023},     // 199.65.192.0/21
-	{3354693632, 3354693887},     // 199.244.144.0/24
-	{3389023232, 3389023743},     // 202.0.100.0/23
-	{3389028864, 3389029375},     // 202.0.122.0/23
-	{3389090048, 3389090303},     // 202.1.105.0/24
-	{3389090304, 3389090559},     // 202.1.106.0/24
-	{3389227008, 3389227519},     // 202.3.128.0/23
-	{3389292544, 3389300735},     // 202.4.128.0/19
-	{3389324288, 3389325311},     // 202.4.252.0/22
-	{3389378560, 3389380607},     // 202.5.208.0/21
-	{3389380608, 3389381631},     // 202.5.216.0/22
-	{3389392384, 3389392895},     // 202.6.6.0/23
-	{3389407744, 3389408255},     // 202.6.66.0/23
-	{3389409280, 3389409791},     // 202.6.72.0/23
-	{3389413120, 3389413375},     // 202.6.87.0/24
-	{3389413376, 3389413887},     // 202.6.88.0/23
-	{3389414400, 3389414911},     // 202.6.92.0/23
-	{3389417216, 3389417471},     // 202.6.103.0/24
-	{3389418496, 3389418751},     // 202.6.108.0/24
-	{3389419008, 3389419519},     // 202.6.110.0/23
-	{3389420032, 3389420287},     // 202.6.114.0/24
-	{3389435904, 3389439999},     // 202.6.176.0/20
-	{3389521920, 3389522175},     // 202.8.0.0/24
-	{3389522432, 3389522943},     // 202.8.2.0/23
-	{3389522944, 3389523455},     // 202.8.4.0/23
-	{3389524992, 3389525247},     // 202.8.12.0/24
-	{3389528064, 3389528319},     // 202.8.24.0/24
-	{3389541632, 3389541887},     // 202.8.77.0/24
-	{3389554688, 3389562879},     // 202.8.128.0/19
-	{3389571072, 3389575167},     // 202.8.192.0/20
-	{3389595648, 3389595903},     // 202.9.32.0/24
-	{3389596160, 3389596671},     // 202.9.34.0/23
-	{3389599744, 3389600255},     // 202.9.48.0/23
-	{3389600512, 3389600767},     // 202.9.51.0/24
-	{3389600768, 3389601279},     // 202.9.52.0/23
-	{3389601280, 3389601535},     // 202.9.54.0/24
-	{3389602048, 3389602303},     // 202.9.57.0/24
-	{3389602304, 3389602815},     // 202.9.58.0/23
-	{3389669376, 3389671423},     // 202.10.64.0/21
-	{3389671936, 3389672447},     // 202.10.74.0/23
-	{3389672448, 3389673471},     // 202.10.76.0/22
-	{3389681664, 3389685759},     // 202.10.112.0/20
-	{3389784320, 3389784575},     // 202.12.1.0/24
-	{3389784576, 3389784831},     // 202.12.2.0/24
-	{3389788416, 3389788671},     // 202.12.17.0/24
-	{3389788672, 3389789183},     // 202.12.18.0/23
-	{3389802496, 3389802751},     // 202.12.72.0/24
-	{3389805568, 3389806079},     // 202.12.84.0/23
-	{3389808640, 3389808895},     // 202.12.96.0/24
-	{3389809152, 3389809663},     // 202.12.98.0/23
-	{3389811200, 3389811455},     // 202.12.106.0/24
-	{3389812480, 3389812735},     // 202.12.111.0/24
-	{3389813760, 3389814015},     // 202.12.116.0/24
-	{3389931520, 3389932031},     // 202.14.64.0/23
-	{3389932800, 3389933055},     // 202.14.69.0/24
-	{3389933824, 3389934079},     // 202.14.73.0/24
-	{3389934080, 3389934591},     // 202.14.74.0/23
-	{3389934592, 3389934847},     // 202.14.76.0/24
-	{3389935104, 3389935615},     // 202.14.78.0/23
-	{3389937664, 3389937919},     // 202.14.88.0/24
-	{3389939968, 3389940223},     // 202.14.97.0/24
-	{3389941760, 3389942271},     // 202.14.104.0/23
-	{3389942784, 3389943295},     // 202.14.108.0/23
-	{3389943552, 3389943807},     // 202.14.111.0/24
-	{3389944320, 3389944831},     // 202.14.114.0/23
-	{3389945344, 3389945855},     // 202.14.118.0/23
-	{3389946880, 3389947391},     // 202.14.124.0/23
-	{3389947648, 3389947903},     // 202.14.127.0/24
-	{3389948160, 3389948415},     // 202.14.129.0/24
-	{3389949696, 3389949951},     // 202.14.135.0/24
-	{3389949952, 3389950207},     // 202.14.136.0/24
-	{3389953280, 3389953535},     // 202.14.149.0/24
-	{3389953792, 3389954047},     // 202.14.151.0/24
-	{3389955328, 3389955583},     // 202.14.157.0/24
-	{3389955584, 3389956095},     // 202.14.158.0/23
-	{3389958400, 3389958655},     // 202.14.169.0/24
-	{3389958656, 3389959167},     // 202.14.170.0/23
-	{3389959168, 3389960191},     // 202.14.172.0/22
-	{3389960192, 3389960447},     // 202.14.176.0/24
-	{3389962240, 3389962751},     // 202.14.184.0/23
-	{3389968384, 3389968895},     // 202.14.208.0/23
-	{3389969664, 3389969919},     // 202.14.213.0/24
-	{3389971200, 3389971455},     // 202.14.219.0/24
-	{3389971456, 3389971711},     // 202.14.220.0/24
-	{3389971968, 3389972479},     // 202.14.222.0/23
-	{3389972736, 3389972991},     // 202.14.225.0/24
-	{3389972992, 3389973503},     // 202.14.226.0/23
-	{3389974272, 3389974527},     // 202.14.231.0/24
-	{3389975296, 3389975551},     // 202.14.235.0/24
-	{3389975552, 3389976575},     // 202.14.236.0/22
-	{3389978112, 3389978367},     // 202.14.246.0/24
-	{3389979392, 3389979647},     // 202.14.251.0/24
-	{3390325248, 3390325503},     // 202.20.66.0/24
-	{3390328576, 3390328831},     // 202.20.79.0/24
-	{3390330624, 3390330879},     // 202.20.87.0/24
-	{3390330880, 3390331391},     // 202.20.88.0/23
-	{3390331392, 3390331647},     // 202.20.90.0/24
-	{3390332416, 3390332927},     // 202.20.94.0/23
-	{3390337536, 3390337791},     // 202.20.114.0/24
-	{3390338304, 3390338559},     // 202.20.117.0/24
-	{3390339072, 3390339327},     // 202.20.120.0/24
-	{3390340352, 3390340607},     // 202.20.125.0/24
-	{3390340608, 3390341119},     // 202.20.126.0/23
-	{3390386176, 3390390271},     // 202.21.48.0/20
-	{3390407424, 3390407679},     // 202.21.131.0/24
-	{3390407680, 3390407935},     // 202.21.132.0/24
-	{3390409984, 3390410239},     // 202.21.141.0/24
-	{3390410240, 3390410495},     // 202.21.142.0/24
-	{3390411520, 3390411775},     // 202.21.147.0/24
-	{3390411776, 3390412031},     // 202.21.148.0/24
-	{3390412288, 3390412799},     // 202.21.150.0/23
-	{3390412800, 3390413311},     // 202.21.152.0/23
-	{3390413312, 3390413567},     // 202.21.154.0/24
-	{3390413824, 3390414079},     // 202.21.156.0/24
-	{3390427136, 3390427391},     // 202.21.208.0/24
-	{3390502912, 3390504959},     // 202.22.248.0/21
-	{3390770176, 3390770431},     // 202.27.12.0/24
-	{3390770688, 3390770943},     // 202.27.14.0/24
-	{3390801920, 3390802431},     // 202.27.136.0/23
-	{3391414784, 3391415039},     // 202.36.226.0/24
-	{3391488000, 3391489023},     // 202.38.0.0/22
-	{3391490048, 3391492095},     // 202.38.8.0/21
-	{3391500288, 3391504383},     // 202.38.48.0/20
-	{3391504384, 3391520767},     // 202.38.64.0/18
-	{3391520768, 3391522815},     // 202.38.128.0/21
-	{3391522816, 3391523327},     // 202.38.136.0/23
-	{3391523328, 3391523583},     // 202.38.138.0/24
-	{3391523840, 3391524863},     // 202.38.140.0/22
-	{3391525376, 3391525887},     // 202.38.146.0/23
-	{3391526144, 3391526399},     // 202.38.149.0/24
-	{3391526400, 3391526911},     // 202.38.150.0/23
-	{3391526912, 3391527935},     // 202.38.152.0/22
-	{3391527936, 3391528191},     // 202.38.156.0/24
-	{3391528448, 3391528959},     // 202.38.158.0/23
-	{3391528960, 3391529471},     // 202.38.160.0/23
-	{3391529984, 3391531007},     // 202.38.164.0/22
-	{3391531008, 3391532031},     // 202.38.168.0/22
-	{3391533056, 3391533567},     // 202.38.176.0/23
-	{3391535104, 3391537151},     // 202.38.184.0/21
-	{3391537152, 3391553535},     // 202.38.192.0/18
-	{3391620096, 3391620607},     // 202.40.4.0/23
-	{3391620864, 3391621119},     // 202.40.7.0/24
-	{3391622912, 3391623167},     // 202.40.15.0/24
-	{3391653632, 3391653887},     // 202.40.135.0/24
-	{3391653888, 3391654143},     // 202.40.136.0/24
-	{3391654912, 3391655167},     // 202.40.140.0/24
-	{3391655680, 3391655935},     // 202.40.143.0/24
-	{3391655936, 3391656447},     // 202.40.144.0/23
-	{3391657472, 3391657727},     // 202.40.150.0/24
-	{3391658752, 3391659007},     // 202.40.155.0/24
-	{3391659008, 3391659263},     // 202.40.156.0/24
-	{3391659520, 3391660031},     // 202.40.158.0/23
-	{3391660544, 3391660799},     // 202.40.162.0/24
-	{3391686656, 3391687167},     // 202.41.8.0/23
-	{3391687424, 3391687679},     // 202.41.11.0/24
-	{3391687680, 3391688191},     // 202.41.12.0/23
-	{3391717376, 3391717631},     // 202.41.128.0/24
-	{3391717888, 3391718399},     // 202.41.130.0/23
-	{3391720960, 3391721215},     // 202.41.142.0/24
-	{3391723520, 3391725567},     // 202.41.152.0/21
-	{3391733760, 3391734015},     // 202.41.192.0/24
-	{3391734784, 3391735807},     // 202.41.196.0/22
-	{3391735808, 3391736831},     // 202.41.200.0/22
-	{3391746048, 3391750143},     // 202.41.240.0/20
-	{3391835136, 3391836159},     // 202.43.76.0/22
-	{3391852544, 3391856639},     // 202.43.144.0/20
-	{3391885312, 3391889407},     // 202.44.16.0/20
-	{3391893504, 3391894527},     // 202.44.48.0/22
-	{3391898368, 3391898623},     // 202.44.67.0/24
-	{3391900160, 3391900415},     // 202.44.74.0/24
-	{3391906048, 3391906303},     // 202.44.97.0/24
-	{3391914240, 3391914495},     // 202.44.129.0/24
-	{3391915008, 3391915519},     // 202.44.132.0/23
-	{3391918592, 3391919103},     // 202.44.146.0/23
-	{3391946752, 3391947263},     // 202.45.0.0/23
-	{3391947264, 3391947519},     // 202.45.2.0/24
-	{3391950592, 3391950847},     // 202.45.15.0/24
-	{3391950848, 3391954943},     // 202.45.16.0/20
-	{3392016384, 3392016895},     // 202.46.16.0/23
-	{3392016896, 3392017151},     // 202.46.18.0/24
-	{3392017408, 3392017919},     // 202.46.20.0/23
-	{3392045056, 3392045311},     // 202.46.128.0/24
-	{3392069632, 3392073727},     // 202.46.224.0/20
-	{3392098816, 3392099327},     // 202.47.82.0/23
-	{3392102400, 3392106495},     // 202.47.96.0/20
-	{3392110080, 3392110335},     // 202.47.126.0/24
-	{3392110592, 3392110847},     // 202.47.128.0/24
-	{3392111104, 3392111615},     // 202.47.130.0/23
-	{3392414208, 3392414463},     // 202.52.34.0/24
-	{3392442112, 3392442367},     // 202.52.143.0/24
-	{3392506880, 3392507135},     // 202.53.140.0/24
-	{3392507648, 3392507903},     // 202.53.143.0/24
-	{3392522752, 3392523007},     // 202.53.202.0/24
-	{3392787456, 3392788479},     // 202.57.212.0/22
-	{3392788480, 3392789503},     // 202.57.216.0/22
-	{3392794624, 3392798719},     // 202.57.240.0/20
-	{3392798720, 3392798975},     // 202.58.0.0/24
-	{3392827392, 3392828415},     // 202.58.112.0/22
-	{3392864256, 3392864767},     // 202.59.0.0/23
-	{3392918528, 3392919551},     // 202.59.212.0/22
-	{3392924672, 3392924927},     // 202.59.236.0/24
-	{3392925696, 3392925951},     // 202.59.240.0/24
-	{3392942080, 3392944127},     // 202.60.48.0/21
-	{3392954368, 3392956415},     // 202.60.96.0/21
-	{3392958464, 3392962559},     // 202.60.112.0/20
-	{3392963584, 3392964607},     // 202.60.132.0/22
-	{3392964608, 3392966655},     // 202.60.136.0/21
-	{3392966656, 3392970751},     // 202.60.144.0/20
-	{3393012736, 3393013759},     // 202.61.68.0/22
-	{3393014784, 3393015807},     // 202.61.76.0/22
-	{3393017856, 3393018879},     // 202.61.88.0/22
-	{3393026816, 3393027071},     // 202.61.123.0/24
-	{3393027840, 3393028095},     // 202.61.127.0/24
-	{3393089536, 3393090559},     // 202.62.112.0/22
-	{3393124352, 3393125375},     // 202.62.248.0/22
-	{3393125376, 3393125631},     // 202.62.252.0/24
-	{3393126144, 3393126399},     // 202.62.255.0/24
-	{3393146880, 3393150975},     // 202.63.80.0/20
-	{3393167360, 3393175551},     // 202.63.160.0/19
-	{3393189888, 3393190911},     // 202.63.248.0/22
-	{3393191168, 3393191423},     // 202.63.253.0/24
-	{3393257472, 3393259519},     // 202.65.0.0/21
-	{3393259520, 3393260031},     // 202.65.8.0/23
-	{3393388544, 3393389567},     // 202.67.0.0/22
-	{3393520640, 3393521151},     // 202.69.4.0/23
-	{3393523712, 3393527807},     // 202.69.16.0/20
-	{3393585152, 3393593343},     // 202.70.0.0/19
-	{3393609728, 3393613823},     // 202.70.96.0/20
-	{3393634304, 3393638399},     // 202.70.192.0/20
-	{3393658880, 3393662975},     // 202.71.32.0/20
-	{3393726464, 3393728511},     // 202.72.40.0/21
-	{3393736704, 3393740799},     // 202.72.80.0/20
-	{3393744896, 3393748991},     // 202.72.112.0/20
-	{3393814528, 3393815551},     // 202.73.128.0/22
-	{3393843200, 3393847295},     // 202.73.240.0/20
-	{3393849344, 3393851391},     // 202.74.8.0/21
-	{3393856512, 3393856767},     // 202.74.36.0/24
-	{3393858048, 3393858303},     // 202.74.42.0/24
-	{3393860608, 3393860863},     // 202.74.52.0/24
-	{3393867776, 3393871871},     // 202.74.80.0/20
-	{3393912320, 3393912831},     // 202.74.254.0/23
-	{3393966080, 3393970175},     // 202.75.208.0/20
-	{3393977344, 3393978367},     // 202.75.252.0/22
-	{3394041600, 3394041855},     // 202.76.247.0/24
-	{3394042880, 3394043903},     // 202.76.252.0/22
-	{3394064384, 3394066431},     // 202.77.80.0/21
-	{3394067456, 3394068479},     // 202.77.92.0/22
-	{3394111488, 3394113535},     // 202.78.8.0/21
-	{3394232320, 3394234367},     // 202.79.224.0/21
-	{3394238464, 3394239487},     // 202.79.248.0/22
-	{3394289664, 3394293759},     // 202.80.192.0/20
-	{3394306048, 3394307071},     // 202.81.0.0/22
-	{3394351104, 3394355199},     // 202.81.176.0/20
-	{3394501632, 3394502655},     // 202.83.252.0/22
-	{3394502656, 3394506751},     // 202.84.0.0/20
-	{3394506752, 3394507263},     // 202.84.16.0/23
-	{3394508288, 3394508543},     // 202.84.22.0/24
-	{3394508800, 3394510847},     // 202.84.24.0/21
-	{3394621440, 3394625535},     // 202.85.208.0/20
-	{3394697472, 3394697727},     // 202.86.249.0/24
-	{3394719744, 3394723839},     // 202.87.80.0/20
-	{3394772992, 3394774015},     // 202.88.32.0/22
-	{3394832384, 3394834431},     // 202.89.8.0/21
-	{3394854912, 3394855935},     // 202.89.96.0/22
-	{3394857984, 3394859007},     // 202.89.108.0/22
-	{3394860800, 3394861055},     // 202.89.119.0/24
-	{3394889728, 3394891775},     // 202.89.232.0/21
-	{3394895872, 3394896895},     // 202.90.0.0/22
-	{3394899968, 3394904063},     // 202.90.16.0/20
-	{3394905344, 3394905599},     // 202.90.37.0/24
-	{3394920448, 3394928639},     // 202.90.96.0/19
-	{3394945280, 3394945535},     // 202.90.193.0/24
-	{3394946048, 3394946303},     // 202.90.196.0/24
-	{3394948352, 3394948607},     // 202.90.205.0/24
-	{3394953216, 3394957311},     // 202.90.224.0/20
-	{3394961408, 3394962431},     // 202.91.0.0/22
-	{3394985984, 3394990079},     // 202.91.96.0/20
-	{3394994176, 3394995199},     // 202.91.128.0/22
-	{3395006464, 3395010559},     // 202.91.176.0/20
-	{3395018752, 3395026943},     // 202.91.224.0/19
-	{3395026944, 3395027967},     // 202.92.0.0/22
-	{3395028992, 3395031039},     // 202.92.8.0/21
-	{3395039232, 3395043327},     // 202.92.48.0/20
-	{3395091456, 3395092479},     // 202.92.252.0/22
-	{3395092480, 3395093503},     // 202.93.0.0/22
-	{3395156992, 3395158015},     // 202.93.252.0/22
-	{3395158016, 3395166207},     // 202.94.0.0/19
-	{3395176960, 3395177215},     // 202.94.74.0/24
-	{3395178752, 3395179007},     // 202.94.81.0/24
-	{3395181568, 3395182591},     // 202.94.92.0/22
-	{3395284992, 3395287039},     // 202.95.240.0/21
-	{3395288064, 3395289087},     // 202.95.252.0/22
-	{3395289088, 3396337663},     // 202.96.0.0/12
-	{3396337664, 3396861951},     // 202.112.0.0/13
-	{3396861952, 3396993023},     // 202.120.0.0/15
-	{3396993024, 3396995071},     // 202.122.0.0/21
-	{3397001216, 3397003263},     // 202.122.32.0/21
-	{3397009408, 3397017599},     // 202.122.64.0/19
-	{3397021696, 3397025791},     // 202.122.112.0/20
-	{3397025792, 3397026047},     // 202.122.128.0/24
-	{3397026816, 3397027071},     // 202.122.132.0/24
-	{3397083136, 3397087231},     // 202.123.96.0/20
-	{3397088256, 3397089279},     // 202.123.116.0/22
-	{3397089280, 3397090303},     // 202.123.120.0/22
-	{3397128192, 3397130239},     // 202.124.16.0/21
-	{3397130240, 3397131263},     // 202.124.24.0/22
-	{3397217024, 3397217279},     // 202.125.107.0/24
-	{3397217536, 3397217791},     // 202.125.109.0/24
-	{3397218304, 3397222399},     // 202.125.112.0/20
-	{3397234688, 3397238783},     // 202.125.176.0/20
-	{3397320704, 3397322751},     // 202.127.0.0/21
-	{3397323776, 3397324799},     // 202.127.12.0/22
-	{3397324800, 3397328895},     // 202.127.16.0/20
-	{3397330944, 3397332991},     // 202.127.40.0/21
-	{3397332992, 3397337087},     // 202.127.48.0/20
-	{3397349376, 3397353471},     // 202.127.112.0/20
-	{3397353472, 3397361663},     // 202.127.128.0/19
-	{3397361664, 3397363711},     // 202.127.160.0/21
-	{3397369856, 3397373951},     // 202.127.192.0/20
-	{3397373952, 3397374463},     // 202.127.208.0/23
-	{3397374976, 3397375999},     // 202.127.212.0/22
-	{3397376000, 3397378047},     // 202.127.216.0/21
-	{3397378048, 3397386239},     // 202.127.224.0/19
-	{3397505024, 3397505279},     // 202.129.208.0/24
-	{3397517312, 3397525503},     // 202.130.0.0/19
-	{3397527296, 3397527551},     // 202.130.39.0/24
-	{3397574656, 3397582847},     // 202.130.224.0/19
-	{3397586944, 3397588991},     // 202.131.16.0/21
-	{3397597952, 3397598207},     // 202.131.59.0/24
-	{3397636096, 3397640191},     // 202.131.208.0/20
-	{3397722112, 3397726207},     // 202.133.32.0/20
-	{3397794304, 3397794559},     // 202.134.58.0/24
-	{3397812224, 3397816319},     // 202.134.128.0/20
-	{3397832704, 3397836799},     // 202.134.208.0/20
-	{3397922816, 3397926911},     // 202.136.48.0/20
-	{3397963776, 3397967871},     // 202.136.208.0/20
-	{3397967872, 3397971967},     // 202.136.224.0/20
-	{3397974016, 3397975039},     // 202.136.248.0/22
-	{3397975552, 3397976063},     // 202.136.254.0/23
-	{3398035200, 3398035455},     // 202.137.231.0/24
-	{3398208512, 3398209535},     // 202.140.140.0/22
-	{3398209536, 3398213631},     // 202.140.144.0/20
-	{3398279168, 3398287359},     // 202.141.160.0/19
-	{3398307840, 3398311935},     // 202.142.16.0/20
-	{3398370304, 3398371327},     // 202.143.4.0/22
-	{3398373376, 3398377471},     // 202.143.16.0/20
-	{3398377472, 3398381567},     // 202.143.32.0/20
-	{3398383616, 3398385663},     // 202.143.56.0/21
-	{3398394880, 3398395903},     // 202.143.100.0/22
-	{3398395904, 3398396927},     // 202.143.104.0/22
-	{3398606848, 3398610943},     // 202.146.160.0/20
-	{3398613504, 3398613759},     // 202.146.186.0/24
-	{3398614016, 3398615039},     // 202.146.188.0/22
-	{3398616064, 3398617087},     // 202.146.196.0/22
-	{3398617088, 3398619135},     // 202.146.200.0/21
-	{3398634496, 3398634559},     // 202.147.12.0/26
-	{3398634560, 3398634591},     // 202.147.12.64/27
-	{3398634592, 3398634607},     // 202.147.12.96/28
-	{3398634624, 3398634631},     // 202.147.12.128/29
-	{3398634632, 3398634635},     // 202.147.12.136/30
-	{3398634636, 3398634637},     // 202.147.12.140/31
-	{3398634638, 3398634638},     // 202.147.12.142/32
-	{3398634640, 3398634655},     // 202.147.12.144/28
-	{3398634656, 3398634687},     // 202.147.12.160/27
-	{3398634688, 3398634751},     // 202.147.12.192/26
-	{3398668288, 3398672383},     // 202.147.144.0/20
-	{3398705152, 3398709247},     // 202.148.32.0/20
-	{3398713344, 3398729727},     // 202.148.64.0/18
-	{3398770688, 3398778879},     // 202.149.32.0/19
-	{3398803456, 3398811647},     // 202.149.160.0/19
-	{3398819840, 3398828031},     // 202.149.224.0/19
-	{3398832128, 3398836223},     // 202.150.16.0/20
-	{3398836224, 3398840319},     // 202.150.32.0/20
-	{3398842368, 3398843391},     // 202.150.56.0/22
-	{3398877184, 3398881279},     // 202.150.192.0/20
-	{3398885376, 3398893567},     // 202.150.224.0/19
-	{3398893568, 3398894591},     // 202.151.0.0/22
-	{3398926336, 3398934527},     // 202.151.128.0/19
-	{3399004160, 3399008255},     // 202.152.176.0/20
-	{3399024640, 3399025663},     // 202.153.0.0/22
-	{3399026432, 3399026687},     // 202.153.7.0/24
-	{3399036928, 3399041023},     // 202.153.48.0/20
-	{3399335936, 3399344127},     // 202.157.192.0/19
-	{3399393280, 3399401471},     // 202.158.160.0/19
-	{3399414272, 3399414527},     // 202.158.242.0/24
-	{3399519232, 3399520255},     // 202.160.140.0/22
-	{3399523328, 3399524351},     // 202.160.156.0/22
-	{3399528448, 3399532543},     // 202.160.176.0/20
-	{3399631616, 3399631871},     // 202.162.67.0/24
-	{3399633664, 3399633919},     // 202.162.75.0/24
-	{3399745536, 3399749631},     // 202.164.0.0/20
-	{3399770112, 3399778303},     // 202.164.96.0/19
-	{3399856128, 3399860223},     // 202.165.176.0/20
-	{3399864320, 3399868415},     // 202.165.208.0/20
-	{3399872256, 3399872511},     // 202.165.239.0/24
-	{3399872512, 3399873023},     // 202.165.240.0/23
-	{3399873280, 3399873535},     // 202.165.243.0/24
-	{3399873792, 3399874047},     // 202.165.245.0/24
-	{3399875328, 3399875583},     // 202.165.251.0/24
-	{3399875584, 3399876607},     // 202.165.252.0/22
-	{3399933952, 3399942143},     // 202.166.224.0/19
-	{3400028160, 3400029183},     // 202.168.80.0/22
-	{3400040448, 3400044543},     // 202.168.128.0/20
-	{3400048640, 3400056831},     // 202.168.160.0/19
-	{3400171520, 3400179711},     // 202.170.128.0/19
-	{3400194048, 3400196095},     // 202.170.216.0/21
-	{3400196096, 3400204287},     // 202.170.224.0/19
-	{3400259584, 3400261631},     // 202.171.216.0/21
-	{3400263680, 3400263935},     // 202.171.232.0/24
-	{3400264448, 3400264703},     // 202.171.235.0/24
-	{3400269824, 3400270847},     // 202.172.0.0/22
-	{3400271616, 3400271871},     // 202.172.7.0/24
-	{3400335360, 3400336383},     // 202.173.0.0/22
-	{3400336896, 3400337151},     // 202.173.6.0/24
-	{3400337408, 3400339455},     // 202.173.8.0/21
-	{3400364032, 3400365055},     // 202.173.112.0/22
-	{3400392704, 3400400895},     // 202.173.224.0/19
-	{3400417280, 3400421375},     // 202.174.64.0/20
-	{3400432640, 3400433663},     // 202.174.124.0/22
-	{3400589312, 3400597503},     // 202.176.224.0/19
-	{3400769536, 3400773631},     // 202.179.160.0/20
-	{3400790016, 3400794111},     // 202.179.240.0/20
-	{3400826880, 3400835071},     // 202.180.128.0/19
-	{3400847360, 3400849407},     // 202.180.208.0/21
-	{3400861696, 3400862719},     // 202.181.8.0/22
-	{3400866816, 3400867839},     // 202.181.28.0/22
-	{3400888320, 3400892415},     // 202.181.112.0/20
-	{3400933376, 3400937471},     // 202.182.32.0/20
-	{3400974336, 3400982527},     // 202.182.192.0/19
-	{3401383936, 3401400319},     // 202.189.0.0/18
-	{3401404416, 3401408511},     // 202.189.80.0/20
-	{3401431040, 3401433087},     // 202.189.184.0/21
-	{3401515008, 3401515263},     // 202.191.0.0/24
-	{3401532416, 3401533439},     // 202.191.68.0/22
-	{3401533440, 3401535487},     // 202.191.72.0/21
-	{3401535488, 3401539583},     // 202.191.80.0/20
-	{3401580544, 3402629119},     // 202.192.0.0/12
-	{3405775872, 3405776895},     // 203.0.4.0/22
-	{3405777408, 3405777919},     // 203.0.10.0/23
-	{3405779456, 3405779711},     // 203.0.18.0/24
-	{3405780992, 3405781247},     // 203.0.24.0/24
-	{3405785600, 3405786111},     // 203.0.42.0/23
-	{3405786368, 3405786623},     // 203.0.45.0/24
-	{3405786624, 3405787135},     // 203.0.46.0/23
-	{3405795584, 3405795839},     // 203.0.81.0/24
-	{3405795840, 3405796351},     // 203.0.82.0/23
-	{3405797888, 3405798399},     // 203.0.90.0/23
-	{3405799424, 3405799935},     // 203.0.96.0/23
-	{3405801472, 3405803519},     // 203.0.104.0/21
-	{3405804032, 3405804543},     // 203.0.114.0/23
-	{3405806080, 3405806335},     // 203.0.122.0/24
-	{3405807616, 3405807871},     // 203.0.128.0/24
-	{3405808128, 3405808639},     // 203.0.130.0/23
-	{3405808640, 3405809663},     // 203.0.132.0/22
-	{3405809920, 3405810175},     // 203.0.137.0/24
-	{3405811200, 3405811455},     // 203.0.142.0/24
-	{3405811712, 3405811967},     // 203.0.144.0/24
-	{3405812224, 3405812479},     // 203.0.146.0/24
-	{3405812736, 3405812991},     // 203.0.148.0/24
-	{3405813248, 3405813759},     // 203.0.150.0/23
-	{3405813760, 3405814015},     // 203.0.152.0/24
-	{3405820160, 3405820415},     // 203.0.177.0/24
-	{3405832192, 3405832447},     // 203.0.224.0/24
-	{3405841408, 3405842431},     // 203.1.4.0/22
-	{3405844992, 3405845247},     // 203.1.18.0/24
-	{3405847040, 3405847551},     // 203.1.26.0/23
-	{3405857024, 3405857279},     // 203.1.65.0/24
-	{3405857280, 3405857791},     // 203.1.66.0/23
-	{3405858304, 3405858815},     // 203.1.70.0/23
-	{3405859840, 3405860351},     // 203.1.76.0/23
-	{3405863424, 3405863679},     // 203.1.90.0/24
-	{3405865216, 3405865471},     // 203.1.97.0/24
-	{3405865472, 3405865983},     // 203.1.98.0/23
-	{3405865984, 3405867007},     // 203.1.100.0/22
-	{3405868032, 3405868287},     // 203.1.108.0/24
-	{3405905152, 3405905407},     // 203.1.253.0/24
-	{3405905408, 3405905663},     // 203.1.254.0/24
-	{3405922304, 3405924351},     // 203.2.64.0/21
-	{3405924608, 3405924863},     // 203.2.73.0/24
-	{3405934592, 3405936639},     // 203.2.112.0/21
-	{3405938176, 3405938687},     // 203.2.126.0/23
-	{3405941760, 3405942015},     // 203.2.140.0/24
-	{3405944320, 3405944575},     // 203.2.150.0/24
-	{3405944832, 3405945855},     // 203.2.152.0/22
-	{3405945856, 3405946367},     // 203.2.156.0/23
-	{3405946880, 3405948927},     // 203.2.160.0/21
-	{3405952000, 3405952511},     // 203.2.180.0/23
-	{3405956096, 3405956607},     // 203.2.196.0/23
-	{3405959424, 3405959679},     // 203.2.209.0/24
-	{3405960704, 3405961215},     // 203.2.214.0/23
-	{3405963776, 3405964287},     // 203.2.226.0/23
-	{3405964544, 3405964799},     // 203.2.229.0/24
-	{3405966336, 3405966847},     // 203.2.236.0/23
-	{3405988864, 3405989119},     // 203.3.68.0/24
-	{3405989888, 3405990399},     // 203.3.72.0/23
-	{3405990656, 3405990911},     // 203.3.75.0/24
-	{3405991936, 3405993983},     // 203.3.80.0/21
-	{3405996032, 3405997055},     // 203.3.96.0/22
-	{3405998336, 3405998591},     // 203.3.105.0/24
-	{3406000128, 3406002175},     // 203.3.112.0/21
-	{3406002176, 3406002431},     // 203.3.120.0/24
-	{3406002944, 3406003199},     // 203.3.123.0/24
-	{3406006016, 3406006271},     // 203.3.135.0/24
-	{3406007040, 3406007295},     // 203.3.139.0/24
-	{3406008064, 3406008319},     // 203.3.143.0/24
-	{3406070784, 3406071295},     // 203.4.132.0/23
-	{3406071296, 3406071551},     // 203.4.134.0/24
-	{3406075648, 3406075903},     // 203.4.151.0/24
-	{3406075904, 3406076927},     // 203.4.152.0/22
-	{3406081536, 3406082047},     // 203.4.174.0/23
-	{3406083072, 3406083327},     // 203.4.180.0/24
-	{3406084608, 3406084863},     // 203.4.186.0/24
-	{3406089472, 3406089727},     // 203.4.205.0/24
-	{3406090240, 3406091263},     // 203.4.208.0/22
-	{3406095104, 3406095359},     // 203.4.227.0/24
-	{3406095872, 3406096383},     // 203.4.230.0/23
-	{3406103552, 3406104063},     // 203.5.4.0/23
-	{3406104320, 3406104575},     // 203.5.7.0/24
-	{3406104576, 3406105087},     // 203.5.8.0/23
-	{3406105344, 3406105599},     // 203.5.11.0/24
-	{3406107904, 3406108159},     // 203.5.21.0/24
-	{3406108160, 3406108415},     // 203.5.22.0/24
-	{3406113792, 3406114047},     // 203.5.44.0/24
-	{3406114304, 3406114815},     // 203.5.46.0/23
-	{3406115840, 3406116863},     // 203.5.52.0/22
-	{3406116864, 3406117375},     // 203.5.56.0/23
-	{3406117888, 3406118399},     // 203.5.60.0/23
-	{3406131712, 3406132223},     // 203.5.114.0/23
-	{3406132736, 3406132991},     // 203.5.118.0/24
-	{3406133248, 3406133503},     // 203.5.120.0/24
-	{3406146560, 3406146815},     // 203.5.172.0/24
-	{3406148608, 3406149119},     // 203.5.180.0/23
-	{3406149120, 3406149375},     // 203.5.182.0/24
-	{3406149888, 3406150143},     // 203.5.185.0/24
-	{3406150144, 3406150399},     // 203.5.186.0/24
-	{3406150656, 3406151167},     // 203.5.188.0/23
-	{3406151168, 3406151423},     // 203.5.190.0/24
-	{3406152448, 3406152703},     // 203.5.195.0/24
-	{3406157312, 3406157823},     // 203.5.214.0/23
-	{3406158336, 3406158847},     // 203.5.218.0/23
-	{3406201600, 3406201855},     // 203.6.131.0/24
-	{3406202880, 3406203135},     // 203.6.136.0/24
-	{3406203392, 3406203903},     // 203.6.138.0/23
-	{3406204416, 3406204671},     // 203.6.142.0/24
-	{3406206464, 3406206975},     // 203.6.150.0/23
-	{3406208256, 3406208511},     // 203.6.157.0/24
-	{3406208768, 3406209023},     // 203.6.159.0/24
-	{3406225408, 3406229503},     // 203.6.224.0/20
-	{3406231552, 3406232063},     // 203.6.248.0/23
-	{3406266624, 3406266879},     // 203.7.129.0/24
-	{3406268928, 3406269439},     // 203.7.138.0/23
-	{3406271232, 3406271487},     // 203.7.147.0/24
-	{3406272000, 3406272511},     // 203.7.150.0/23
-	{3406274048, 3406274303},     // 203.7.158.0/24
-	{3406282752, 3406283263},     // 203.7.192.0/23
-	{3406284800, 3406285055},     // 203.7.200.0/24
-	{3406299136, 3406299391},     // 203.8.0.0/24
-	{3406301184, 3406301439},     // 203.8.8.0/24
-	{3406305024, 3406305279},     // 203.8.23.0/24
-	{3406317056, 3406317311},     // 203.8.70.0/24
-	{3406320128, 3406320383},     // 203.8.82.0/24
-	{3406321152, 3406321663},     // 203.8.86.0/23
-	{3406322432, 3406322687},     // 203.8.91.0/24
-	{3406327296, 3406327807},     // 203.8.110.0/23
-	{3406328576, 3406328831},     // 203.8.115.0/24
-	{3406341632, 3406342143},     // 203.8.166.0/23
-	{3406342400, 3406342655},     // 203.8.169.0/24
-	{3406343424, 3406343679},     // 203.8.173.0/24
-	{3406346240, 3406346495},     // 203.8.184.0/24
-	{3406346752, 3406347263},     // 203.8.186.0/23
-	{3406347776, 3406348287},     // 203.8.190.0/23
-	{3406348288, 3406348543},     // 203.8.192.0/24
-	{3406349568, 3406349823},     // 203.8.197.0/24
-	{3406349824, 3406350335},     // 203.8.198.0/23
-	{3406351104, 3406351359},     // 203.8.203.0/24
-	{3406352640, 3406352895},     // 203.8.209.0/24
-	{3406352896, 3406353407},     // 203.8.210.0/23
-	{3406353408, 3406354431},     // 203.8.212.0/22
-	{3406354688, 3406354943},     // 203.8.217.0/24
-	{3406355456, 3406355711},     // 203.8.220.0/24
-	{3406372864, 3406373119},     // 203.9.32.0/24
-	{3406373888, 3406374399},     // 203.9.36.0/23
-	{3406379264, 3406379519},     // 203.9.57.0/24
-	{3406380800, 3406381055},     // 203.9.63.0/24
-	{3406381312, 3406381567},     // 203.9.65.0/24
-	{3406382592, 3406383103},     // 203.9.70.0/23
-	{3406383104, 3406383359},     // 203.9.72.0/24
-	{3406383872, 3406384127},     // 203.9.75.0/24
-	{3406384128, 3406384639},     // 203.9.76.0/23
-	{3406389248, 3406390271},     // 203.9.96.0/22
-	{3406390272, 3406390783},     // 203.9.100.0/23
-	{3406392320, 3406392575},     // 203.9.108.0/24
-	{3406405120, 3406405375},     // 203.9.158.0/24
-	{3406438912, 3406439167},     // 203.10.34.0/24
-	{3406444544, 3406444799},     // 203.10.56.0/24
-	{3406449152, 3406449663},     // 203.10.74.0/23
-	{3406451712, 3406452735},     // 203.10.84.0/22
-	{3406452736, 3406452991},     // 203.10.88.0/24
-	{3406454528, 3406454783},     // 203.10.95.0/24
-	{3406462208, 3406462463},     // 203.10.125.0/24
-	{3406513664, 3406513919},     // 203.11.70.0/24
-	{3406515200, 3406516223},     // 203.11.76.0/22
-	{3406516736, 3406516991},     // 203.11.82.0/24
-	{3406517248, 3406518271},     // 203.11.84.0/22
-	{3406521344, 3406522367},     // 203.11.100.0/22
-	{3406523648, 3406523903},     // 203.11.109.0/24
-	{3406525696, 3406525951},     // 203.11.117.0/24
-	{3406526976, 3406527231},     // 203.11.122.0/24
-	{3406528000, 3406528255},     // 203.11.126.0/24
-	{3406530560, 3406531583},     // 203.11.136.0/22
-	{3406531840, 3406532095},     // 203.11.141.0/24
-	{3406532096, 3406532607},     // 203.11.142.0/23
-	{3406541824, 3406542847},     // 203.11.180.0/22
-	{3406548992, 3406550015},     // 203.11.208.0/22
-	{3406565376, 3406565631},     // 203.12.16.0/24
-	{3406566144, 3406566399},     // 203.12.19.0/24
-	{3406567424, 3406567679},     // 203.12.24.0/24
-	{3406575872, 3406576127},     // 203.12.57.0/24
-	{3406577920, 3406578175},     // 203.12.65.0/24
-	{3406578176, 3406578431},     // 203.12.66.0/24
-	{3406579200, 3406579711},     // 203.12.70.0/23
-	{3406583552, 3406583807},     // 203.12.87.0/24
-	{3406586880, 3406587391},     // 203.12.100.0/23
-	{3406587648, 3406587903},     // 203.12.103.0/24
-	{3406590464, 3406590719},     // 203.12.114.0/24
-	{3406591488, 3406591743},     // 203.12.118.0/24
-	{3406594560, 3406594815},     // 203.12.130.0/24
-	{3406596352, 3406596607},     // 203.12.137.0/24
-	{3406611456, 3406612479},     // 203.12.196.0/22
-	{3406615296, 3406615551},     // 203.12.211.0/24
-	{3406617344, 3406617599},     // 203.12.219.0/24
-	{3406619136, 3406619391},     // 203.12.226.0/24
-	{3406622720, 3406623743},     // 203.12.240.0/22
-	{3406631424, 3406631679},     // 203.13.18.0/24
-	{3406632960, 3406633215},     // 203.13.24.0/24
-	{3406638080, 3406638591},     // 203.13.44.0/23
-	{3406649344, 3406649855},     // 203.13.88.0/23
-	{3406650368, 3406651391},     // 203.13.92.0/22
-	{3406671104, 3406671359},     // 203.13.173.0/24
-	{3406684160, 3406684671},     // 203.13.224.0/23
-	{3406684928, 3406685183},     // 203.13.227.0/24
-	{3406686464, 3406686719},     // 203.13.233.0/24
-	{3406698496, 3406699519},     // 203.14.24.0/22
-	{3406700800, 3406701055},     // 203.14.33.0/24
-	{3406706688, 3406706943},     // 203.14.56.0/24
-	{3406707968, 3406708223},     // 203.14.61.0/24
-	{3406708224, 3406708479},     // 203.14.62.0/24
-	{3406718976, 3406719231},     // 203.14.104.0/24
-	{3406721536, 3406722047},     // 203.14.114.0/23
-	{3406722560, 3406722815},     // 203.14.118.0/24
-	{3406733824, 3406734079},     // 203.14.162.0/24
-	{3406739456, 3406741503},     // 203.14.184.0/21
-	{3406741504, 3406741759},     // 203.14.192.0/24
-	{3406742016, 3406742527},     // 203.14.194.0/23
-	{3406747136, 3406747391},     // 203.14.214.0/24
-	{3406751488, 3406751743},     // 203.14.231.0/24
-	{3406755328, 3406755583},     // 203.14.246.0/24
-	{3406757888, 3406761983},     // 203.15.0.0/20
-	{3406763008, 3406763519},     // 203.15.20.0/23
-	{3406763520, 3406763775},     // 203.15.22.0/24
-	{3406780160, 3406780415},     // 203.15.87.0/24
-	{3406780416, 3406780927},     // 203.15.88.0/23
-	{3406784768, 3406785023},     // 203.15.105.0/24
-	{3406786560, 3406788607},     // 203.15.112.0/21
-	{3406791168, 3406791679},     // 203.15.130.0/23
-	{3406796032, 3406796287},     // 203.15.149.0/24
-	{3406796544, 3406796799},     // 203.15.151.0/24
-	{3406797824, 3406798847},     // 203.15.156.0/22
-	{3406802432, 3406802687},     // 203.15.174.0/24
-	{3406816000, 3406816255},     // 203.15.227.0/24
-	{3406817280, 3406818303},     // 203.15.232.0/22
-	{3406818816, 3406819327},     // 203.15.238.0/23
-	{3406819328, 3406819839},     // 203.15.240.0/23
-	{3406820864, 3406821119},     // 203.15.246.0/24
-	{3406825984, 3406826239},     // 203.16.10.0/24
-	{3406826496, 3406827007},     // 203.16.12.0/23
-	{3406827520, 3406829567},     // 203.16.16.0/21
-	{3406830336, 3406830591},     // 203.16.27.0/24
-	{3406833152, 3406833407},     // 203.16.38.0/24
-	{3406835968, 3406836223},     // 203.16.49.0/24
-	{3406836224, 3406836735},     // 203.16.50.0/23
-	{3406838272, 3406838527},     // 203.16.58.0/24
-	{3406839552, 3406839807},     // 203.16.63.0/24
-	{3406857472, 3406857727},     // 203.16.133.0/24
-	{3406864640, 3406864895},     // 203.16.161.0/24
-	{3406864896, 3406865151},     // 203.16.162.0/24
-	{3406871040, 3406871551},     // 203.16.186.0/23
-	{3406881792, 3406882047},     // 203.16.228.0/24
-	{3406884352, 3406884607},     // 203.16.238.0/24
-	{3406884864, 3406885119},     // 203.16.240.0/24
-	{3406886144, 3406886399},     // 203.16.245.0/24
-	{3406889472, 3406889727},     // 203.17.2.0/24
-	{3406893568, 3406893823},     // 203.17.18.0/24
-	{3406896128, 3406896383},     // 203.17.28.0/24
-	{3406898944, 3406899199},     // 203.17.39.0/24
-	{3406903296, 3406903551},     // 203.17.56.0/24
-	{3406907904, 3406908415},     // 203.17.74.0/23
-	{3406911488, 3406911999},     // 203.17.88.0/23
-	{3406923776, 3406924031},     // 203.17.136.0/24
-	{3406930944, 3406931199},     // 203.17.164.0/24
-	{3406936832, 3406937087},     // 203.17.187.0/24
-	{3406937600, 3406938111},     // 203.17.190.0/23
-	{3406948096, 3406948351},     // 203.17.231.0/24
-	{3406948608, 3406948863},     // 203.17.233.0/24
-	{3406952448, 3406952959},     // 203.17.248.0/23
-	{3406954240, 3406954495},     // 203.17.255.0/24
-	{3406955008, 3406955519},     // 203.18.2.0/23
-	{3406955520, 3406955775},     // 203.18.4.0/24
-	{3406956288, 3406956543},     // 203.18.7.0/24
-	{3406962432, 3406962687},     // 203.18.31.0/24
-	{3406963968, 3406964223},     // 203.18.37.0/24
-	{3406966784, 3406967295},     // 203.18.48.0/23
-	{3406967808, 3406968063},     // 203.18.52.0/24
-	{3406972928, 3406973951},     // 203.18.72.0/22
-	{3406974976, 3406975487},     // 203.18.80.0/23
-	{3406976768, 3406977023},     // 203.18.87.0/24
-	{3406980096, 3406980607},     // 203.18.100.0/23
-	{3406981376, 3406981631},     // 203.18.105.0/24
-	{3406981888, 3406982143},     // 203.18.107.0/24
-	{3406982656, 3406982911},     // 203.18.110.0/24
-	{3406987520, 3406987775},     // 203.18.129.0/24
-	{3406988032, 3406988287},     // 203.18.131.0/24
-	{3406988288, 3406988799},     // 203.18.132.0/23
-	{3406991360, 3406991615},     // 203.18.144.0/24
-	{3406993664, 3406993919},     // 203.18.153.0/24
-	{3407005440, 3407005695},     // 203.18.199.0/24
-	{3407007744, 3407007999},     // 203.18.208.0/24
-	{3407008512, 3407008767},     // 203.18.211.0/24
-	{3407009536, 3407009791},     // 203.18.215.0/24
-	{3407020288, 3407020543},     // 203.19.1.0/24
-	{3407024640, 3407024895},     // 203.19.18.0/24
-	{3407026176, 3407026431},     // 203.19.24.0/24
-	{3407027712, 3407027967},     // 203.19.30.0/24
-	{3407030528, 3407030783},     // 203.19.41.0/24
-	{3407031296, 3407031807},     // 203.19.44.0/23
-	{3407031808, 3407032063},     // 203.19.46.0/24
-	{3407034880, 3407035135},     // 203.19.58.0/24
-	{3407035392, 3407035903},     // 203.19.60.0/23
-	{3407036416, 3407036671},     // 203.19.64.0/24
-	{3407037440, 3407037695},     // 203.19.68.0/24
-	{3407038464, 3407038719},     // 203.19.72.0/24
-	{3407045888, 3407046143},     // 203.19.101.0/24
-	{3407048448, 3407048703},     // 203.19.111.0/24
-	{3407053568, 3407053823},     // 203.19.131.0/24
-	{3407054080, 3407054335},     // 203.19.133.0/24
-	{3407056896, 3407057151},     // 203.19.144.0/24
-	{3407057664, 3407057919},     // 203.19.147.0/24
-	{3407058176, 3407058431},     // 203.19.149.0/24
-	{3407059968, 3407060223},     // 203.19.156.0/24
-	{3407065088, 3407065343},     // 203.19.176.0/24
-	{3407065600, 3407066111},     // 203.19.178.0/23
-	{3407073280, 3407073535},     // 203.19.208.0/24
-	{3407078400, 3407079423},     // 203.19.228.0/22
-	{3407079680, 3407079935},     // 203.19.233.0/24
-	{3407081984, 3407082239},     // 203.19.242.0/24
-	{3407083520, 3407084031},     // 203.19.248.0/23
-	{3407085312, 3407085567},     // 203.19.255.0/24
-	{3407089920, 3407090175},     // 203.20.17.0/24
-	{3407095808, 3407096319},     // 203.20.40.0/23
-	{3407096832, 3407097087},     // 203.20.44.0/24
-	{3407097856, 3407098111},     // 203.20.48.0/24
-	{3407101184, 3407101439},     // 203.20.61.0/24
-	{3407102208, 3407102463},     // 203.20.65.0/24
-	{3407107072, 3407107583},     // 203.20.84.0/23
-	{3407108352, 3407108607},     // 203.20.89.0/24
-	{3407112704, 3407113215},     // 203.20.106.0/23
-	{3407115008, 3407115263},     // 203.20.115.0/24
-	{3407115520, 3407115775},     // 203.20.117.0/24
-	{3407115776, 3407116287},     // 203.20.118.0/23
-	{3407116800, 3407117055},     // 203.20.122.0/24
-	{3407117824, 3407118335},     // 203.20.126.0/23
-	{3407120128, 3407120383},     // 203.20.135.0/24
-	{3407121408, 3407122431},     // 203.20.140.0/22
-	{3407123968, 3407124223},     // 203.20.150.0/24
-	{3407144448, 3407144703},     // 203.20.230.0/24
-	{3407144960, 3407145215},     // 203.20.232.0/24
-	{3407145984, 3407146239},     // 203.20.236.0/24
-	{3407151104, 3407151615},     // 203.21.0.0/23
-	{3407151616, 3407151871},     // 203.21.2.0/24
-	{3407153152, 3407153407},     // 203.21.8.0/24
-	{3407153664, 3407153919},     // 203.21.10.0/24
-	{3407155712, 3407155967},     // 203.21.18.0/24
-	{3407159552, 3407159807},     // 203.21.33.0/24
-	{3407159808, 3407160063},     // 203.21.34.0/24
-	{3407161600, 3407161855},     // 203.21.41.0/24
-	{3407162368, 3407162623},     // 203.21.44.0/24
-	{3407168512, 3407168767},     // 203.21.68.0/24
-	{3407172096, 3407172351},     // 203.21.82.0/24
-	{3407175680, 3407176703},     // 203.21.96.0/22
-	{3407182848, 3407183103},     // 203.21.124.0/24
-	{3407185920, 3407186431},     // 203.21.136.0/23
-	{3407188224, 3407188479},     // 203.21.145.0/24
-	{3407203840, 3407204095},     // 203.21.206.0/24
-	{3407222784, 3407223039},     // 203.22.24.0/24
-	{3407223808, 3407224319},     // 203.22.28.0/23
-	{3407224576, 3407224831},     // 203.22.31.0/24
-	{3407234048, 3407234303},     // 203.22.68.0/24
-	{3407236096, 3407236351},     // 203.22.76.0/24
-	{3407238144, 3407238399},     // 203.22.84.0/24
-	{3407238912, 3407239167},     // 203.22.87.0/24
-	{3407240192, 3407241215},     // 203.22.92.0/22
-	{3407241984, 3407242239},     // 203.22.99.0/24
-	{3407243776, 3407244031},     // 203.22.106.0/24
-	{3407247872, 3407248383},     // 203.22.122.0/23
-	{3407250176, 3407250431},     // 203.22.131.0/24
-	{3407258368, 3407258623},     // 203.22.163.0/24
-	{3407259136, 3407259391},     // 203.22.166.0/24
-	{3407260160, 3407260415},     // 203.22.170.0/24
-	{3407261696, 3407262719},     // 203.22.176.0/22
-	{3407262720, 3407263231},     // 203.22.180.0/23
-	{3407263232, 3407263235},     // 203.22.182.0/30
-	{3407263238, 3407263239},     // 203.22.182.6/31
-	{3407263240, 3407263247},     // 203.22.182.8/29
-	{3407263250, 3407263251},     // 203.22.182.18/31
-	{3407263252, 3407263255},     // 203.22.182.20/30
-	{3407263256, 3407263263},     // 203.22.182.24/29
-	{3407263264, 3407263295},     // 203.22.182.32/27
-	{3407263296, 3407263359},     // 203.22.182.64/26
-	{3407263360, 3407263487},     // 203.22.182.128/25
-	{3407263488, 3407263743},     // 203.22.183.0/24
-	{3407266304, 3407266559},     // 203.22.194.0/24
-	{3407278592, 3407279103},     // 203.22.242.0/23
-	{3407279360, 3407279615},     // 203.22.245.0/24
-	{3407279616, 3407279871},     // 203.22.246.0/24
-	{3407281152, 3407281663},     // 203.22.252.0/23
-	{3407282176, 3407282431},     // 203.23.0.0/24
-	{3407294208, 3407294463},     // 203.23.47.0/24
-	{3407297792, 3407298047},     // 203.23.61.0/24
-	{3407298048, 3407298559},     // 203.23.62.0/23
-	{3407300864, 3407301119},     // 203.23.73.0/24
-	{3407303936, 3407304191},     // 203.23.85.0/24
-	{3407305728, 3407306751},     // 203.23.92.0/22
-	{3407307264, 3407307519},     // 203.23.98.0/24
-	{3407309568, 3407309823},     // 203.23.107.0/24
-	{3407310848, 3407311103},     // 203.23.112.0/24
-	{3407315456, 3407315711},     // 203.23.130.0/24
-	{3407318016, 3407318527},     // 203.23.140.0/23
-	{3407326208, 3407326463},     // 203.23.172.0/24
-	{3407328768, 3407329023},     // 203.23.182.0/24
-	{3407329792, 3407330303},     // 203.23.186.0/23
-	{3407331328, 3407331583},     // 203.23.192.0/24
-	{3407332608, 3407332863},     // 203.23.197.0/24
-	{3407332864, 3407333119},     // 203.23.198.0/24
-	{3407334400, 3407335423},     // 203.23.204.0/22
-	{3407339520, 3407339775},     // 203.23.224.0/24
-	{3407340032, 3407340543},     // 203.23.226.0/23
-	{3407340544, 3407341567},     // 203.23.228.0/22
-	{3407345920, 3407346175},     // 203.23.249.0/24
-	{3407346432, 3407346687},     // 203.23.251.0/24
-	{3407351040, 3407351295},     // 203.24.13.0/24
-	{3407352320, 3407352575},     // 203.24.18.0/24
-	{3407354624, 3407354879},     // 203.24.27.0/24
-	{3407358720, 3407358975},     // 203.24.43.0/24
-	{3407362048, 3407362303},     // 203.24.56.0/24
-	{3407362560, 3407362815},     // 203.24.58.0/24
-	{3407364864, 3407365119},     // 203.24.67.0/24
-	{3407366656, 3407366911},     // 203.24.74.0/24
-	{3407367936, 3407368191},     // 203.24.79.0/24
-	{3407368192, 3407368703},     // 203.24.80.0/23
-	{3407369216, 3407369727},     // 203.24.84.0/23
-	{3407369728, 3407369983},     // 203.24.86.0/24
-	{3407370752, 3407371007},     // 203.24.90.0/24
-	{3407376128, 3407376383},     // 203.24.111.0/24
-	{3407376384, 3407376639},     // 203.24.112.0/24
-	{3407377408, 3407377663},     // 203.24.116.0/24
-	{3407378944, 3407379455},     // 203.24.122.0/23
-	{3407384832, 3407385087},     // 203.24.145.0/24
-	{3407386624, 3407387135},     // 203.24.152.0/23
-	{3407387904, 3407388159},     // 203.24.157.0/24
-	{3407388928, 3407389183},     // 203.24.161.0/24
-	{3407390464, 3407390719},     // 203.24.167.0/24
-	{3407395328, 3407395839},     // 203.24.186.0/23
-	{3407398656, 3407398911},     // 203.24.199.0/24
-	{3407399424, 3407399679},     // 203.24.202.0/24
-	{3407401984, 3407402495},     // 203.24.212.0/23
-	{3407403264, 3407403519},     // 203.24.217.0/24
-	{3407403776, 3407404031},     // 203.24.219.0/24
-	{3407410176, 3407410431},     // 203.24.244.0/24
-	{3407418112, 3407418367},     // 203.25.19.0/24
-	{3407418368, 3407418879},     // 203.25.20.0/23
-	{3407425024, 3407425279},     // 203.25.46.0/24
-	{3407429632, 3407430143},     // 203.25.64.0/23
-	{3407436544, 3407436799},     // 203.25.91.0/24
-	{3407438592, 3407438847},     // 203.25.99.0/24
-	{3407438848, 3407439103},     // 203.25.100.0/24
-	{3407440384, 3407440639},     // 203.25.106.0/24
-	{3407446784, 3407447039},     // 203.25.131.0/24
-	{3407447808, 3407448063},     // 203.25.135.0/24
-	{3407448576, 3407448831},     // 203.25.138.0/24
-	{3407450880, 3407451135},     // 203.25.147.0/24
-	{3407452416, 3407452671},     // 203.25.153.0/24
-	{3407452672, 3407453183},     // 203.25.154.0/23
-	{3407455232, 3407455487},     // 203.25.164.0/24
-	{3407455744, 3407455999},     // 203.25.166.0/24
-	{3407457792, 3407458303},     // 203.25.174.0/23
-	{3407459328, 3407459583},     // 203.25.180.0/24
-	{3407459840, 3407460095},     // 203.25.182.0/24
-	{3407462144, 3407462399},     // 203.25.191.0/24
-	{3407464192, 3407464447},     // 203.25.199.0/24
-	{3407464448, 3407464703},     // 203.25.200.0/24
-	{3407464960, 3407465471},     // 203.25.202.0/23
-	{3407466496, 3407470591},     // 203.25.208.0/20
-	{3407471872, 3407472127},     // 203.25.229.0/24
-	{3407473408, 3407473663},     // 203.25.235.0/24
-	{3407473664, 3407473919},     // 203.25.236.0/24
-	{3407475200, 3407475455},     // 203.25.242.0/24
-	{3407481856, 3407482111},     // 203.26.12.0/24
-	{3407487488, 3407487743},     // 203.26.34.0/24
-	{3407491328, 3407491583},     // 203.26.49.0/24
-	{3407491584, 3407491839},     // 203.26.50.0/24
-	{3407492864, 3407493119},     // 203.26.55.0/24
-	{3407493120, 3407493631},     // 203.26.56.0/23
-	{3407494144, 3407494399},     // 203.26.60.0/24
-	{3407495424, 3407495679},     // 203.26.65.0/24
-	{3407496192, 3407496447},     // 203.26.68.0/24
-	{3407498240, 3407498495},     // 203.26.76.0/24
-	{3407499264, 3407499519},     // 203.26.80.0/24
-	{3407500288, 3407500543},     // 203.26.84.0/24
-	{3407503616, 3407503871},     // 203.26.97.0/24
-	{3407504896, 3407505407},     // 203.26.102.0/23
-	{3407508224, 3407508479},     // 203.26.115.0/24
-	{3407508480, 3407508735},     // 203.26.116.0/24
-	{3407511808, 3407512063},     // 203.26.129.0/24
-	{3407515392, 3407515647},     // 203.26.143.0/24
-	{3407515648, 3407515903},     // 203.26.144.0/24
-	{3407516672, 3407517183},     // 203.26.148.0/23
-	{3407518208, 3407518463},     // 203.26.154.0/24
-	{3407519232, 3407519743},     // 203.26.158.0/23
-	{3407522304, 3407522559},     // 203.26.170.0/24
-	{3407523072, 3407523327},     // 203.26.173.0/24
-	{3407523840, 3407524095},     // 203.26.176.0/24
-	{3407526144, 3407526399},     // 203.26.185.0/24
-	{3407530496, 3407531007},     // 203.26.202.0/23
-	{3407532544, 3407532799},     // 203.26.210.0/24
-	{3407533568, 3407533823},     // 203.26.214.0/24
-	{3407535616, 3407535871},     // 203.26.222.0/24
-	{3407536128, 3407536383},     // 203.26.224.0/24
-	{3407537152, 3407537407},     // 203.26.228.0/24
-	{3407538176, 3407538431},     // 203.26.232.0/24
-	{3407544320, 3407544575},     // 203.27.0.0/24
-	{3407546880, 3407547135},     // 203.27.10.0/24
-	{3407548160, 3407548415},     // 203.27.15.0/24
-	{3407548416, 3407548671},     // 203.27.16.0/24
-	{3407549440, 3407549695},     // 203.27.20.0/24
-	{3407549952, 3407550463},     // 203.27.22.0/23
-	{3407554560, 3407554815},     // 203.27.40.0/24
-	{3407555840, 3407556095},     // 203.27.45.0/24
-	{3407557888, 3407558143},     // 203.27.53.0/24
-	{3407560960, 3407561215},     // 203.27.65.0/24
-	{3407561216, 3407561471},     // 203.27.66.0/24
-	{3407565056, 3407565311},     // 203.27.81.0/24
-	{3407566848, 3407567103},     // 203.27.88.0/24
-	{3407570432, 3407570687},     // 203.27.102.0/24
-	{3407572224, 3407572479},     // 203.27.109.0/24
-	{3407574272, 3407574527},     // 203.27.117.0/24
-	{3407575296, 3407575551},     // 203.27.121.0/24
-	{3407575552, 3407576063},     // 203.27.122.0/23
-	{3407576320, 3407576575},     // 203.27.125.0/24
-	{3407595520, 3407595775},     // 203.27.200.0/24
-	{3407596032, 3407596287},     // 203.27.202.0/24
-	{3407603968, 3407604223},     // 203.27.233.0/24
-	{3407606016, 3407606271},     // 203.27.241.0/24
-	{3407608320, 3407608575},     // 203.27.250.0/24
-	{3407612416, 3407612671},     // 203.28.10.0/24
-	{3407612928, 3407613183},     // 203.28.12.0/24
-	{3407618304, 3407618559},     // 203.28.33.0/24
-	{3407618560, 3407619071},     // 203.28.34.0/23
-	{3407620864, 3407621119},     // 203.28.43.0/24
-	{3407621120, 3407621375},     // 203.28.44.0/24
-	{3407623680, 3407623935},     // 203.28.54.0/24
-	{3407624192, 3407624447},     // 203.28.56.0/24
-	{3407628544, 3407628799},     // 203.28.73.0/24
-	{3407628800, 3407629055},     // 203.28.74.0/24
-	{3407629312, 3407629567},     // 203.28.76.0/24
-	{3407631872, 3407632127},     // 203.28.86.0/24
-	{3407632384, 3407632639},     // 203.28.88.0/24
-	{3407638528, 3407638783},     // 203.28.112.0/24
-	{3407643392, 3407643647},     // 203.28.131.0/24
-	{3407644672, 3407644927},     // 203.28.136.0/24
-	{3407645696, 3407645951},     // 203.28.140.0/24
-	{3407646976, 3407647231},     // 203.28.145.0/24
-	{3407652096, 3407652351},     // 203.28.165.0/24
-	{3407653120, 3407653375},     // 203.28.169.0/24
-	{3407653376, 3407653631},     // 203.28.170.0/24
-	{3407655424, 3407655935},     // 203.28.178.0/23
-	{3407657216, 3407657471},     // 203.28.185.0/24
-	{3407657728, 3407657983},     // 203.28.187.0/24
-	{3407660032, 3407660287},     // 203.28.196.0/24
-	{3407667712, 3407668223},     // 203.28.226.0/23
-	{3407671040, 3407671295},     // 203.28.239.0/24
-	{3407675904, 3407676159},     // 203.29.2.0/24
-	{3407677440, 3407677951},     // 203.29.8.0/23
-	{3407678720, 3407678975},     // 203.29.13.0/24
-	{3407678976, 3407679231},     // 203.29.14.0/24
-	{3407682560, 3407682815},     // 203.29.28.0/24
-	{3407687168, 3407687423},     // 203.29.46.0/24
-	{3407689984, 3407690239},     // 203.29.57.0/24
-	{3407691008, 3407691263},     // 203.29.61.0/24
-	{3407691520, 3407691775},     // 203.29.63.0/24
-	{3407693056, 3407693311},     // 203.29.69.0/24
-	{3407694080, 3407694335},     // 203.29.73.0/24
-	{3407696128, 3407696383},     // 203.29.81.0/24
-	{3407698432, 3407698687},     // 203.29.90.0/24
-	{3407699712, 3407699967},     // 203.29.95.0/24
-	{3407700992, 3407701247},     // 203.29.100.0/24
-	{3407701760, 3407702015},     // 203.29.103.0/24
-	{3407704064, 3407704319},     // 203.29.112.0/24
-	{3407706112, 3407707135},     // 203.29.120.0/22
-	{3407721984, 3407722495},     // 203.29.182.0/23
-	{3407723264, 3407723519},     // 203.29.187.0/24
-	{3407723776, 3407724031},     // 203.29.189.0/24
-	{3407724032, 3407724287},     // 203.29.190.0/24
-	{3407727872, 3407728127},     // 203.29.205.0/24
-	{3407729152, 3407729407},     // 203.29.210.0/24
-	{3407730944, 3407731199},     // 203.29.217.0/24
-	{3407733504, 3407733759},     // 203.29.227.0/24
-	{3407734528, 3407734783},     // 203.29.231.0/24
-	{3407735040, 3407735295},     // 203.29.233.0/24
-	{3407735296, 3407735551},     // 203.29.234.0/24
-	{3407738880, 3407739135},     // 203.29.248.0/24
-	{3407740416, 3407740927},     // 203.29.254.0/23
-	{3407745024, 3407745535},     // 203.30.16.0/23
-	{3407747328, 3407747583},     // 203.30.25.0/24
-	{3407748352, 3407748607},     // 203.30.29.0/24
-	{3407757824, 3407758079},     // 203.30.66.0/24
-	{3407761664, 3407761919},     // 203.30.81.0/24
-	{3407763200, 3407763455},     // 203.30.87.0/24
-	{3407769344, 3407769599},     // 203.30.111.0/24
-	{3407771904, 3407772159},     // 203.30.121.0/24
-	{3407772416, 3407772671},     // 203.30.123.0/24
-	{3407779840, 3407780095},     // 203.30.152.0/24
-	{3407780864, 3407781119},     // 203.30.156.0/24
-	{3407782400, 3407782655},     // 203.30.162.0/24
-	{3407785216, 3407785471},     // 203.30.173.0/24
-	{3407785728, 3407785983},     // 203.30.175.0/24
-	{3407788800, 3407789055},     // 203.30.187.0/24
-	{3407790592, 3407790847},     // 203.30.194.0/24
-	{3407796480, 3407796735},     // 203.30.217.0/24
-	{3407797248, 3407797503},     // 203.30.220.0/24
-	{3407797760, 3407798015},     // 203.30.222.0/24
-	{3407800320, 3407800831},     // 203.30.232.0/23
-	{3407801088, 3407801343},     // 203.30.235.0/24
-	{3407802368, 3407802879},     // 203.30.240.0/23
-	{3407803904, 3407804159},     // 203.30.246.0/24
-	{3407804928, 3407805439},     // 203.30.250.0/23
-	{3407817984, 3407818239},     // 203.31.45.0/24
-	{3407818240, 3407818495},     // 203.31.46.0/24
-	{3407819008, 3407819263},     // 203.31.49.0/24
-	{3407819520, 3407819775},     // 203.31.51.0/24
-	{3407820288, 3407820799},     // 203.31.54.0/23
-	{3407824128, 3407824383},     // 203.31.69.0/24
-	{3407824896, 3407825151},     // 203.31.72.0/24
-	{3407826944, 3407827199},     // 203.31.80.0/24
-	{3407828224, 3407828479},     // 203.31.85.0/24
-	{3407831296, 3407831551},     // 203.31.97.0/24
-	{3407833344, 3407833599},     // 203.31.105.0/24
-	{3407833600, 3407833855},     // 203.31.106.0/24
-	{3407834112, 3407834623},     // 203.31.108.0/23
-	{3407838208, 3407838463},     // 203.31.124.0/24
-	{3407847936, 3407848191},     // 203.31.162.0/24
-	{3407851008, 3407851263},     // 203.31.174.0/24
-	{3407851776, 3407852031},     // 203.31.177.0/24
-	{3407852800, 3407853055},     // 203.31.181.0/24
-	{3407854336, 3407854591},     // 203.31.187.0/24
-	{3407854848, 3407855103},     // 203.31.189.0/24
-	{3407858688, 3407858943},     // 203.31.204.0/24
-	{3407862784, 3407863039},     // 203.31.220.0/24
-	{3407863296, 3407863807},     // 203.31.222.0/23
-	{3407864064, 3407864319},     // 203.31.225.0/24
-	{3407865088, 3407865343},     // 203.31.229.0/24
-	{3407869952, 3407870463},     // 203.31.248.0/23
-	{3407871232, 3407871487},     // 203.31.253.0/24
-	{3407877120, 3407877375},     // 203.32.20.0/24
-	{3407884288, 3407884799},     // 203.32.48.0/23
-	{3407886336, 3407886591},     // 203.32.56.0/24
-	{3407887360, 3407887615},     // 203.32.60.0/24
-	{3407887872, 3407888127},     // 203.32.62.0/24
-	{3407889408, 3407889919},     // 203.32.68.0/23
-	{3407891456, 3407891711},     // 203.32.76.0/24
-	{3407892736, 3407892991},     // 203.32.81.0/24
-	{3407893504, 3407894015},     // 203.32.84.0/23
-	{3407896320, 3407896575},     // 203.32.95.0/24
-	{3407898112, 3407898367},     // 203.32.102.0/24
-	{3407898880, 3407899135},     // 203.32.105.0/24
-	{3407905280, 3407905535},     // 203.32.130.0/24
-	{3407906048, 3407906303},     // 203.32.133.0/24
-	{3407907840, 3407908095},     // 203.32.140.0/24
-	{3407910912, 3407911167},     // 203.32.152.0/24
-	{3407919616, 3407920127},     // 203.32.186.0/23
-	{3407921152, 3407921407},     // 203.32.192.0/24
-	{3407922176, 3407922431},     // 203.32.196.0/24
-	{3407923968, 3407924223},     // 203.32.203.0/24
-	{3407924224, 3407924735},     // 203.32.204.0/23
-	{3407926272, 3407926527},     // 203.32.212.0/24
-	{3407938560, 3407938815},     // 203.33.4.0/24
-	{3407939328, 3407939583},     // 203.33.7.0/24
-	{3407940608, 3407941119},     // 203.33.12.0/23
-	{3407942912, 3407943167},     // 203.33.21.0/24
-	{3407944192, 3407944447},     // 203.33.26.0/24
-	{3407945728, 3407945983},     // 203.33.32.0/24
-	{3407953664, 3407953919},     // 203.33.63.0/24
-	{3407953920, 3407954175},     // 203.33.64.0/24
-	{3407954688, 3407954943},     // 203.33.67.0/24
-	{3407954944, 3407955199},     // 203.33.68.0/24
-	{3407956224, 3407956479},     // 203.33.73.0/24
-	{3407957760, 3407958015},     // 203.33.79.0/24
-	{3407963136, 3407963391},     // 203.33.100.0/24
-	{3407968768, 3407969023},     // 203.33.122.0/24
-	{3407970560, 3407970815},     // 203.33.129.0/24
-	{3407971072, 3407971327},     // 203.33.131.0/24
-	{3407974656, 3407974911},     // 203.33.145.0/24
-	{3407977472, 3407977727},     // 203.33.156.0/24
-	{3407977984, 3407978495},     // 203.33.158.0/23
-	{3407982080, 3407982335},     // 203.33.174.0/24
-	{3407984896, 3407985151},     // 203.33.185.0/24
-	{3407988736, 3407988991},     // 203.33.200.0/24
-	{3407989248, 3407989759},     // 203.33.202.0/23
-	{3407989760, 3407990015},     // 203.33.204.0/24
-	{3407990272, 3407990783},     // 203.33.206.0/23
-	{3407992320, 3407992831},     // 203.33.214.0/23
-	{3407994880, 3407995391},     // 203.33.224.0/23
-	{3407995392, 3407995647},     // 203.33.226.0/24
-	{3407997184, 3407997439},     // 203.33.233.0/24
-	{3407999744, 3407999999},     // 203.33.243.0/24
-	{3408001536, 3408001791},     // 203.33.250.0/24
-	{3408004096, 3408004351},     // 203.34.4.0/24
-	{3408008448, 3408008703},     // 203.34.21.0/24
-	{3408009984, 3408010239},     // 203.34.27.0/24
-	{3408013056, 3408013311},     // 203.34.39.0/24
-	{3408015360, 3408015871},     // 203.34.48.0/23
-	{3408016896, 3408017151},     // 203.34.54.0/24
-	{3408017408, 3408017919},     // 203.34.56.0/23
-	{3408020224, 3408020479},     // 203.34.67.0/24
-	{3408020736, 3408020991},     // 203.34.69.0/24
-	{3408022528, 3408022783},     // 203.34.76.0/24
-	{3408026624, 3408026879},     // 203.34.92.0/24
-	{3408030208, 3408030463},     // 203.34.106.0/24
-	{3408032000, 3408032255},     // 203.34.113.0/24
-	{3408040704, 3408040959},     // 203.34.147.0/24
-	{3408041472, 3408041727},     // 203.34.150.0/24
-	{3408041984, 3408042495},     // 203.34.152.0/23
-	{3408044288, 3408044543},     // 203.34.161.0/24
-	{3408044544, 3408044799},     // 203.34.162.0/24
-	{3408050944, 3408051199},     // 203.34.187.0/24
-	{3408052224, 3408053247},     // 203.34.192.0/22
-	{3408053504, 3408053759},     // 203.34.197.0/24
-	{3408053760, 3408054015},     // 203.34.198.0/24
-	{3408055296, 3408056319},     // 203.34.204.0/22
-	{3408062464, 3408062719},     // 203.34.232.0/24
-	{3408064512, 3408064767},     // 203.34.240.0/24
-	{3408065024, 3408065279},     // 203.34.242.0/24
-	{3408065792, 3408066047},     // 203.34.245.0/24
-	{3408067328, 3408067583},     // 203.34.251.0/24
-	{3409379840, 3409380351},     // 203.55.2.0/23
-	{3409380352, 3409380607},     // 203.55.4.0/24
-	{3409381888, 3409382143},     // 203.55.10.0/24
-	{3409382656, 3409382911},     // 203.55.13.0/24
-	{3409384960, 3409385215},     // 203.55.22.0/24
-	{3409387008, 3409387263},     // 203.55.30.0/24
-	{3409403136, 3409403391},     // 203.55.93.0/24
-	{3409405184, 3409405439},     // 203.55.101.0/24
-	{3409407232, 3409407487},     // 203.55.109.0/24
-	{3409407488, 3409407743},     // 203.55.110.0/24
-	{3409409024, 3409409535},     // 203.55.116.0/23
-	{3409409792, 3409410047},     // 203.55.119.0/24
-	{3409412096, 3409412607},     // 203.55.128.0/23
-	{3409416704, 3409417215},     // 203.55.146.0/23
-	{3409428480, 3409428735},     // 203.55.192.0/24
-	{3409429504, 3409429759},     // 203.55.196.0/24
-	{3409435136, 3409435647},     // 203.55.218.0/23
-	{3409435904, 3409436159},     // 203.55.221.0/24
-	{3409436672, 3409436927},     // 203.55.224.0/24
-	{3409445120, 3409445375},     // 203.56.1.0/24
-	{3409445888, 3409446143},     // 203.56.4.0/24
-	{3409447936, 3409448191},     // 203.56.12.0/24
-	{3409451008, 3409451263},     // 203.56.24.0/24
-	{3409454592, 3409454847},     // 203.56.38.0/24
-	{3409455104, 3409455359},     // 203.56.40.0/24
-	{3409456640, 3409456895},     // 203.56.46.0/24
-	{3409457664, 3409458175},     // 203.56.50.0/23
-	{3409458176, 3409459199},     // 203.56.52.0/22
-	{3409462272, 3409462783},     // 203.56.68.0/23
-	{3409465856, 3409466367},     // 203.56.82.0/23
-	{3409466368, 3409466879},     // 203.56.84.0/23
-	{3409469184, 3409469439},     // 203.56.95.0/24
-	{3409473024, 3409473279},     // 203.56.110.0/24
-	{3409475840, 3409476095},     // 203.56.121.0/24
-	{3409486080, 3409486335},     // 203.56.161.0/24
-	{3409488128, 3409488383},     // 203.56.169.0/24
-	{3409488896, 3409489407},     // 203.56.172.0/23
-	{3409489664, 3409489919},     // 203.56.175.0/24
-	{3409491712, 3409491967},     // 203.56.183.0/24
-	{3409492224, 3409492479},     // 203.56.185.0/24
-	{3409492736, 3409492991},     // 203.56.187.0/24
-	{3409494016, 3409494271},     // 203.56.192.0/24
-	{3409495552, 3409495807},     // 203.56.198.0/24
-	{3409496320, 3409496575},     // 203.56.201.0/24
-	{3409498112, 3409498623},     // 203.56.208.0/23
-	{3409498624, 3409498879},     // 203.56.210.0/24
-	{3409499648, 3409499903},     // 203.56.214.0/24
-	{3409500160, 3409500415},     // 203.56.216.0/24
-	{3409502976, 3409503231},     // 203.56.227.0/24
-	{3409503232, 3409503487},     // 203.56.228.0/24
-	{3409504256, 3409504511},     // 203.56.232.0/24
-	{3409506304, 3409506559},     // 203.56.240.0/24
-	{3409509376, 3409509631},     // 203.56.252.0/24
-	{3409509888, 3409510143},     // 203.56.254.0/24
-	{3409511680, 3409511935},     // 203.57.5.0/24
-	{3409511936, 3409512191},     // 203.57.6.0/24
-	{3409513472, 3409513983},     // 203.57.12.0/23
-	{3409517568, 3409517823},     // 203.57.28.0/24
-	{3409520384, 3409520639},     // 203.57.39.0/24
-	{3409522176, 3409522431},     // 203.57.46.0/24
-	{3409525248, 3409525503},     // 203.57.58.0/24
-	{3409526016, 3409526271},     // 203.57.61.0/24
-	{3409527296, 3409527551},     // 203.57.66.0/24
-	{3409528064, 3409528319},     // 203.57.69.0/24
-	{3409528320, 3409528831},     // 203.57.70.0/23
-	{3409529088, 3409529343},     // 203.57.73.0/24
-	{3409533440, 3409533695},     // 203.57.90.0/24
-	{3409536256, 3409536511},     // 203.57.101.0/24
-	{3409538304, 3409538559},     // 203.57.109.0/24
-	{3409541888, 3409542143},     // 203.57.123.0/24
-	{3409550592, 3409550847},     // 203.57.157.0/24
-	{3409561600, 3409561855},     // 203.57.200.0/24
-	{3409562112, 3409562367},     // 203.57.202.0/24
-	{3409563136, 3409563391},     // 203.57.206.0/24
-	{3409567232, 3409567487},     // 203.57.222.0/24
-	{3409567744, 3409571839},     // 203.57.224.0/20
-	{3409573376, 3409573887},     // 203.57.246.0/23
-	{3409574144, 3409574399},     // 203.57.249.0/24
-	{3409575168, 3409575423},     // 203.57.253.0/24
-	{3409575424, 3409575935},     // 203.57.254.0/23
-	{3409838592, 3409838847},     // 203.62.2.0/24
-	{3409871616, 3409871871},     // 203.62.131.0/24
-	{3409873664, 3409873919},     // 203.62.139.0/24
-	{3409879296, 3409879551},     // 203.62.161.0/24
-	{3409888512, 3409888767},     // 203.62.197.0/24
-	{3409896448, 3409897471},     // 203.62.228.0/22
-	{3409897984, 3409898239},     // 203.62.234.0/24
-	{3409901056, 3409901311},     // 203.62.246.0/24
-	{3410096128, 3410097151},     // 203.65.240.0/22
-	{3410796544, 3410797567},     // 203.76.160.0/22
-	{3410798592, 3410799615},     // 203.76.168.0/22
-	{3410808832, 3410810879},     // 203.76.208.0/21
-	{3410810880, 3410811903},     // 203.76.216.0/22
-	{3410817024, 3410818047},     // 203.76.240.0/22
-	{3410867200, 3410868223},     // 203.77.180.0/22
-	{3410898944, 3410903039},     // 203.78.48.0/20
-	{3410926592, 3410927615},     // 203.78.156.0/22
-	{3410952192, 3410956287},     // 203.79.0.0/20
-	{3411018752, 3411019263},     // 203.80.4.0/23
-	{3411025920, 3411030015},     // 203.80.32.0/20
-	{3411032320, 3411032575},     // 203.80.57.0/24
-	{3411050752, 3411051007},     // 203.80.129.0/24
-	{3411051520, 3411052543},     // 203.80.132.0/22
-	{3411053568, 3411054591},     // 203.80.140.0/22
-	{3411054592, 3411058687},     // 203.80.144.0/20
-	{3411083264, 3411085311},     // 203.81.0.0/21
-	{3411087360, 3411091455},     // 203.81.16.0/20
-	{3411145728, 3411146751},     // 203.81.244.0/22
-	{3411148800, 3411149311},     // 203.82.0.0/23
-	{3411152896, 3411154943},     // 203.82.16.0/21
-	{3411177472, 3411181567},     // 203.82.112.0/20
-	{3411206144, 3411210239},     // 203.82.224.0/20
-	{3411214336, 3411215359},     // 203.83.0.0/22
-	{3411217408, 3411218431},     // 203.83.12.0/22
-	{3411228672, 3411230719},     // 203.83.56.0/21
-	{3411271680, 3411275775},     // 203.83.224.0/20
-	{3411410944, 3411443711},     // 203.86.0.0/17
-	{3411474944, 3411475199},     // 203.86.250.0/24
-	{3411475968, 3411476479},     // 203.86.254.0/23
-	{3411550208, 3411558399},     // 203.88.32.0/19
-	{3411567616, 3411568639},     // 203.88.100.0/22
-	{3411591168, 3411599359},     // 203.88.192.0/19
-	{3411607552, 3411608575},     // 203.89.0.0/22
-	{3411642368, 3411643391},     // 203.89.136.0/22
-	{3411644416, 3411644671},     // 203.89.144.0/24
-	{3411673088, 3411674111},     // 203.90.0.0/22
-	{3411675136, 3411677183},     // 203.90.8.0/21
-	{3411705856, 3411722239},     // 203.90.128.0/18
-	{3411722240, 3411730431},     // 203.90.192.0/19
-	{3411746816, 3411755007},     // 203.91.32.0/19
-	{3411763200, 3411767295},     // 203.91.96.0/20
-	{3411769344, 3411771391},     // 203.91.120.0/21
-	{3411804160, 3411805183},     // 203.92.0.0/22
-	{3411805696, 3411805951},     // 203.92.6.0/24
-	{3411845120, 3411853311},     // 203.92.160.0/19
-	{3411869696, 3411935231},     // 203.93.0.0/16
-	{3411935232, 3411943423},     // 203.94.0.0/19
-	{3412000768, 3412002815},     // 203.95.0.0/21
-	{3412025344, 3412033535},     // 203.95.96.0/19
-	{3412033536, 3412049919},     // 203.95.128.0/18
-	{3412051968, 3412054015},     // 203.95.200.0/21
-	{3412054016, 3412055039},     // 203.95.208.0/22
-	{3412058112, 3412066303},     // 203.95.224.0/19
-	{3412264960, 3412267007},     // 203.99.8.0/21
-	{3412267008, 3412268031},     // 203.99.16.0/22
-	{3412270592, 3412271103},     // 203.99.30.0/23
-	{3412283392, 3412287487},     // 203.99.80.0/20
-	{3412336640, 3412340735},     // 203.100.32.0/20
-	{3412343296, 3412343551},     // 203.100.58.0/24
-	{3412343808, 3412344063},     // 203.100.60.0/24
-	{3412344576, 3412344831},     // 203.100.63.0/24
-	{3412348928, 3412353023},     // 203.100.80.0/20
-	{3412353024, 3412361215},     // 203.100.96.0/19
-	{3412377600, 3412381695},     // 203.100.192.0/20
-	{3412598784, 3412602879},     // 203.104.32.0/20
-	{3412680704, 3412688895},     // 203.105.96.0/19
-	{3412688896, 3412697087},     // 203.105.128.0/19
-	{3412787200, 3412795391},     // 203.107.0.0/19
-	{3412795392, 3412799487},     // 203.107.32.0/20
-	{3412800512, 3412801535},     // 203.107.52.0/22
-	{3412801536, 3412803583},     // 203.107.56.0/21
-	{3412804864, 3412805119},     // 203.107.69.0/24
-	{3412805120, 3412805631},     // 203.107.70.0/23
-	{3412805632, 3412807679},     // 203.107.72.0/21
-	{3412807680, 3412811775},     // 203.107.80.0/20
-	{3412811776, 3412819967},     // 203.107.96.0/19
-	{3413024768, 3413032959},     // 203.110.160.0/19
-	{3413037056, 3413041151},     // 203.110.208.0/20
-	{3413043200, 3413043711},     // 203.110.232.0/23
-	{3413043712, 3413043967},     // 203.110.234.0/24
-	{3413266432, 3413270527},     // 203.114.80.0/20
-	{3413308416, 3413309439},     // 203.114.244.0/22
-	{3413557248, 3413565439},     // 203.118.192.0/19
-	{3413569792, 3413570047},     // 203.118.241.0/24
-	{3413571584, 3413572607},     // 203.118.248.0/22
-	{3413579776, 3413580799},     // 203.119.24.0/22
-	{3413580800, 3413581311},     // 203.119.28.0/23
-	{3413581312, 3413581567},     // 203.119.30.0/24
-	{3413581824, 3413582079},     // 203.119.32.0/24
-	{3413582336, 3413582847},     // 203.119.34.0/23
-	{3413594112, 3413595135},     // 203.119.80.0/22
-	{3413595392, 3413595647},     // 203.119.85.0/24
-	{3413602560, 3413602815},     // 203.119.113.0/24
-	{3413602816, 3413603327},     // 203.119.114.0/23
-	{3413603328, 3413604351},     // 203.119.116.0/22
-	{3413606400, 3413639167},     // 203.119.128.0/17
-	{3413850624, 3413850879},     // 203.123.58.0/24
-	{3414171648, 3414179839},     // 203.128.32.0/19
-	{3414188032, 3414196223},     // 203.128.96.0/19
-	{3414196224, 3414196479},     // 203.128.128.0/24
-	{3414221568, 3414221823},     // 203.128.227.0/24
-	{3414221824, 3414222847},     // 203.128.228.0/22
-	{3414302720, 3414306815},     // 203.130.32.0/20
-	{3414307072, 3414307327},     // 203.130.49.0/24
-	{3414307584, 3414307839},     // 203.130.51.0/24
-	{3414308096, 3414308351},     // 203.130.53.0/24
-	{3414308352, 3414308863},     // 203.130.54.0/23
-	{3414308864, 3414309887},     // 203.130.56.0/22
-	{3414309888, 3414310399},     // 203.130.60.0/23
-	{3414433792, 3414441983},     // 203.132.32.0/19
-	{3414618112, 3414619135},     // 203.134.240.0/22
-	{3414619648, 3414620159},     // 203.134.246.0/23
-	{3414646784, 3414654975},     // 203.135.96.0/19
-	{3414663168, 3414667263},     // 203.135.160.0/20
-	{3415084032, 3415084543},     // 203.142.12.0/23
-	{3415137024, 3415137279},     // 203.142.219.0/24
-	{3415138304, 3415146495},     // 203.142.224.0/19
-	{3415236608, 3415244799},     // 203.144.96.0/19
-	{3415277568, 3415285759},     // 203.145.0.0/19
-	{3415474176, 3415490559},     // 203.148.0.0/18
-	{3415490560, 3415494655},     // 203.148.64.0/20
-	{3415494656, 3415495679},     // 203.148.80.0/22
-	{3415496192, 3415496703},     // 203.148.86.0/23
-	{3415563264, 3415564287},     // 203.149.92.0/22
-	{3415752704, 3415760895},     // 203.152.64.0/19
-	{3415769088, 3415777279},     // 203.152.128.0/19
-	{3415801856, 3415802879},     // 203.153.0.0/22
-	{3416047616, 3416063999},     // 203.156.192.0/18
-	{3416133632, 3416135679},     // 203.158.16.0/21
-	{3416293632, 3416293887},     // 203.160.129.0/24
-	{3416309760, 3416317951},     // 203.160.192.0/19
-	{3416326144, 3416327167},     // 203.161.0.0/22
-	{3416372224, 3416372479},     // 203.161.180.0/24
-	{3416372992, 3416373247},     // 203.161.183.0/24
-	{3416375296, 3416383487},     // 203.161.192.0/19
-	{3416694784, 3416702975},     // 203.166.160.0/19
-	{3416726528, 3416727551},     // 203.167.28.0/22
-	{3416784896, 3416793087},     // 203.168.0.0/19
-	{3416930816, 3416931327},     // 203.170.58.0/23
-	{3416981504, 3416982527},     // 203.171.0.0/22
-	{3417034752, 3417035007},     // 203.171.208.0/24
-	{3417038848, 3417042943},     // 203.171.224.0/20
-	{3417179136, 3417179391},     // 203.174.4.0/24
-	{3417179648, 3417179903},     // 203.174.6.0/24
-	{3417202688, 3417206783},     // 203.174.96.0/20
-	{3417276416, 3417284607},     // 203.175.128.0/19
-	{3417292800, 3417309183},     // 203.175.192.0/18
-	{3417309184, 3417325567},     // 203.176.0.0/18
-	{3417325568, 3417333759},     // 203.176.64.0/19
-	{3417352192, 3417354239},     // 203.176.168.0/21
-	{3417853952, 3417858047},     // 203.184.80.0/20
-	{3418071040, 3418079231},     // 203.187.160.0/19
-	{3418161152, 3418161663},     // 203.189.0.0/23
-	{3418162688, 3418163199},     // 203.189.6.0/23
-	{3418189824, 3418190847},     // 203.189.112.0/22
-	{3418210304, 3418218495},     // 203.189.192.0/19
-	{3418222592, 3418223615},     // 203.189.240.0/22
-	{3418251264, 3418255359},     // 203.190.96.0/20
-	{3418290432, 3418290687},     // 203.190.249.0/24
-	{3418292224, 3418292735},     // 203.191.0.0/23
-	{3418292736, 3418292991},     // 203.191.2.0/24
-	{3418293504, 3418293759},     // 203.191.5.0/24
-	{3418294016, 3418294271},     // 203.191.7.0/24
-	{3418299648, 3418299903},     // 203.191.29.0/24
-	{3418300160, 3418300415},     // 203.191.31.0/24
-	{3418308608, 3418324991},     // 203.191.64.0/18
-	{3418326272, 3418326527},     // 203.191.133.0/24
-	{3418329088, 3418333183},     // 203.191.144.0/20
-	{3418357760, 3418365951},     // 203.192.0.0/19
-	{3418480640, 3418488831},     // 203.193.224.0/19
-	{3418570752, 3418578943},     // 203.195.64.0/19
-	{3418587136, 3418619903},     // 203.195.128.0/17
-	{3418619904, 3418621951},     // 203.196.0.0/21
-	{3418627072, 3418628095},     // 203.196.28.0/22
-	{3418993920, 3418994175},     // 203.201.181.0/24
-	{3418994176, 3418994431},     // 203.201.182.0/24
-	{3419073536, 3419074559},     // 203.202.236.0/22
-	{3419226112, 3419234303},     // 203.205.64.0/19
-	{3419357184, 3419373567},     // 203.207.64.0/18
-	{3419373568, 3419406335},     // 203.207.128.0/17
-	{3419406336, 3419410431},     // 203.208.0.0/20
-	{3419410432, 3419411455},     // 203.208.16.0/22
-	{3419414528, 3419422719},     // 203.208.32.0/19
-	{3419529216, 3419537407},     // 203.209.224.0/19
-	{3419668480, 3419672575},     // 203.212.0.0/20
-	{3419688960, 3419693055},     // 203.212.80.0/20
-	{3420038144, 3420039167},     // 203.217.164.0/22
-	{3420389376, 3420393471},     // 203.223.0.0/20
-	{3426197504, 3426197759},     // 204.55.160.0/24
-	{3427426304, 3427426559},     // 204.74.96.0/24
-	{3430068224, 3430068735},     // 204.114.176.0/23
-	{3492871097, 3492871097},     // 208.48.251.185/32
-	{3492871098, 3492871098},     // 208.48.251.186/32
-	{3523346432, 3523346943},     // 210.2.0.0/23
-	{3523346944, 3523347199},     // 210.2.2.0/24
-	{3523347712, 3523347967},     // 210.2.5.0/24
-	{3523347968, 3523348479},     // 210.2.6.0/23
-	{3523348480, 3523350527},     // 210.2.8.0/21
-	{3523352576, 3523354623},     // 210.2.24.0/21
-	{3523543040, 3523551231},     // 210.5.0.0/19
-	{3523558400, 3523558655},     // 210.5.60.0/24
-	{3523575808, 3523583999},     // 210.5.128.0/19
-	{3523688448, 3523690495},     // 210.7.56.0/21
-	{3524001792, 3524132863},     // 210.12.0.0/15
-	{3524149248, 3524157439},     // 210.14.64.0/19
-	{3524161536, 3524165631},     // 210.14.112.0/20
-	{3524165632, 3524198399},     // 210.14.128.0/17
-	{3524198400, 3524231167},     // 210.15.0.0/17
-	{3524231168, 3524247551},     // 210.15.128.0/18
-	{3524296704, 3524298751},     // 210.16.128.0/21
-	{3524298752, 3524299775},     // 210.16.136.0/22
-	{3524303872, 3524304895},     // 210.16.156.0/22
-	{3524304896, 3524313087},     // 210.16.160.0/19
-	{3524591616, 3524657151},     // 210.21.0.0/16
-	{3524657152, 3524722687},     // 210.22.0.0/16
-	{3524730880, 3524739071},     // 210.23.32.0/19
-	{3524853760, 3524886527},     // 210.25.0.0/17
-	{3524886528, 3524894719},     // 210.25.128.0/19
-	{3524894720, 3524898815},     // 210.25.160.0/20
-	{3524898816, 3524900863},     // 210.25.176.0/21
-	{3524900864, 3524901375},     // 210.25.184.0/23
-	{3524901376, 3524901439},     // 210.25.186.0/26
-	{3524901504, 3524901631},     // 210.25.186.128/25
-	{3524901632, 3524901887},     // 210.25.187.0/24
-	{3524901888, 3524902911},     // 210.25.188.0/22
-	{3524902912, 3524919295},     // 210.25.192.0/18
-	{3524919296, 3525050367},     // 210.26.0.0/15
-	{3525050368, 3525312511},     // 210.28.0.0/14
-	{3525312512, 3526361087},     // 210.32.0.0/12
-	{3526557696, 3526623231},     // 210.51.0.0/16
-	{3526623232, 3526639615},     // 210.52.0.0/18
-	{3526639616, 3526640127},     // 210.52.64.0/23
-	{3526640128, 3526640383},     // 210.52.66.0/24
-	{3526640896, 3526641151},     // 210.52.69.0/24
-	{3526641152, 3526641663},     // 210.52.70.0/23
-	{3526641664, 3526643711},     // 210.52.72.0/21
-	{3526643712, 3526647807},     // 210.52.80.0/20
-	{3526647808, 3526649855},     // 210.52.96.0/21
-	{3526649856, 3526650879},     // 210.52.104.0/22
-	{3526650880, 3526651135},     // 210.52.108.0/24
-	{3526651392, 3526651903},     // 210.52.110.0/23
-	{3526651904, 3526655999},     // 210.52.112.0/20
-	{3526656000, 3526688767},     // 210.52.128.0/17
-	{3526688768, 3526754303},     // 210.53.0.0/16
-	{3526934528, 3526942719},     // 210.56.192.0/19
-	{3527933952, 3528196095},     // 210.72.0.0/14
-	{3528196096, 3528327167},     // 210.76.0.0/15
-	{3528327168, 3528392703},     // 210.78.0.0/16
-	{3528409088, 3528425471},     // 210.79.64.0/18
-	{3528450048, 3528458239},     // 210.79.224.0/19
-	{3528589312, 3528720383},     // 210.82.0.0/15
-	{3528949760, 3528966143},     // 210.87.128.0/18
-	{3535388672, 3535405055},     // 210.185.192.0/18
-	{3535822848, 3535831039},     // 210.192.96.0/19
-	{3544186880, 3544711167},     // 211.64.0.0/13
-	{3545235456, 3546284031},     // 211.80.0.0/12
-	{3546284032, 3546546175},     // 211.96.0.0/14
-	{3546546176, 3546578943},     // 211.100.0.0/17
-	{3546578944, 3546587135},     // 211.100.128.0/19
-	{3546587136, 3546591231},     // 211.100.160.0/20
-	{3546593280, 3546595327},     // 211.100.184.0/21
-	{3546595328, 3546611711},     // 211.100.192.0/18
-	{3546611712, 3546677247},     // 211.101.0.0/16
-	{3546677248, 3546808319},     // 211.102.0.0/15
-	{3548905472, 3549429759},     // 211.136.0.0/13
-	{3549429760, 3549954047},     // 211.144.0.0/13
-	{3549954048, 3549986815},     // 211.152.0.0/17
-	{3549988352, 3549988863},     // 211.152.134.0/23
-	{3549989888, 3549990911},     // 211.152.140.0/22
-	{3549992448, 3549992959},     // 211.152.150.0/23
-	{3549994240, 3549994495},     // 211.152.157.0/24
-	{3549995008, 3550003199},     // 211.152.160.0/19
-	{3550003200, 3550019583},     // 211.152.192.0/18
-	{3550019584, 3550085119},     // 211.153.0.0/16
-	{3550085120, 3550093311},     // 211.154.0.0/19
-	{3550093312, 3550097407},     // 211.154.32.0/20
-	{3550097408, 3550099455},     // 211.154.48.0/21
-	{3550101504, 3550117887},     // 211.154.64.0/18
-	{3550117888, 3550150655},     // 211.154.128.0/17
-	{3550150656, 3550167039},     // 211.155.0.0/18
-	{3550167808, 3550168063},     // 211.155.67.0/24
-	{3550168064, 3550168319},     // 211.155.68.0/24
-	{3550169088, 3550171135},     // 211.155.72.0/21
-	{3550171136, 3550175231},     // 211.155.80.0/20
-	{3550175488, 3550175743},     // 211.155.97.0/24
-	{3550175744, 3550176255},     // 211.155.98.0/23
-	{3550176256, 3550177279},     // 211.155.100.0/22
-	{3550177280, 3550179327},     // 211.155.104.0/21
-	{3550179584, 3550179839},     // 211.155.113.0/24
-	{3550180352, 3550181375},     // 211.155.116.0/22
-	{3550181376, 3550183423},     // 211.155.120.0/21
-	{3550183424, 3550216191},     // 211.155.128.0/17
-	{3550216192, 3550232575},     // 211.156.0.0/18
-	{3550232576, 3550240767},     // 211.156.64.0/19
-	{3550240768, 3550242815},     // 211.156.96.0/21
-	{3550242816, 3550243839},     // 211.156.104.0/22
-	{3550243840, 3550244351},     // 211.156.108.0/23
-	{3550244864, 3550248959},     // 211.156.112.0/20
-	{3550248960, 3550281727},     // 211.156.128.0/17
-	{3550281728, 3550347263},     // 211.157.0.0/16
-	{3550347264, 3550478335},     // 211.158.0.0/15
-	{3550478336, 3551002623},     // 211.160.0.0/13
-	{3560964096, 3560996863},     // 212.64.0.0/17
-	{3565256704, 3565289471},     // 212.129.128.0/17
-	{3586631936, 3586632191},     // 213.199.169.0/24
-	{3590317056, 3590318079},     // 213.255.228.0/22
-	{3657433088, 3658481663},     // 218.0.0.0/12
-	{3658481664, 3659005951},     // 218.16.0.0/13
-	{3659005952, 3659268095},     // 218.24.0.0/14
-	{3659268096, 3659399167},     // 218.28.0.0/15
-	{3659399168, 3659407359},     // 218.30.0.0/19
-	{3659415552, 3659431935},     // 218.30.64.0/18
-	{3659431936, 3659448319},     // 218.30.128.0/18
-	{3659448320, 3659456511},     // 218.30.192.0/19
-	{3659456512, 3659460607},     // 218.30.224.0/20
-	{3659460608, 3659462655},     // 218.30.240.0/21
-	{3659462656, 3659463679},     // 218.30.248.0/22
-	{3659463680, 3659463807},     // 218.30.252.0/25
-	{3659463808, 3659463871},     // 218.30.252.128/26
-	{3659463874, 3659463875},     // 218.30.252.194/31
-	{3659463876, 3659463879},     // 218.30.252.196/30
-	{3659463880, 3659463887},     // 218.30.252.200/29
-	{3659463888, 3659463903},     // 218.30.252.208/28
-	{3659463904, 3659463935},     // 218.30.252.224/27
-	{3659463936, 3659464191},     // 218.30.253.0/24
-	{3659464192, 3659464703},     // 218.30.254.0/23
-	{3659464704, 3659530239},     // 218.31.0.0/16
-	{3661103104, 3661627391},     // 218.56.0.0/13
-	{3661627392, 3663724543},     // 218.64.0.0/11
-	{3663724544, 3663855615},     // 218.96.0.0/15
-	{3663855616, 3663871999},     // 218.98.0.0/18
-	{3663880192, 3663888383},     // 218.98.96.0/19
-	{3663888384, 3663896575},     // 218.98.128.0/19
-	{3663904768, 3663921151},     // 218.98.192.0/18
-	{3663921152, 3663986687},     // 218.99.0.0/16
-	{3664011264, 3664019455},     // 218.100.96.0/19
-	{3664019456, 3664052223},     // 218.100.128.0/17
-	{3664248832, 3664510975},     // 218.104.0.0/14
-	{3664510976, 3664642047},     // 218.108.0.0/15
-	{3669606400, 3669614591},     // 218.185.192.0/19
-	{3670016000, 3671064575},     // 218.192.0.0/12
-	{3673161728, 3673423871},     // 218.240.0.0/14
-	{3673423872, 3673554943},     // 218.244.0.0/15
-	{3673554944, 3673563135},     // 218.246.0.0/19
-	{3673563136, 3673567231},     // 218.246.32.0/20
-	{3673567232, 3673569279},     // 218.246.48.0/21
-	{3673569280, 3673569791},     // 218.246.56.0/23
-	{3673569792, 3673570047},     // 218.246.58.0/24
-	{3673570304, 3673571327},     // 218.246.60.0/22
-	{3673571328, 3673587711},     // 218.246.64.0/18
-	{3673587968, 3673588223},     // 218.246.129.0/24
-	{3673588480, 3673588735},     // 218.246.131.0/24
-	{3673588736, 3673589247},     // 218.246.132.0/23
-	{3673589248, 3673589503},     // 218.246.134.0/24
-	{3673590528, 3673590783},     // 218.246.139.0/24
-	{3673591808, 3673595903},     // 218.246.144.0/20
-	{3673595904, 3673604095},     // 218.246.160.0/19
-	{3673604096, 3673620479},     // 218.246.192.0/18
-	{3673620480, 3673636863},     // 218.247.0.0/18
-	{3673645056, 3673653247},     // 218.247.96.0/19
-	{3673653248, 3673686015},     // 218.247.128.0/17
-	{3673751552, 3673817087},     // 218.249.0.0/16
-	{3678928896, 3678994431},     // 219.72.0.0/16
-	{3679584256, 3679649791},     // 219.82.0.0/16
-	{3679682560, 3679715327},     // 219.83.128.0/17
-	{3680125952, 3680126975},     // 219.90.68.0/22
-	{3680126976, 3680129023},     // 219.90.72.0/21
-	{3682598912, 3684696063},     // 219.128.0.0/11
-	{3688366080, 3688890367},     // 219.216.0.0/13
-	{3688890368, 3689414655},     // 219.224.0.0/13
-	{3689414656, 3689545727},     // 219.232.0.0/15
-	{3689545728, 3689547775},     // 219.234.0.0/21
-	{3689548288, 3689548799},     // 219.234.10.0/23
-	{3689548800, 3689549823},     // 219.234.12.0/22
-	{3689553920, 3689562111},     // 219.234.32.0/19
-	{3689562112, 3689578495},     // 219.234.64.0/18
-	{3689578496, 3689611263},     // 219.234.128.0/17
-	{3689611264, 3689676799},     // 219.235.0.0/16
-	{3689676800, 3689938943},     // 219.236.0.0/14
-	{3690070016, 3690201087},     // 219.242.0.0/15
-	{3690201088, 3690463231},     // 219.244.0.0/14
-	{3697655808, 3697672191},     // 220.101.192.0/18
-	{3698327552, 3698589695},     // 220.112.0.0/14
-	{3700981760, 3701014527},     // 220.152.128.0/17
-	{3701080064, 3701145599},     // 220.154.0.0/16
-	{3701145600, 3701147647},     // 220.155.0.0/21
-	{3701147904, 3701148159},     // 220.155.9.0/24
-	{3701148160, 3701148671},     // 220.155.10.0/23
-	{3701148672, 3701149695},     // 220.155.12.0/22
-	{3701149696, 3701151743},     // 220.155.16.0/21
-	{3701151744, 3701152767},     // 220.155.24.0/22
-	{3701152768, 3701153279},     // 220.155.28.0/23
-	{3701153536, 3701153791},     // 220.155.31.0/24
-	{3701153792, 3701161983},     // 220.155.32.0/19
-	{3701161984, 3701178367},     // 220.155.64.0/18
-	{3701178368, 3701211135},     // 220.155.128.0/17
-	{3701403904, 3701404159},     // 220.158.241.0/24
-	{3701404416, 3701404671},     // 220.158.243.0/24
-	{3701473280, 3703570431},     // 220.160.0.0/11
-	{3703570432, 3704619007},     // 220.192.0.0/12
-	{3706126336, 3706142719},     // 220.231.0.0/18
-	{3706159104, 3706191871},     // 220.231.128.0/17
-	{3706208256, 3706224639},     // 220.232.64.0/18
-	{3706322944, 3706388479},     // 220.234.0.0/16
-	{3706847232, 3706847743},     // 220.242.0.0/23
-	{3706848768, 3706849023},     // 220.242.6.0/24
-	{3706849280, 3706849535},     // 220.242.8.0/24
-	{3706850304, 3706850815},     // 220.242.12.0/23
-	{3706850816, 3706851071},     // 220.242.14.0/24
-	{3706851584, 3706851839},     // 220.242.17.0/24
-	{3706851840, 3706852351},     // 220.242.18.0/23
-	{3706852352, 3706852607},     // 220.242.20.0/24
-	{3706855424, 3706859519},     // 220.242.32.0/20
-	{3706859520, 3706860031},     // 220.242.48.0/23
-	{3706860800, 3706861055},     // 220.242.53.0/24
-	{3706861312, 3706861567},     // 220.242.55.0/24
-	{3706861568, 3706862591},     // 220.242.56.0/22
-	{3706862592, 3706863103},     // 220.242.60.0/23
-	{3706863104, 3706863359},     // 220.242.62.0/24
-	{3706863616, 3706871807},     // 220.242.64.0/19
-	{3706871808, 3706875903},     // 220.242.96.0/20
-	{3706875904, 3706877951},     // 220.242.112.0/21
-	{3706877952, 3706878975},     // 220.242.120.0/22
-	{3706878976, 3706879487},     // 220.242.124.0/23
-	{3706879488, 3706879743},     // 220.242.126.0/24
-	{3706881536, 3706882047},     // 220.242.134.0/23
-	{3706891520, 3706891775},     // 220.242.173.0/24
-	{3706894080, 3706894335},     // 220.242.183.0/24
-	{3706897664, 3706897919},     // 220.242.197.0/24
-	{3706899712, 3706899967},     // 220.242.205.0/24
-	{3706900224, 3706900479},     // 220.242.207.0/24
-	{3706902784, 3706903039},     // 220.242.217.0/24
-	{3706903040, 3706903551},     // 220.242.218.0/23
-	{3706903552, 3706904575},     // 220.242.220.0/22
-	{3706904576, 3706912767},     // 220.242.224.0/19
-	{3706912768, 3706945535},     // 220.243.0.0/17
-	{3706945536, 3706961919},     // 220.243.128.0/18
-	{3706961920, 3706962431},     // 220.243.192.0/23
-	{3706962944, 3706963199},     // 220.243.196.0/24
-	{3706963456, 3706963967},     // 220.243.198.0/23
-	{3706964224, 3706964479},     // 220.243.201.0/24
-	{3706964992, 3706965247},     // 220.243.204.0/24
-	{3706967552, 3706967807},     // 220.243.214.0/24
-	{3706968064, 3706968575},     // 220.243.216.0/23
-	{3706968576, 3706968831},     // 220.243.218.0/24
-	{3706969088, 3706969599},     // 220.243.220.0/23
-	{3706969856, 3706970111},     // 220.243.223.0/24
-	{3706970368, 3706970623},     // 220.243.225.0/24
-	{3706970624, 3706971135},     // 220.243.226.0/23
-	{3706971392, 3706971647},     // 220.243.229.0/24
-	{3706971648, 3706971903},     // 220.243.230.0/24
-	{3706972672, 3706973183},     // 220.243.234.0/23
-	{3706973440, 3706973695},     // 220.243.237.0/24
-	{3706973696, 3706974207},     // 220.243.238.0/23
-	{3706974976, 3706975231},     // 220.243.243.0/24
-	{3706975232, 3706975487},     // 220.243.244.0/24
-	{3706975744, 3706975999},     // 220.243.246.0/24
-	{3706976512, 3706976767},     // 220.243.249.0/24
-	{3706976768, 3706977023},     // 220.243.250.0/24
-	{3706977280, 3706977535},     // 220.243.252.0/24
-	{3706977792, 3706978303},     // 220.243.254.0/23
-	{3707209728, 3707211775},     // 220.247.136.0/21
-	{3707240448, 3707502591},     // 220.248.0.0/14
-	{3707502592, 3707568127},     // 220.252.0.0/16
-	{3707764736, 3708289023},     // 221.0.0.0/13
-	{3708289024, 3708551167},     // 221.8.0.0/14
-	{3708551168, 3708583935},     // 221.12.0.0/17
-	{3708583936, 3708600319},     // 221.12.128.0/18
-	{3708616704, 3708682239},     // 221.13.0.0/16
-	{3708682240, 3708813311},     // 221.14.0.0/15
-	{3715760128, 3715891199},     // 221.122.0.0/15
-	{3716186112, 3716218879},     // 221.128.128.0/17
-	{3716218880, 3716284415},     // 221.129.0.0/16
-	{3716284416, 3716415487},     // 221.130.0.0/15
-	{3716538368, 3716546559},     // 221.133.224.0/19
-	{3716677632, 3716808703},     // 221.136.0.0/15
-	{3719036928, 3719299071},     // 221.172.0.0/14
-	{3719299072, 3719307263},     // 221.176.0.0/19
-	{3719307264, 3719311359},     // 221.176.32.0/20
-	{3719311360, 3719313407},     // 221.176.48.0/21
-	{3719313408, 3719313663},     // 221.176.56.0/24
-	{3719313920, 3719314431},     // 221.176.58.0/23
-	{3719314432, 3719315455},     // 221.176.60.0/22
-	{3719315456, 3719331839},     // 221.176.64.0/18
-	{3719331840, 3719364607},     // 221.176.128.0/17
-	{3719364608, 3719430143},     // 221.177.0.0/16
-	{3719430144, 3719561215},     // 221.178.0.0/15
-	{3719561216, 3719823359},     // 221.180.0.0/14
-	{3720347648, 3720609791},     // 221.192.0.0/14
-	{3720609792, 3720740863},     // 221.196.0.0/15
-	{3720740864, 3720806399},     // 221.198.0.0/16
-	{3720806400, 3720839167},     // 221.199.0.0/17
-	{3720839168, 3720855551},     // 221.199.128.0/18
-	{3720855552, 3720859647},     // 221.199.192.0/20
-	{3720863744, 3720871935},     // 221.199.224.0/19
-	{3720871936, 3721396223},     // 221.200.0.0/13
-	{3721396224, 3722444799},     // 221.208.0.0/12
-	{3722444800, 3723493375},     // 221.224.0.0/12
-	{3725590528, 3726639103},     // 222.16.0.0/12
-	{3726639104, 3728736255},     // 222.32.0.0/11
-	{3728736256, 3730833407},     // 222.64.0.0/11
-	{3732733952, 3732799487},     // 222.125.0.0/16
-	{3732832256, 3732840447},     // 222.126.128.0/19
-	{3732840448, 3732842495},     // 222.126.160.0/21
-	{3732842496, 3732843519},     // 222.126.168.0/22
-	{3732843520, 3732844031},     // 222.126.172.0/23
-	{3732844072, 3732844079},     // 222.126.174.40/29
-	{3732844108, 3732844111},     // 222.126.174.76/30
-	{3732844120, 3732844127},     // 222.126.174.88/29
-	{3732844176, 3732844191},     // 222.126.174.144/28
-	{3732845056, 3732845567},     // 222.126.178.0/23
-	{3732845568, 3732846591},     // 222.126.180.0/22
-	{3732846592, 3732848639},     // 222.126.184.0/21
-	{3732848640, 3732850687},     // 222.126.192.0/21
-	{3732850792, 3732850799},     // 222.126.200.104/29
-	{3732852224, 3732852735},     // 222.126.206.0/23
-	{3732852736, 3732853759},     // 222.126.208.0/22
-	{3732853760, 3732853823},     // 222.126.212.0/26
-	{3732853824, 3732853855},     // 222.126.212.64/27
-	{3732853856, 3732853871},     // 222.126.212.96/28
-	{3732853872, 3732853879},     // 222.126.212.112/29
-	{3732853888, 3732854015},     // 222.126.212.128/25
-	{3732854016, 3732854271},     // 222.126.213.0/24
-	{3732854272, 3732854783},     // 222.126.214.0/23
-	{3732854784, 3732856831},     // 222.126.216.0/21
-	{3732856832, 3732865023},     // 222.126.224.0/19
-	{3732930560, 3733979135},     // 222.128.0.0/12
-	{3735027712, 3735289855},     // 222.160.0.0/14
-	{3735552000, 3736076287},     // 222.168.0.0/13
-	{3736076288, 3737124863},     // 222.176.0.0/12
-	{3737124864, 3739222015},     // 222.192.0.0/11
-	{3740270592, 3740794879},     // 222.240.0.0/13
-	{3740794880, 3740925951},     // 222.248.0.0/15
-	{3741319168, 3742367743},     // 223.0.0.0/12
-	{3742629888, 3742760959},     // 223.20.0.0/15
-	{3743135744, 3743136767},     // 223.27.184.0/22
-	{3743272960, 3743273983},     // 223.29.208.0/22
-	{3743284224, 3743285247},     // 223.29.252.0/22
-	{3745513472, 3747610623},     // 223.64.0.0/11
-	{3747610624, 3748659199},     // 223.96.0.0/12
-	{3748659200, 3748921343},     // 223.112.0.0/14
-	{3748921344, 3749052415},     // 223.116.0.0/15
-	{3749183488, 3749707775},     // 223.120.0.0/13
-	{3749707776, 3749838847},     // 223.128.0.0/15
-	{3750756352, 3751804927},     // 223.144.0.0/12
-	{3751804928, 3752067071},     // 223.160.0.0/14
-	{3752198144, 3752329215},     // 223.166.0.0/15
-	{3753902080, 3754033151},     // 223.192.0.0/15
-	{3754295296, 3754426367},     // 223.198.0.0/15
-	{3754493952, 3754495999},     // 223.201.8.0/21
-	{3754496000, 3754500095},     // 223.201.16.0/20
-	{3754500096, 3754508287},     // 223.201.32.0/19
-	{3754508288, 3754524671},     // 223.201.64.0/18
-	{3754524672, 3754557439},     // 223.201.128.0/17
-	{3754557440, 3754688511},     // 223.202.0.0/15
-	{3754950656, 3755474943},     // 223.208.0.0/13
-	{3755737088, 3755868159},     // 223.220.0.0/15
-	{3755978752, 3755982847},     // 223.223.176.0/20
-	{3755982848, 3755986943},     // 223.223.192.0/20
-	{3757047808, 3757572095},     // 223.240.0.0/13
-	{3757572096, 3757834239},     // 223.248.0.0/14
-	{3757867008, 3757875199},     // 223.252.128.0/19
-	{3757883392, 3757899775},     // 223.252.192.0/18
-	{3757965312, 3758030847},     // 223.254.0.0/16
-	{3758030848, 3758063615},     // 223.255.0.0/17
-	{3758091264, 3758092287},     // 223.255.236.0/22
-	{3758095360, 3758095871},     // 223.255.252.0/23
-}
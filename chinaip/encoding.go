@@ -0,0 +1,101 @@
+package chinaip
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+)
+
+// The binary format of Set is a gzip stream of
+//
+//	magic "CNIP1", uvarint(number of ranges),
+//	and for each range: uvarint(gap from the previous range), uvarint(size - 1)
+//
+// Since the ranges are merged and sorted, the gaps and sizes are small
+// numbers, which makes the format much more compact than the source lists.
+const setMagic = "CNIP1"
+
+// MarshalBinary encodes the set in the compact binary format.
+func (s *Set) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	w := bufio.NewWriter(zw)
+	var tmp [binary.MaxVarintLen64]byte
+	uvarint := func(v uint64) {
+		n := binary.PutUvarint(tmp[:], v)
+		w.Write(tmp[:n])
+	}
+
+	w.WriteString(setMagic)
+	uvarint(uint64(s.Len()))
+	next := uint64(0) // the first address after the previous range
+	for i := 0; i < len(s.ranges); i += 2 {
+		uvarint(uint64(s.ranges[i]) - next)
+		uvarint(uint64(s.ranges[i+1] - s.ranges[i]))
+		next = uint64(s.ranges[i+1]) + 1
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the set from the compact binary format.
+func (s *Set) UnmarshalBinary(data []byte) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(zr)
+
+	magic := make([]byte, len(setMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != setMagic {
+		return Error("invalid set magic")
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > 1<<31 {
+		return Error("invalid set length")
+	}
+
+	// decode into a single flat array, without intermediate ranges
+	ranges := make([]uint32, 0, 2*n)
+	next := uint64(0)
+	for i := uint64(0); i < n; i++ {
+		gap, err := binary.ReadUvarint(r)
+		if err != nil {
+			return Error("truncated set")
+		}
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return Error("truncated set")
+		}
+		start := next + gap
+		end := start + size
+		if end > 0xffffffff || (i > 0 && gap == 0) {
+			return Error("invalid set range")
+		}
+		ranges = append(ranges, uint32(start), uint32(end))
+		next = end + 1
+	}
+	rest, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return Error("trailing data after set")
+	}
+
+	s.ranges = ranges
+	return nil
+}
@@ -0,0 +1,36 @@
+package chinaip_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestSetBinary(t *testing.T) {
+	ranges := []chinaip.Range{{0, 0}, {16777472, 16778239}, {3758095360, 3758095871}, {4294967295, 4294967295}}
+	data, err := chinaip.NewSet(ranges).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &chinaip.Set{}
+	if err := s.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s.Ranges(), ranges) {
+		t.Errorf("got %v, want %v", s.Ranges(), ranges)
+	}
+
+	for _, bad := range [][]byte{nil, []byte("CNIP1"), data[:len(data)-4]} {
+		if err := s.UnmarshalBinary(bad); err == nil {
+			t.Errorf("%q should not be decoded", bad)
+		}
+	}
+}
+
+func TestBuiltinDatabase(t *testing.T) {
+	if n := chinaip.Current().Len(); n < 1000 {
+		t.Errorf("builtin database has only %d ranges", n)
+	}
+}
@@ -0,0 +1,70 @@
+package chinaip
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// CountryDB classifies addresses by their ISO country codes in a MaxMind
+// GeoIP2/GeoLite2 Country (or compatible) mmdb file.
+type CountryDB struct {
+	reader    *maxminddb.Reader
+	countries map[string]bool
+}
+
+type countryRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+}
+
+// OpenCountryDB opens the mmdb file, and the addresses located in any of
+// `countries` are considered local.
+func OpenCountryDB(filename string, countries []string) (*CountryDB, error) {
+	codes := make(map[string]bool)
+	for _, c := range countries {
+		if c = strings.ToUpper(strings.TrimSpace(c)); c != "" {
+			codes[c] = true
+		}
+	}
+	if len(codes) == 0 {
+		return nil, Error("no country codes for " + filename)
+	}
+
+	reader, err := maxminddb.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	return &CountryDB{
+		reader:    reader,
+		countries: codes,
+	}, nil
+}
+
+// Country returns the ISO country code of `addr`, or "" if it is unknown.
+// The registered country is used if the location is missing.
+func (db *CountryDB) Country(addr netip.Addr) string {
+	var record countryRecord
+	if err := db.reader.Lookup(addr.Unmap().AsSlice(), &record); err != nil {
+		return ""
+	}
+	if record.Country.ISOCode != "" {
+		return record.Country.ISOCode
+	}
+	return record.RegisteredCountry.ISOCode
+}
+
+// Contains returns whether `addr` is located in one of the local countries.
+func (db *CountryDB) Contains(addr netip.Addr) bool {
+	return db.countries[db.Country(addr)]
+}
+
+// Close unmaps the mmdb file.
+func (db *CountryDB) Close() error {
+	return db.reader.Close()
+}
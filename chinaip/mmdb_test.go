@@ -0,0 +1,136 @@
+package chinaip_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+// writeCountryMMDB writes a minimal IPv4 country mmdb file with record size 24.
+func writeCountryMMDB(t *testing.T, networks map[string]string) string {
+	const empty = -1
+	// node records: >= 0 is a node index, <= -2 is -2-(data offset)
+	nodes := [][2]int{{empty, empty}}
+	var data bytes.Buffer
+
+	str := func(b *bytes.Buffer, s string) {
+		b.WriteByte(2<<5 | byte(len(s)))
+		b.WriteString(s)
+	}
+	for cidr, country := range networks {
+		p := netip.MustParsePrefix(cidr)
+		offset := data.Len()
+		data.WriteByte(7<<5 | 1) // map with 1 entry
+		str(&data, "country")
+		data.WriteByte(7<<5 | 1)
+		str(&data, "iso_code")
+		str(&data, country)
+
+		ip := p.Addr().As4()
+		node := 0
+		for i := 0; i < p.Bits(); i++ {
+			bit := (ip[i/8] >> uint(7-i%8)) & 1
+			if i == p.Bits()-1 {
+				nodes[node][bit] = -2 - offset
+				break
+			}
+			if nodes[node][bit] < 0 {
+				nodes = append(nodes, [2]int{empty, empty})
+				nodes[node][bit] = len(nodes) - 1
+			}
+			node = nodes[node][bit]
+		}
+	}
+
+	var db bytes.Buffer
+	n := len(nodes)
+	for _, node := range nodes {
+		for _, r := range node {
+			v := n // empty
+			if r >= 0 {
+				v = r
+			} else if r <= -2 {
+				v = n + 16 + (-2 - r)
+			}
+			db.Write([]byte{byte(v >> 16), byte(v >> 8), byte(v)})
+		}
+	}
+	db.Write(make([]byte, 16))
+	db.Write(data.Bytes())
+
+	db.WriteString("\xab\xcd\xefMaxMind.com")
+	uint16Field := func(key string, v uint16) {
+		str(&db, key)
+		db.WriteByte(5<<5 | 2)
+		binary.Write(&db, binary.BigEndian, v)
+	}
+	db.WriteByte(7<<5 | 5)
+	str(&db, "node_count")
+	db.WriteByte(6<<5 | 4)
+	binary.Write(&db, binary.BigEndian, uint32(n))
+	uint16Field("record_size", 24)
+	uint16Field("ip_version", 4)
+	uint16Field("binary_format_major_version", 2)
+	str(&db, "database_type")
+	str(&db, "Test-Country")
+
+	f, err := ioutil.TempFile("", "test_country_mmdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(db.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestCountryDB(t *testing.T) {
+	filename := writeCountryMMDB(t, map[string]string{
+		"1.0.1.0/24": "CN",
+		"8.8.8.0/24": "US",
+		"1.1.1.0/24": "HK",
+	})
+	defer os.Remove(filename)
+
+	db, err := chinaip.OpenCountryDB(filename, []string{"cn", " HK"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		ip      string
+		country string
+		local   bool
+	}{
+		{"1.0.1.1", "CN", true},
+		{"1.1.1.1", "HK", true},
+		{"8.8.8.8", "US", false},
+		{"9.9.9.9", "", false},
+		{"2001:db8::1", "", false},
+	}
+	for _, tt := range tests {
+		addr := netip.MustParseAddr(tt.ip)
+		if country := db.Country(addr); country != tt.country {
+			t.Errorf("%s: got country %q, want %q", tt.ip, country, tt.country)
+		}
+		if local := db.Contains(addr); local != tt.local {
+			t.Errorf("%s: got local %v, want %v", tt.ip, local, tt.local)
+		}
+	}
+
+	union := chinaip.Union(db, chinaip.ClassifierFunc(chinaip.Contains))
+	if !union.Contains(netip.MustParseAddr("114.114.114.114")) || union.Contains(netip.MustParseAddr("8.8.8.8")) {
+		t.Errorf("union of the country db and the builtin list is wrong")
+	}
+
+	if _, err := chinaip.OpenCountryDB(filename, nil); err == nil {
+		t.Errorf("country codes should be required")
+	}
+}
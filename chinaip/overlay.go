@@ -0,0 +1,161 @@
+package chinaip
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+// Rule includes or excludes a prefix on top of the base classification.
+type Rule struct {
+	Prefix  netip.Prefix
+	Include bool
+	// Source tells where the rule comes from, e.g. "overrides.txt:3".
+	Source string
+}
+
+func (r Rule) String() string {
+	action := "exclude"
+	if r.Include {
+		action = "include"
+	}
+	if r.Source == "" {
+		return action + " " + r.Prefix.String()
+	}
+	return action + " " + r.Prefix.String() + " (" + r.Source + ")"
+}
+
+// ParseRule parses a rule like "include 1.2.3.0/24" or "exclude 2001:db8::/32".
+// A single address is treated as a full-length prefix.
+func ParseRule(s string) (Rule, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 || (fields[0] != "include" && fields[0] != "exclude") {
+		return Rule{}, Error("invalid rule: " + s)
+	}
+	prefix, err := parsePrefix(fields[1])
+	if err != nil {
+		return Rule{}, err
+	}
+	return Rule{Prefix: prefix, Include: fields[0] == "include"}, nil
+}
+
+// ParseRules reads one rule per line, ignoring empty lines and comments
+// starting with '#'. `source` names the reader in Rule.Source.
+func ParseRules(r io.Reader, source string) ([]Rule, error) {
+	var rules []Rule
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rule, err := ParseRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", source, n, err)
+		}
+		rule.Source = fmt.Sprintf("%s:%d", source, n)
+		rules = append(rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, Error("host bits set in prefix: " + s)
+	}
+	return prefix, nil
+}
+
+// Match is the effective classification of an address.
+type Match struct {
+	Local bool
+	// Rule is the overlay rule deciding the classification,
+	// or nil if the base classifier decides it.
+	Rule *Rule
+}
+
+// Overlay applies user rules on top of a base Classifier.
+// The most specific rule matching an address wins, and exclude rules win
+// over include rules of the same prefix length.
+type Overlay struct {
+	base  Classifier
+	rules []Rule
+}
+
+// NewOverlay creates an Overlay of `rules` on top of `base`.
+func NewOverlay(base Classifier, rules []Rule) *Overlay {
+	sorted := append([]Rule(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Prefix.Bits() != sorted[j].Prefix.Bits() {
+			return sorted[i].Prefix.Bits() > sorted[j].Prefix.Bits()
+		}
+		return !sorted[i].Include && sorted[j].Include
+	})
+	return &Overlay{base: base, rules: sorted}
+}
+
+// Rules returns the rules in the order they are matched.
+func (o *Overlay) Rules() []Rule {
+	return append([]Rule(nil), o.rules...)
+}
+
+// Explain returns the effective classification of `addr` and the rule
+// deciding it.
+func (o *Overlay) Explain(addr netip.Addr) Match {
+	addr = addr.Unmap()
+	for i := range o.rules {
+		if o.rules[i].Prefix.Contains(addr) {
+			return Match{Local: o.rules[i].Include, Rule: &o.rules[i]}
+		}
+	}
+	return Match{Local: o.base.Contains(addr)}
+}
+
+// Contains returns whether `addr` is local after applying the rules.
+func (o *Overlay) Contains(addr netip.Addr) bool {
+	return o.Explain(addr).Local
+}
+
+// ApplyRules returns the merged IPv4 `ranges` with the IPv4 rules applied
+// like Overlay does, so the ranges match its classification.
+func ApplyRules(ranges []Range, rules []Rule) []Range {
+	result := Merge(append([]Range(nil), ranges...))
+	// apply from the least specific rule, so the more specific ones
+	// override it, as the rules are matched in the reversed order
+	sorted := NewOverlay(nil, rules).rules
+	for i := len(sorted) - 1; i >= 0; i-- {
+		prefix := sorted[i].Prefix
+		if !prefix.Addr().Is4() {
+			continue
+		}
+		start := addr2Int(prefix.Addr())
+		rg := Range{start, start | uint32(uint64(1)<<uint(32-prefix.Bits())-1)}
+		if sorted[i].Include {
+			result = Merge(append(result, rg))
+		} else {
+			result = Subtract(result, []Range{rg})
+		}
+	}
+	return result
+}
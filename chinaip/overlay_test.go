@@ -0,0 +1,88 @@
+package chinaip_test
+
+import (
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestOverlay(t *testing.T) {
+	rules, err := chinaip.ParseRules(strings.NewReader(`
+# our overseas DC behaving domestically
+include 8.8.8.0/24
+exclude 8.8.8.8     # but not this one
+exclude 114.114.0.0/16 # anycast abroad
+include 114.114.114.0/24
+include 2001:db8::/32
+`), "overrides.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := chinaip.NewOverlay(chinaip.ClassifierFunc(chinaip.Contains), rules)
+
+	tests := []struct {
+		ip    string
+		local bool
+		rule  string
+	}{
+		{"8.8.8.1", true, "include 8.8.8.0/24 (overrides.txt:3)"},
+		{"8.8.8.8", false, "exclude 8.8.8.8/32 (overrides.txt:4)"},
+		{"114.114.1.1", false, "exclude 114.114.0.0/16 (overrides.txt:5)"},
+		{"114.114.114.114", true, "include 114.114.114.0/24 (overrides.txt:6)"},
+		{"::ffff:114.114.114.114", true, "include 114.114.114.0/24 (overrides.txt:6)"},
+		{"2001:db8::1", true, "include 2001:db8::/32 (overrides.txt:7)"},
+		{"220.181.57.216", true, ""},
+		{"1.1.1.1", false, ""},
+	}
+	for _, tt := range tests {
+		addr := netip.MustParseAddr(tt.ip)
+		m := o.Explain(addr)
+		rule := ""
+		if m.Rule != nil {
+			rule = m.Rule.String()
+		}
+		if m.Local != tt.local || rule != tt.rule || o.Contains(addr) != tt.local {
+			t.Errorf("%s: got %v %q, want %v %q", tt.ip, m.Local, rule, tt.local, tt.rule)
+		}
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	for _, bad := range []string{"include", "add 1.2.3.0/24", "include 1.2.3.4/24", "exclude 1.2.3.0/24 x", "include wtf"} {
+		if _, err := chinaip.ParseRules(strings.NewReader(bad), "test"); err == nil {
+			t.Errorf("%q should not be parsed", bad)
+		}
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	rules, err := chinaip.ParseRules(strings.NewReader(`
+include 8.8.8.0/24
+exclude 8.8.8.8
+exclude 114.114.0.0/16
+include 114.114.114.0/24
+include 114.114.114.0/24
+exclude 114.114.114.0/24
+include 2001:db8::/32
+`), "overrides.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := chinaip.Current()
+	ranges := chinaip.ApplyRules(base.Ranges(), rules)
+	applied := chinaip.NewSet(ranges)
+	o := chinaip.NewOverlay(base, rules)
+
+	if !reflect.DeepEqual(chinaip.Merge(append([]chinaip.Range(nil), ranges...)), ranges) {
+		t.Errorf("the ranges should be merged")
+	}
+	for _, ip := range []string{"8.8.8.7", "8.8.8.8", "8.8.8.9", "114.114.1.1", "114.114.114.114", "220.181.57.216", "1.1.1.1"} {
+		addr := netip.MustParseAddr(ip)
+		if applied.Contains(addr) != o.Contains(addr) {
+			t.Errorf("%s: got %v, want %v", ip, applied.Contains(addr), o.Contains(addr))
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package chinaip
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Range is an inclusive range of IPv4 addresses in integer format.
+type Range struct {
+	Start uint32
+	End   uint32
+}
+
+// ParseCIDR converts an IPv4 CIDR like "1.0.1.0/24" to a Range.
+// CIDRs with host bits set are rejected.
+func ParseCIDR(cidr string) (Range, error) {
+	ip, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return Range{}, err
+	}
+	ones, bits := ipnet.Mask.Size()
+	if bits != 32 {
+		return Range{}, Error("not ipv4 cidr: " + cidr)
+	}
+	if !ip.Equal(ipnet.IP) {
+		return Range{}, Error("host bits set in cidr: " + cidr)
+	}
+	start, _ := IP2Int(ipnet.IP.String())
+	end := start | uint32(uint64(1)<<uint(32-ones)-1)
+	return Range{start, end}, nil
+}
+
+// ParseCIDRList reads one IPv4 CIDR per line.
+// Empty lines and lines starting with '#' are ignored.
+func ParseCIDRList(r io.Reader) ([]Range, error) {
+	var ranges []Range
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rg, err := ParseCIDR(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		ranges = append(ranges, rg)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+// ParseAPNIC reads the IPv4 records of `country` from an APNIC delegated
+// statistics file, whose records look like
+//
+//	apnic|CN|ipv4|1.0.1.0|256|20110414|allocated
+func ParseAPNIC(r io.Reader, country string) ([]Range, error) {
+	var ranges []Range
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "|")
+		// skip the version line and the summary lines
+		if len(fields) < 7 || fields[2] != "ipv4" || fields[1] != country {
+			continue
+		}
+		start, err := IP2Int(fields[3])
+		if err != nil || net.ParseIP(fields[3]) == nil {
+			return nil, fmt.Errorf("line %d: invalid ip %q", n, fields[3])
+		}
+		count, err := strconv.ParseUint(fields[4], 10, 32)
+		if err != nil || count == 0 || uint64(start)+count-1 > 0xffffffff {
+			return nil, fmt.Errorf("line %d: invalid address count %q", n, fields[4])
+		}
+		ranges = append(ranges, Range{start, uint32(uint64(start) + count - 1)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+// ParseList parses a list in `format`, which is either "cidr" for
+// ParseCIDRList or "apnic" for ParseAPNIC with `country`.
+func ParseList(r io.Reader, format string, country string) ([]Range, error) {
+	switch format {
+	case "", "cidr":
+		return ParseCIDRList(r)
+	case "apnic":
+		return ParseAPNIC(r, country)
+	default:
+		return nil, Error("unknown list format " + format)
+	}
+}
+
+// Merge sorts the ranges and merges the overlapping or adjacent ones.
+// The input slice is reordered in place.
+func Merge(ranges []Range) []Range {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+
+	var merged []Range
+	for _, rg := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && uint64(rg.Start) <= uint64(merged[last].End)+1 {
+			if rg.End > merged[last].End {
+				merged[last].End = rg.End
+			}
+			continue
+		}
+		merged = append(merged, rg)
+	}
+	return merged
+}
+
+// Subtract returns the addresses in `a` but not in `b`, which are both merged.
+func Subtract(a []Range, b []Range) []Range {
+	var result []Range
+	j := 0
+	for _, rg := range a {
+		for j < len(b) && b[j].End < rg.Start {
+			j++
+		}
+		start := uint64(rg.Start)
+		for k := j; k < len(b) && b[k].Start <= rg.End; k++ {
+			if uint64(b[k].Start) > start {
+				result = append(result, Range{uint32(start), b[k].Start - 1})
+			}
+			if uint64(b[k].End)+1 > start {
+				start = uint64(b[k].End) + 1
+			}
+		}
+		if start <= uint64(rg.End) {
+			result = append(result, Range{uint32(start), rg.End})
+		}
+	}
+	return result
+}
+
+// Complement returns the IPv4 addresses not in the merged `ranges`.
+func Complement(ranges []Range) []Range {
+	return Subtract([]Range{{0, math.MaxUint32}}, ranges)
+}
+
+// Prefixes returns the fewest CIDR prefixes covering exactly the range.
+func (r Range) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for start := uint64(r.Start); start <= uint64(r.End); {
+		// the largest block aligned at start within the range
+		bits := 32
+		for bits > 0 {
+			size := uint64(1) << uint(33-bits)
+			if start%size != 0 || start+size-1 > uint64(r.End) {
+				break
+			}
+			bits--
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(int2Addr(uint32(start)), bits))
+		start += uint64(1) << uint(32-bits)
+	}
+	return prefixes
+}
@@ -0,0 +1,92 @@
+package chinaip_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestParseCIDRList(t *testing.T) {
+	got, err := chinaip.ParseCIDRList(strings.NewReader("# comment\n1.0.1.0/24\n\n 1.0.2.0/23 \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chinaip.Range{{16777472, 16777727}, {16777728, 16778239}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, bad := range []string{"1.0.1.0/24\n1.0\n", "1.0.1.1/24\n", "::/0\n", "1.0.1.0/33\n"} {
+		if _, err := chinaip.ParseCIDRList(strings.NewReader(bad)); err == nil {
+			t.Errorf("%q should not be parsed", bad)
+		}
+	}
+}
+
+func TestParseAPNIC(t *testing.T) {
+	src := `2|apnic|20200101|1|19830613|20200101|+1000
+apnic|*|ipv4|*|1|summary
+apnic|CN|ipv4|1.0.1.0|256|20110414|allocated
+apnic|JP|ipv4|1.0.16.0|4096|20110412|allocated
+apnic|CN|ipv6|2001:250::|35|20000426|allocated
+apnic|CN|ipv4|1.0.2.0|768|20110414|allocated
+`
+	got, err := chinaip.ParseAPNIC(strings.NewReader(src), "CN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chinaip.Range{{16777472, 16777727}, {16777728, 16778495}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := chinaip.ParseAPNIC(strings.NewReader("apnic|CN|ipv4|255.255.255.0|512|0|allocated\n"), "CN"); err == nil {
+		t.Errorf("overflowing range should not be parsed")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	got := chinaip.Merge([]chinaip.Range{{20, 30}, {0, 9}, {10, 12}, {25, 40}, {42, 50}, {43, 44}})
+	want := []chinaip.Range{{0, 12}, {20, 40}, {42, 50}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	a := []chinaip.Range{{0, 10}, {20, 30}, {40, 50}}
+	b := []chinaip.Range{{5, 6}, {10, 22}, {25, 25}, {45, 60}}
+	want := []chinaip.Range{{0, 4}, {7, 9}, {23, 24}, {26, 30}, {40, 44}}
+	if got := chinaip.Subtract(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	want = []chinaip.Range{{1, 9}, {11, 0xffffffff}}
+	if got := chinaip.Complement([]chinaip.Range{{0, 0}, {10, 10}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := chinaip.Complement([]chinaip.Range{{0, 0xffffffff}}); len(got) != 0 {
+		t.Errorf("got %v, want nothing", got)
+	}
+}
+
+func TestRangePrefixes(t *testing.T) {
+	tests := []struct {
+		rg   chinaip.Range
+		want string
+	}{
+		{chinaip.Range{0, 0xffffffff}, "[0.0.0.0/0]"},
+		{chinaip.Range{16777472, 16777727}, "[1.0.1.0/24]"},
+		// 1.0.1.255 - 1.0.3.0
+		{chinaip.Range{16777727, 16777984}, "[1.0.1.255/32 1.0.2.0/24 1.0.3.0/32]"},
+		{chinaip.Range{0xffffffff, 0xffffffff}, "[255.255.255.255/32]"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.rg.Prefixes()); got != tt.want {
+			t.Errorf("%v: got %s, want %s", tt.rg, got, tt.want)
+		}
+	}
+}
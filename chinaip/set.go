@@ -0,0 +1,92 @@
+package chinaip
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// Set is an immutable set of IPv4 addresses.
+//
+// The ranges are kept in a single sorted flat array of start and end pairs,
+// so that a lookup is a binary search without any allocation.
+type Set struct {
+	ranges []uint32
+}
+
+// NewSet creates a set containing all of the `ranges`.
+func NewSet(ranges []Range) *Set {
+	merged := Merge(append([]Range(nil), ranges...))
+	flat := make([]uint32, 0, 2*len(merged))
+	for _, rg := range merged {
+		flat = append(flat, rg.Start, rg.End)
+	}
+	return &Set{ranges: flat}
+}
+
+// Len returns the number of merged ranges in the set.
+func (s *Set) Len() int {
+	return len(s.ranges) / 2
+}
+
+// Ranges returns a copy of the merged ranges in the set.
+func (s *Set) Ranges() []Range {
+	ranges := make([]Range, 0, s.Len())
+	for i := 0; i < len(s.ranges); i += 2 {
+		ranges = append(ranges, Range{s.ranges[i], s.ranges[i+1]})
+	}
+	return ranges
+}
+
+// Contains returns whether `addr` is in the set.
+// IPv4-mapped IPv6 addresses are treated as IPv4 addresses.
+func (s *Set) Contains(addr netip.Addr) bool {
+	_, ok := s.find(addr)
+	return ok
+}
+
+// Lookup returns the largest prefix in the set which contains `addr`.
+func (s *Set) Lookup(addr netip.Addr) (netip.Prefix, bool) {
+	i, ok := s.find(addr)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+	ip := addr2Int(addr.Unmap())
+	start, end := s.ranges[2*i], s.ranges[2*i+1]
+
+	// the shortest prefix of `ip` that fits in [start, end]
+	for length := 0; length < 32; length++ {
+		hostMask := uint32(1)<<uint(32-length) - 1
+		if ip&^hostMask >= start && ip|hostMask <= end {
+			return netip.PrefixFrom(int2Addr(ip&^hostMask), length), true
+		}
+	}
+	return netip.PrefixFrom(int2Addr(ip), 32), true
+}
+
+// find returns the index of the range containing `addr`.
+func (s *Set) find(addr netip.Addr) (int, bool) {
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return 0, false
+	}
+	ip := addr2Int(addr)
+
+	// the first range ending at or after ip
+	n := s.Len()
+	i := sort.Search(n, func(i int) bool {
+		return s.ranges[2*i+1] >= ip
+	})
+	if i < n && s.ranges[2*i] <= ip {
+		return i, true
+	}
+	return 0, false
+}
+
+func addr2Int(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func int2Addr(i uint32) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(i >> 24), byte(i >> 16), byte(i >> 8), byte(i)})
+}
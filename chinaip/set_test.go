@@ -0,0 +1,123 @@
+package chinaip
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet([]Range{{16777472, 16777727}, {16777728, 16778239}, {3758095360, 3758095871}})
+	if s.Len() != 2 {
+		t.Errorf("ranges should be merged, got %v", s.Ranges())
+	}
+
+	tests := []struct {
+		addr   string
+		prefix string
+	}{
+		{"1.0.0.255", ""},
+		{"1.0.1.0", "1.0.1.0/24"},
+		{"1.0.2.7", "1.0.2.0/23"},
+		{"1.0.3.255", "1.0.2.0/23"},
+		{"1.0.4.0", ""},
+		{"::ffff:1.0.1.1", "1.0.1.0/24"},
+		{"223.255.253.1", "223.255.252.0/23"},
+		{"255.255.255.255", ""},
+		{"2001:db8::1", ""},
+	}
+	for _, tt := range tests {
+		addr := netip.MustParseAddr(tt.addr)
+		prefix, ok := s.Lookup(addr)
+		if ok != (tt.prefix != "") || ok != s.Contains(addr) {
+			t.Errorf("%s: got %v, want %v", tt.addr, ok, tt.prefix != "")
+			continue
+		}
+		if ok && prefix.String() != tt.prefix {
+			t.Errorf("%s: got prefix %s, want %s", tt.addr, prefix, tt.prefix)
+		}
+	}
+}
+
+func TestContainsAllocs(t *testing.T) {
+	addr := netip.MustParseAddr("114.114.114.114")
+	allocs := testing.AllocsPerRun(100, func() {
+		Contains(addr)
+		Lookup(addr)
+	})
+	if allocs != 0 {
+		t.Errorf("Contains and Lookup should not allocate, got %v allocs", allocs)
+	}
+}
+
+// legacyTable returns the database in the [][]uint32 format before Set.
+func legacyTable() [][]uint32 {
+	var table [][]uint32
+	for _, r := range Current().Ranges() {
+		table = append(table, []uint32{r.Start, r.End})
+	}
+	return table
+}
+
+// legacyIsChinaIP is the string based implementation before Set,
+// kept for comparison in benchmarks.
+func legacyIsChinaIP(chinaIPs [][]uint32, ip string) bool {
+	var i, err = IP2Int(ip)
+	if err != nil {
+		return false
+	}
+	var l = 0
+	var r = len(chinaIPs) - 1
+	for l <= r {
+		var mid = int((l + r) / 2)
+		if i < chinaIPs[mid][0] {
+			r = mid - 1
+		} else if i > chinaIPs[mid][1] {
+			l = mid + 1
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+var benchIPs = []string{"114.114.114.114", "8.8.8.8", "220.181.57.216", "172.217.14.78"}
+
+func BenchmarkLegacyIsChinaIP(b *testing.B) {
+	table := legacyTable()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		legacyIsChinaIP(table, benchIPs[i%len(benchIPs)])
+	}
+}
+
+func BenchmarkIsChinaIP(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		IsChinaIP(benchIPs[i%len(benchIPs)])
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	addrs := make([]netip.Addr, len(benchIPs))
+	for i, ip := range benchIPs {
+		addrs[i] = netip.MustParseAddr(ip)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Contains(addrs[i%len(addrs)])
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	addrs := make([]netip.Addr, len(benchIPs))
+	for i, ip := range benchIPs {
+		addrs[i] = netip.MustParseAddr(ip)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Lookup(addrs[i%len(addrs)])
+	}
+}
@@ -0,0 +1,180 @@
+package chinaip
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Updater downloads an IP list, verifies it, persists it and replaces the
+// China IP database in use. The database is kept if any step fails.
+type Updater struct {
+	// URL of the list, in the format of ParseList.
+	URL     string
+	Format  string
+	Country string
+
+	// File persists the last verified list, so it survives restarts. Optional.
+	File string
+
+	// ChecksumURL is an optional sha256sum style file of the list.
+	ChecksumURL string
+	// SignatureURL is an optional detached ed25519 signature of the list,
+	// in raw or base64 format, verified with PublicKey.
+	SignatureURL string
+	PublicKey    ed25519.PublicKey
+
+	// MinRanges is the least number of merged ranges of a sane list, 1000 by default.
+	MinRanges int
+	// MaxSize is the maximum size of the list in bytes, 16 MiB by default.
+	MaxSize int64
+
+	Client *http.Client
+}
+
+// LoadFile loads the persisted list and replaces the database in use.
+func (u *Updater) LoadFile() (*Set, error) {
+	data, err := ioutil.ReadFile(u.File)
+	if err != nil {
+		return nil, err
+	}
+	s, err := u.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", u.File, err)
+	}
+	Replace(s)
+	return s, nil
+}
+
+// Update downloads and verifies the list. If it is valid, it is persisted
+// to File and replaces the database in use.
+func (u *Updater) Update() (*Set, error) {
+	data, err := u.fetch(u.URL)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.verify(data); err != nil {
+		return nil, err
+	}
+	s, err := u.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", u.URL, err)
+	}
+
+	if u.File != "" {
+		if err := writeFileAtomic(u.File, data); err != nil {
+			return nil, err
+		}
+	}
+	Replace(s)
+	return s, nil
+}
+
+func (u *Updater) verify(data []byte) error {
+	if u.ChecksumURL != "" {
+		sum, err := u.fetch(u.ChecksumURL)
+		if err != nil {
+			return err
+		}
+		fields := strings.Fields(string(sum))
+		actual := sha256.Sum256(data)
+		if len(fields) == 0 || !strings.EqualFold(fields[0], hex.EncodeToString(actual[:])) {
+			return Error("checksum mismatch of " + u.URL)
+		}
+	}
+
+	if u.PublicKey != nil {
+		if u.SignatureURL == "" {
+			return Error("no signature url for " + u.URL)
+		}
+		sig, err := u.fetch(u.SignatureURL)
+		if err != nil {
+			return err
+		}
+		if len(sig) != ed25519.SignatureSize {
+			sig, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+			if err != nil {
+				return Error("malformed signature of " + u.URL)
+			}
+		}
+		if !ed25519.Verify(u.PublicKey, data, sig) {
+			return Error("bad signature of " + u.URL)
+		}
+	}
+	return nil
+}
+
+func (u *Updater) parse(data []byte) (*Set, error) {
+	ranges, err := ParseList(bytes.NewReader(data), u.Format, u.Country)
+	if err != nil {
+		return nil, err
+	}
+	s := NewSet(ranges)
+
+	minRanges := u.MinRanges
+	if minRanges == 0 {
+		minRanges = 1000
+	}
+	if s.Len() < minRanges {
+		return nil, fmt.Errorf("only %d ranges, expect at least %d", s.Len(), minRanges)
+	}
+	return s, nil
+}
+
+func (u *Updater) fetch(url string) ([]byte, error) {
+	client := u.Client
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	maxSize := u.MaxSize
+	if maxSize == 0 {
+		maxSize = 16 << 20
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s status code is %d", url, resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", url, maxSize)
+	}
+	return data, nil
+}
+
+// writeFileAtomic writes the file by renaming a temporary file, so that
+// readers never see a partially written list.
+func writeFileAtomic(filename string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filename)
+}
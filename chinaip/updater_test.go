@@ -0,0 +1,100 @@
+package chinaip_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestUpdater(t *testing.T) {
+	defer chinaip.Replace(chinaip.Current())
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	publish := func(list string) {
+		sum := sha256.Sum256([]byte(list))
+		files["/list.txt"] = list
+		files["/list.txt.sha256"] = hex.EncodeToString(sum[:]) + "  list.txt\n"
+		files["/list.txt.sig"] = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(list)))
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "test_updater")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	u := &chinaip.Updater{
+		URL:          ts.URL + "/list.txt",
+		File:         filepath.Join(dir, "list.txt"),
+		ChecksumURL:  ts.URL + "/list.txt.sha256",
+		SignatureURL: ts.URL + "/list.txt.sig",
+		PublicKey:    pub,
+		MinRanges:    2,
+	}
+	isCN := func(ip string) bool {
+		return chinaip.Contains(netip.MustParseAddr(ip))
+	}
+
+	// a valid list replaces the builtin database
+	publish("8.8.8.0/24\n9.9.9.0/24\n")
+	if _, err := u.Update(); err != nil {
+		t.Fatal(err)
+	}
+	if !isCN("8.8.8.8") || isCN("114.114.114.114") {
+		t.Errorf("database is not replaced")
+	}
+
+	// invalid lists keep the previous version
+	invalid := map[string]func(){
+		"too small":    func() { publish("1.0.1.0/24\n") },
+		"malformed":    func() { publish("1.0.1.0/24\n1.0\n") },
+		"bad checksum": func() { publish("1.0.1.0/24\n1.0.2.0/24\n"); files["/list.txt.sha256"] = "00" },
+		"bad signature": func() {
+			publish("1.0.1.0/24\n1.0.2.0/24\n")
+			files["/list.txt.sig"] = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte("other")))
+		},
+		"not found": func() { delete(files, "/list.txt") },
+	}
+	for name, prepare := range invalid {
+		prepare()
+		if _, err := u.Update(); err == nil {
+			t.Errorf("%s: list should be rejected", name)
+		}
+		if !isCN("8.8.8.8") {
+			t.Errorf("%s: previous database should be kept", name)
+		}
+	}
+
+	// the verified list is persisted
+	chinaip.Replace(chinaip.NewSet(nil))
+	if _, err := u.LoadFile(); err != nil {
+		t.Fatal(err)
+	}
+	if !isCN("9.9.9.9") {
+		t.Errorf("persisted list is not loaded")
+	}
+}
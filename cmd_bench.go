@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+// benchMain replays queries against a freedns instance at a target rate,
+// and reports the throughput, latency percentiles, rcodes and cache hit ratio.
+func benchMain(args []string) {
+	var (
+		server      string
+		network     string
+		queryFile   string
+		qps         int
+		concurrency int
+		duration    time.Duration
+		timeout     time.Duration
+		insecure    bool
+		metricsURL  string
+		fake        bool
+		fakeDelay   time.Duration
+	)
+
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	fs.StringVar(&server, "s", "127.0.0.1:53", "The server to benchmark, ip:port, or the url for https.")
+	fs.StringVar(&network, "net", "udp", "The protocol: udp/tcp/tcp-tls (DNS over TLS)/https (DNS over HTTPS).")
+	fs.StringVar(&queryFile, "queries", "", "The domain list or query log to replay.")
+	fs.IntVar(&qps, "qps", 1000, "The target queries per second, 0 for unlimited.")
+	fs.IntVar(&concurrency, "concurrency", 100, "The maximum outstanding queries.")
+	fs.DurationVar(&duration, "d", 10*time.Second, "The duration of the benchmark.")
+	fs.DurationVar(&timeout, "timeout", 2*time.Second, "The timeout of each query.")
+	fs.BoolVar(&insecure, "insecure", false, "Skip verifying the TLS certificate of the server.")
+	fs.StringVar(&metricsURL, "metrics", "", "The metrics url of the freedns instance, to report the cache hit ratio.")
+	fs.BoolVar(&fake, "fake-upstream", false, "Benchmark an in-process freedns with local fake upstreams instead of -s.")
+	fs.DurationVar(&fakeDelay, "fake-delay", 10*time.Millisecond, "The latency of the fake upstreams.")
+	fs.Parse(args)
+
+	queries, err := loadBenchQueries(queryFile, fake)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var stats func() freedns.Stats
+	if fake {
+		if network != "udp" && network != "tcp" {
+			log.Fatalln("only udp and tcp are supported with -fake-upstream")
+		}
+		s, addr, err := startFakeFreedns(fakeDelay)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		defer s.Shutdown()
+		server = addr
+		stats = s.Stats
+	} else if metricsURL != "" {
+		stats = func() freedns.Stats {
+			st, err := scrapeStats(metricsURL)
+			if err != nil {
+				log.Fatalln(err)
+			}
+			return st
+		}
+	}
+
+	var before freedns.Stats
+	if stats != nil {
+		before = stats()
+	}
+
+	r := runBench(queries, benchOptions{
+		server:      server,
+		network:     network,
+		qps:         qps,
+		concurrency: concurrency,
+		duration:    duration,
+		timeout:     timeout,
+		insecure:    insecure,
+	})
+	r.print()
+
+	if stats != nil {
+		after := stats()
+		queries := after.Queries - before.Queries
+		hits := after.CacheHits - before.CacheHits
+		if queries > 0 {
+			fmt.Printf("cache      hit ratio %.1f%% (%d/%d)\n", 100*float64(hits)/float64(queries), hits, queries)
+		}
+	}
+}
+
+func loadBenchQueries(filename string, fake bool) ([]dns.Question, error) {
+	if filename == "" {
+		if !fake {
+			return nil, fmt.Errorf("-queries is required")
+		}
+		// synthetic names for the fake upstreams
+		var queries []dns.Question
+		for i := 0; i < 1000; i++ {
+			queries = append(queries, dns.Question{Name: fmt.Sprintf("bench-%d.example.", i), Qtype: dns.TypeA, Qclass: dns.ClassINET})
+		}
+		return queries, nil
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entries, err := freedns.ReadQueryLog(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var queries []dns.Question
+	for _, e := range entries {
+		qtype, ok := freedns.ParseQueryType(e.Type)
+		if !ok {
+			return nil, fmt.Errorf("unknown query type %q of %s", e.Type, e.Name)
+		}
+		queries = append(queries, dns.Question{Name: dns.Fqdn(e.Name), Qtype: qtype, Qclass: dns.ClassINET})
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no queries in %s", filename)
+	}
+	return queries, nil
+}
+
+type benchOptions struct {
+	server      string
+	network     string
+	qps         int
+	concurrency int
+	duration    time.Duration
+	timeout     time.Duration
+	insecure    bool
+}
+
+type benchResult struct {
+	elapsed   time.Duration
+	qps       int
+	sent      int
+	errors    int
+	latencies []time.Duration
+	rcodes    map[int]int
+}
+
+// benchJob is a query, and when it is scheduled to be sent at the target
+// rate.
+type benchJob struct {
+	q         dns.Question
+	scheduled time.Time
+}
+
+// runBench sends the queries in turn at the target rate until the
+// duration elapses. The latencies are measured from the times the queries
+// are scheduled at, not the ones they are sent at, so that a server falling
+// behind isn't given less load and better latencies: all the queries
+// scheduled are sent, even after the duration, and the throughput achieved
+// tells.
+func runBench(queries []dns.Question, opts benchOptions) *benchResult {
+	jobs := make(chan benchJob, opts.concurrency)
+	results := make(chan *benchResult, opts.concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := &benchResult{rcodes: make(map[int]int)}
+			ex := newExchanger(opts)
+			defer ex.close()
+			for job := range jobs {
+				req := &dns.Msg{}
+				req.SetQuestion(job.q.Name, job.q.Qtype)
+				// unlimited, the queries are sent as soon as possible
+				start := job.scheduled
+				if start.IsZero() {
+					start = time.Now()
+				}
+				res, err := ex.exchange(req)
+				r.sent++
+				if err != nil {
+					r.errors++
+					continue
+				}
+				r.latencies = append(r.latencies, time.Since(start))
+				r.rcodes[res.Rcode]++
+			}
+			results <- r
+		}()
+	}
+
+	start := time.Now()
+	if opts.qps > 0 {
+		n := int(int64(opts.duration) * int64(opts.qps) / int64(time.Second))
+		for i := 0; i < n; i++ {
+			scheduled := start.Add(time.Duration(i) * time.Second / time.Duration(opts.qps))
+			time.Sleep(time.Until(scheduled))
+			jobs <- benchJob{q: queries[i%len(queries)], scheduled: scheduled}
+		}
+	} else {
+		for i := 0; time.Since(start) < opts.duration; i++ {
+			jobs <- benchJob{q: queries[i%len(queries)]}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	total := &benchResult{elapsed: time.Since(start), qps: opts.qps, rcodes: make(map[int]int)}
+	for r := range results {
+		total.sent += r.sent
+		total.errors += r.errors
+		total.latencies = append(total.latencies, r.latencies...)
+		for rcode, n := range r.rcodes {
+			total.rcodes[rcode] += n
+		}
+	}
+	return total
+}
+
+// throughput returns the queries answered per second.
+func (r *benchResult) throughput() float64 {
+	return float64(len(r.latencies)) / r.elapsed.Seconds()
+}
+
+func (r *benchResult) print() {
+	fmt.Printf("queries    %d sent, %d answered, %d errors\n", r.sent, len(r.latencies), r.errors)
+	if r.qps > 0 {
+		fmt.Printf("duration   %v, throughput %.1f qps of the target %d qps\n", r.elapsed.Round(time.Millisecond), r.throughput(), r.qps)
+	} else {
+		fmt.Printf("duration   %v, throughput %.1f qps\n", r.elapsed.Round(time.Millisecond), r.throughput())
+	}
+
+	if len(r.latencies) > 0 {
+		sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+		percentile := func(p float64) time.Duration {
+			return r.latencies[int(p*float64(len(r.latencies)-1))].Round(10 * time.Microsecond)
+		}
+		fmt.Printf("latency    p50 %v, p90 %v, p99 %v, max %v\n", percentile(0.5), percentile(0.9), percentile(0.99), percentile(1))
+	}
+
+	var rcodes []string
+	for rcode, n := range r.rcodes {
+		rcodes = append(rcodes, fmt.Sprintf("%s %d", dns.RcodeToString[rcode], n))
+	}
+	sort.Strings(rcodes)
+	fmt.Printf("rcodes     %s\n", strings.Join(rcodes, ", "))
+}
+
+// exchanger sends queries with one of the protocols.
+type exchanger interface {
+	exchange(req *dns.Msg) (*dns.Msg, error)
+	close()
+}
+
+func newExchanger(opts benchOptions) exchanger {
+	tlsConfig := &tls.Config{InsecureSkipVerify: opts.insecure}
+	switch opts.network {
+	case "https":
+		return &dohExchanger{
+			url: opts.server,
+			client: &http.Client{
+				Timeout:   opts.timeout,
+				Transport: &http.Transport{TLSClientConfig: tlsConfig, MaxIdleConnsPerHost: 1},
+			},
+		}
+	case "tcp", "tcp-tls":
+		return &connExchanger{
+			server: opts.server,
+			client: &dns.Client{Net: opts.network, Timeout: opts.timeout, TLSConfig: tlsConfig},
+		}
+	default:
+		return &udpExchanger{
+			server: opts.server,
+			client: &dns.Client{Net: "udp", Timeout: opts.timeout},
+		}
+	}
+}
+
+type udpExchanger struct {
+	server string
+	client *dns.Client
+}
+
+func (e *udpExchanger) exchange(req *dns.Msg) (*dns.Msg, error) {
+	res, _, err := e.client.Exchange(req, e.server)
+	return res, err
+}
+
+func (e *udpExchanger) close() {}
+
+// connExchanger reuses the connection for tcp and tcp-tls, like real clients.
+type connExchanger struct {
+	server string
+	client *dns.Client
+	conn   *dns.Conn
+}
+
+func (e *connExchanger) exchange(req *dns.Msg) (*dns.Msg, error) {
+	if e.conn == nil {
+		conn, err := e.client.Dial(e.server)
+		if err != nil {
+			return nil, err
+		}
+		e.conn = conn
+	}
+
+	e.conn.SetDeadline(time.Now().Add(e.client.Timeout))
+	if err := e.conn.WriteMsg(req); err != nil {
+		e.close()
+		return nil, err
+	}
+	res, err := e.conn.ReadMsg()
+	if err != nil {
+		e.close()
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *connExchanger) close() {
+	if e.conn != nil {
+		e.conn.Close()
+		e.conn = nil
+	}
+}
+
+// dohExchanger posts the queries as RFC 8484 DNS over HTTPS.
+type dohExchanger struct {
+	url    string
+	client *http.Client
+}
+
+func (e *dohExchanger) exchange(req *dns.Msg) (*dns.Msg, error) {
+	req.Id = 0
+	data, err := req.Pack()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.client.Post(e.url, "application/dns-message", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s status code is %d", e.url, resp.StatusCode)
+	}
+
+	res := &dns.Msg{}
+	if err := res.Unpack(body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *dohExchanger) close() {
+	e.client.CloseIdleConnections()
+}
+
+// scrapeStats reads the counters from the metrics of a freedns instance.
+func scrapeStats(url string) (freedns.Stats, error) {
+	var stats freedns.Stats
+	resp, err := http.Get(url)
+	if err != nil {
+		return stats, err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, _ := strconv.ParseUint(fields[1], 10, 64)
+		switch fields[0] {
+		case "freedns_queries_total":
+			stats.Queries = v
+		case "freedns_cache_hits_total":
+			stats.CacheHits = v
+		}
+	}
+	return stats, scanner.Err()
+}
+
+// startFakeFreedns starts an in-process freedns whose upstreams are local
+// fake servers answering every query after `delay`, for reproducible numbers.
+func startFakeFreedns(delay time.Duration) (*freedns.Server, string, error) {
+	fast, err := startFakeUpstream("114.114.114.114", delay)
+	if err != nil {
+		return nil, "", err
+	}
+	clean, err := startFakeUpstream("8.8.8.8", delay)
+	if err != nil {
+		return nil, "", err
+	}
+
+	addr, err := freeLocalAddr()
+	if err != nil {
+		return nil, "", err
+	}
+	s, err := freedns.NewServer(freedns.Config{
+		FastUpstream:  fast,
+		CleanUpstream: clean,
+		Listen:        addr,
+		CacheCap:      1024 * 10,
+		LogLevel:      "error",
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	go s.Run()
+
+	// wait for the server to be up
+	c := &dns.Client{Timeout: 100 * time.Millisecond}
+	req := &dns.Msg{}
+	req.SetQuestion("warmup.example.", dns.TypeA)
+	for i := 0; i < 50; i++ {
+		if _, _, err = c.Exchange(req, addr); err == nil {
+			return s, addr, nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return nil, "", err
+}
+
+// startFakeUpstream answers every A query with `ip` after `delay`.
+func startFakeUpstream(ip string, delay time.Duration) (string, error) {
+	u, err := freednstest.NewUpstream()
+	if err != nil {
+		return "", err
+	}
+	u.HandleFunc(func(q dns.Question) freednstest.Answer {
+		a := freednstest.Answer{Delay: delay}
+		if q.Qtype == dns.TypeA {
+			a.Answer = freednstest.IPs(q.Name, ip).Answer
+		}
+		return a
+	})
+	return u.Addr, nil
+}
+
+// freeLocalAddr returns a local address whose udp and tcp ports are free.
+func freeLocalAddr() (string, error) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer pc.Close()
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	return pc.LocalAddr().String(), nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestLoadBenchQueries(t *testing.T) {
+	if _, err := loadBenchQueries("", false); err == nil {
+		t.Error("-queries should be required without -fake-upstream")
+	}
+	queries, err := loadBenchQueries("", true)
+	if err != nil || len(queries) != 1000 || queries[0].Name != "bench-0.example." {
+		t.Errorf("got %d synthetic queries: %v", len(queries), err)
+	}
+
+	dir := t.TempDir()
+	domains := filepath.Join(dir, "domains.txt")
+	if err := ioutil.WriteFile(domains, []byte("example.com\nexample.org AAAA\nexample.net HTTPS\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	queries, err = loadBenchQueries(domains, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []dns.Question{
+		{Name: "example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		{Name: "example.org.", Qtype: dns.TypeAAAA, Qclass: dns.ClassINET},
+		{Name: "example.net.", Qtype: 65, Qclass: dns.ClassINET},
+	}
+	if len(queries) != len(want) {
+		t.Fatalf("got %v", queries)
+	}
+	for i := range want {
+		if queries[i] != want[i] {
+			t.Errorf("got %v, want %v", queries[i], want[i])
+		}
+	}
+
+	invalid := filepath.Join(dir, "invalid.txt")
+	if err := ioutil.WriteFile(invalid, []byte("example.com NOPE\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadBenchQueries(invalid, false); err == nil {
+		t.Error("the unknown type should fail")
+	}
+	if _, err := loadBenchQueries(filepath.Join(dir, "missing.txt"), false); !os.IsNotExist(err) {
+		t.Errorf("got %v for a missing file", err)
+	}
+}
+
+func TestRunBench(t *testing.T) {
+	s, addr, err := startFakeFreedns(50 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Shutdown()
+	queries, _ := loadBenchQueries("", true)
+
+	r := runBench(queries, benchOptions{
+		server:      addr,
+		network:     "udp",
+		qps:         100,
+		concurrency: 10,
+		duration:    200 * time.Millisecond,
+		timeout:     time.Second,
+	})
+	if r.sent != 20 || r.errors != 0 || len(r.latencies) != 20 || r.rcodes[dns.RcodeSuccess] != 20 {
+		t.Errorf("got %d sent, %d errors, %d answered, rcodes %v", r.sent, r.errors, len(r.latencies), r.rcodes)
+	}
+
+	// one at a time, the server falls behind the schedule of a query every
+	// 10ms, and the later queries wait for the earlier ones
+	r = runBench(queries[100:], benchOptions{
+		server:      addr,
+		network:     "udp",
+		qps:         100,
+		concurrency: 1,
+		duration:    200 * time.Millisecond,
+		timeout:     time.Second,
+	})
+	if r.sent != 20 || len(r.latencies) != 20 {
+		t.Fatalf("got %d sent, %d answered", r.sent, len(r.latencies))
+	}
+	var max time.Duration
+	for _, l := range r.latencies {
+		if l > max {
+			max = l
+		}
+	}
+	if max < 500*time.Millisecond {
+		t.Errorf("the latency of the last query should count its wait, got %v", max)
+	}
+	if r.throughput() > 50 {
+		t.Errorf("got %.1f qps, more than one at a time can answer", r.throughput())
+	}
+}
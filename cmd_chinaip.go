@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns"
+)
+
+const defaultChinaIPListURL = "https://raw.githubusercontent.com/17mon/china_ip_list/master/china_ip_list.txt"
+
+func chinaipMain(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "usage: freedns-go chinaip update|lookup|export [flags]")
+		os.Exit(2)
+	}
+
+	switch args[0] {
+	case "update":
+		chinaipUpdate(args[1:])
+	case "lookup":
+		chinaipLookup(args[1:])
+	case "export":
+		chinaipExport(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown chinaip command %q\n", args[0])
+		os.Exit(2)
+	}
+}
+
+// chinaipUpdate regenerates the embedded database from a CIDR list or an
+// APNIC delegated statistics file.
+func chinaipUpdate(args []string) {
+	var (
+		src     string
+		format  string
+		country string
+		output  string
+	)
+
+	fs := flag.NewFlagSet("chinaip update", flag.ExitOnError)
+	fs.StringVar(&src, "src", defaultChinaIPListURL, "The source list, http(s) url or local file.")
+	fs.StringVar(&format, "format", "cidr", "The source format: cidr/apnic.")
+	fs.StringVar(&country, "country", "CN", "The country code to extract from apnic source.")
+	fs.StringVar(&output, "o", "db.bin", "The generated database file.")
+	fs.Parse(args)
+
+	data, err := readSource(src)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	ranges, err := chinaip.ParseList(bytes.NewReader(data), format, country)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if len(ranges) == 0 {
+		log.Fatalln("no ranges found in", src)
+	}
+	set := chinaip.NewSet(ranges)
+
+	data, err = set.MarshalBinary()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile(output, data, 0644); err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("wrote %d ranges to %s", set.Len(), output)
+}
+
+// chinaipLookup prints the effective classification of IPs with the same
+// flags as the server.
+func chinaipLookup(args []string) {
+	fs := flag.NewFlagSet("chinaip lookup", flag.ExitOnError)
+	config := configFlags(fs)
+	fs.Parse(args)
+
+	local, err := freedns.NewLocalIPClassifier(config())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for _, ip := range fs.Args() {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		m := local.Explain(addr)
+		rule := "database"
+		if m.Rule != nil {
+			rule = m.Rule.String()
+		}
+		fmt.Printf("%s\tlocal=%v\t%s\n", addr, m.Local, rule)
+	}
+}
+
+// reservedRanges are never routed through the tunnel by the wg format.
+var reservedRanges = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+}
+
+// chinaipExport writes the local IPv4 ranges, with the overlays of the same
+// flags as the server, as routes or sets for split tunneling.
+func chinaipExport(args []string) {
+	var (
+		format string
+		output string
+		name   string
+		via    string
+		dev    string
+		table  string
+	)
+
+	fs := flag.NewFlagSet("chinaip export", flag.ExitOnError)
+	config := configFlags(fs)
+	fs.StringVar(&format, "format", "cidr", "The output format: cidr/iproute/nft/routeros/wg.")
+	fs.StringVar(&output, "o", "-", "The output file, - for stdout.")
+	fs.StringVar(&name, "name", "", "The nftables set as family/table/set (inet/freedns/chinaip by default), or the RouterOS address list (chinaip by default).")
+	fs.StringVar(&via, "via", "", "The gateway of the iproute routes.")
+	fs.StringVar(&dev, "dev", "", "The device of the iproute routes.")
+	fs.StringVar(&table, "table", "", "The routing table of the iproute routes.")
+	fs.Parse(args)
+
+	local, err := freedns.NewLocalIPClassifier(config())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	ranges, err := local.Ranges()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var b bytes.Buffer
+	n, err := writeExport(&b, ranges, exportOptions{
+		format: format,
+		name:   name,
+		via:    via,
+		dev:    dev,
+		table:  table,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if output == "-" {
+		os.Stdout.Write(b.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(output, b.Bytes(), 0644); err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("wrote %d prefixes to %s", n, output)
+}
+
+type exportOptions struct {
+	format string
+	name   string
+	via    string
+	dev    string
+	table  string
+}
+
+// writeExport writes `ranges` to `w` in the format of `opts`, and returns the
+// number of prefixes written, which are the ones out of `ranges` for wg.
+func writeExport(w io.Writer, ranges []chinaip.Range, opts exportOptions) (int, error) {
+	var prefixes []netip.Prefix
+	for _, rg := range ranges {
+		prefixes = append(prefixes, rg.Prefixes()...)
+	}
+
+	b := bufio.NewWriter(w)
+	switch opts.format {
+	case "cidr":
+		for _, p := range prefixes {
+			fmt.Fprintln(b, p)
+		}
+	case "iproute":
+		if opts.via == "" && opts.dev == "" {
+			return 0, errors.New("-via or -dev is required by the iproute format")
+		}
+		var suffix string
+		if opts.via != "" {
+			suffix += " via " + opts.via
+		}
+		if opts.dev != "" {
+			suffix += " dev " + opts.dev
+		}
+		if opts.table != "" {
+			suffix += " table " + opts.table
+		}
+		// for `ip -batch`
+		for _, p := range prefixes {
+			fmt.Fprintf(b, "route replace %s%s\n", p, suffix)
+		}
+	case "nft":
+		name := opts.name
+		if name == "" {
+			name = "inet/freedns/chinaip"
+		}
+		parts := strings.Split(name, "/")
+		if len(parts) != 3 {
+			return 0, fmt.Errorf("invalid nftables set %q", name)
+		}
+		// for `nft -f`, creating the set if needed and replacing the elements
+		fmt.Fprintf(b, "table %s %s {\n\tset %s {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t}\n}\n", parts[0], parts[1], parts[2])
+		fmt.Fprintf(b, "flush set %s %s %s\n", parts[0], parts[1], parts[2])
+		fmt.Fprintf(b, "add element %s %s %s {\n", parts[0], parts[1], parts[2])
+		for _, p := range prefixes {
+			fmt.Fprintf(b, "\t%s,\n", p)
+		}
+		fmt.Fprintln(b, "}")
+	case "routeros":
+		name := opts.name
+		if name == "" {
+			name = "chinaip"
+		}
+		fmt.Fprintf(b, "/ip firewall address-list\nremove [find list=%s]\n", name)
+		for _, p := range prefixes {
+			fmt.Fprintf(b, "add list=%s address=%s\n", name, p)
+		}
+	case "wg":
+		// route everything else through the tunnel, including IPv6 which
+		// is never local
+		var reserved []chinaip.Range
+		for _, cidr := range reservedRanges {
+			rg, _ := chinaip.ParseCIDR(cidr)
+			reserved = append(reserved, rg)
+		}
+		allowed := chinaip.Subtract(chinaip.Complement(ranges), chinaip.Merge(reserved))
+		var list []string
+		for _, rg := range allowed {
+			for _, p := range rg.Prefixes() {
+				list = append(list, p.String())
+			}
+		}
+		list = append(list, "::/0")
+		fmt.Fprintf(b, "AllowedIPs = %s\n", strings.Join(list, ", "))
+		return len(list), b.Flush()
+	default:
+		return 0, fmt.Errorf("unknown format %q", opts.format)
+	}
+	return len(prefixes), b.Flush()
+}
+
+func readSource(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return ioutil.ReadFile(src)
+	}
+
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s status code is %d", src, resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
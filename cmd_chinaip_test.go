@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestWriteExport(t *testing.T) {
+	// 1.0.1.0/24 and 1.0.2.0/23
+	ranges := []chinaip.Range{{Start: 0x01000100, End: 0x010003ff}}
+	// everything but 200.0.0.0/8
+	allButOne := []chinaip.Range{{Start: 0, End: 200<<24 - 1}, {Start: 201 << 24, End: math.MaxUint32}}
+
+	tests := []struct {
+		name   string
+		ranges []chinaip.Range
+		opts   exportOptions
+		want   string
+		n      int
+	}{
+		{
+			name:   "cidr",
+			ranges: ranges,
+			opts:   exportOptions{format: "cidr"},
+			want:   "1.0.1.0/24\n1.0.2.0/23\n",
+			n:      2,
+		},
+		{
+			name:   "iproute via",
+			ranges: ranges,
+			opts:   exportOptions{format: "iproute", via: "192.168.1.1"},
+			want:   "route replace 1.0.1.0/24 via 192.168.1.1\nroute replace 1.0.2.0/23 via 192.168.1.1\n",
+			n:      2,
+		},
+		{
+			name:   "iproute dev table",
+			ranges: ranges,
+			opts:   exportOptions{format: "iproute", dev: "eth0", table: "100"},
+			want:   "route replace 1.0.1.0/24 dev eth0 table 100\nroute replace 1.0.2.0/23 dev eth0 table 100\n",
+			n:      2,
+		},
+		{
+			name:   "iproute via dev",
+			ranges: ranges[:0],
+			opts:   exportOptions{format: "iproute", via: "192.168.1.1", dev: "eth0"},
+			want:   "",
+			n:      0,
+		},
+		{
+			name:   "nft",
+			ranges: ranges,
+			opts:   exportOptions{format: "nft"},
+			want: "table inet freedns {\n\tset chinaip {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t}\n}\n" +
+				"flush set inet freedns chinaip\n" +
+				"add element inet freedns chinaip {\n\t1.0.1.0/24,\n\t1.0.2.0/23,\n}\n",
+			n: 2,
+		},
+		{
+			name:   "nft name",
+			ranges: ranges[:0],
+			opts:   exportOptions{format: "nft", name: "ip/fw/cn"},
+			want: "table ip fw {\n\tset cn {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t}\n}\n" +
+				"flush set ip fw cn\n" +
+				"add element ip fw cn {\n}\n",
+			n: 0,
+		},
+		{
+			name:   "routeros",
+			ranges: ranges,
+			opts:   exportOptions{format: "routeros"},
+			want:   "/ip firewall address-list\nremove [find list=chinaip]\nadd list=chinaip address=1.0.1.0/24\nadd list=chinaip address=1.0.2.0/23\n",
+			n:      2,
+		},
+		{
+			name:   "routeros name",
+			ranges: ranges,
+			opts:   exportOptions{format: "routeros", name: "cn"},
+			want:   "/ip firewall address-list\nremove [find list=cn]\nadd list=cn address=1.0.1.0/24\nadd list=cn address=1.0.2.0/23\n",
+			n:      2,
+		},
+		{
+			name:   "wg",
+			ranges: allButOne,
+			opts:   exportOptions{format: "wg"},
+			want:   "AllowedIPs = 200.0.0.0/8, ::/0\n",
+			n:      2,
+		},
+		{
+			name:   "wg without the reserved",
+			ranges: []chinaip.Range{{Start: 0, End: 224<<24 - 1}},
+			opts:   exportOptions{format: "wg"},
+			want:   "AllowedIPs = ::/0\n",
+			n:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			n, err := writeExport(&b, tt.ranges, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.want || n != tt.n {
+				t.Errorf("got %d prefixes:\n%s\nwant %d:\n%s", n, b.String(), tt.n, tt.want)
+			}
+		})
+	}
+
+	for _, opts := range []exportOptions{
+		{format: "iproute", table: "100"},
+		{format: "nft", name: "inet/freedns"},
+		{format: "json"},
+	} {
+		if _, err := writeExport(&bytes.Buffer{}, ranges, opts); err == nil {
+			t.Errorf("%+v should fail", opts)
+		}
+	}
+}
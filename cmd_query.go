@@ -0,0 +1,148 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns"
+)
+
+// queryMain resolves a name like dig. By default it runs the full freedns
+// pipeline in-process and prints the decision trace; with -s it queries a
+// running instance, which only tells the answer and the latency.
+func queryMain(args []string) {
+	var (
+		qtype  string
+		net    string
+		server string
+	)
+
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: freedns-go query [flags] name [type]")
+		fs.PrintDefaults()
+	}
+	config := configFlags(fs)
+	fs.StringVar(&qtype, "t", "A", "The query type.")
+	fs.StringVar(&net, "net", "udp", "The network to query upstreams: udp/tcp.")
+	fs.StringVar(&server, "s", "", "Query a running instance at ip:port instead of resolving in-process.")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if fs.NArg() == 2 {
+		qtype = fs.Arg(1)
+	}
+	t, ok := freedns.ParseQueryType(qtype)
+	if !ok {
+		log.Fatalf("unknown query type %q", qtype)
+	}
+	q := dns.Question{
+		Name:   dns.Fqdn(fs.Arg(0)),
+		Qtype:  t,
+		Qclass: dns.ClassINET,
+	}
+
+	if server != "" {
+		queryServer(q, net, server)
+		return
+	}
+
+	cfg := config()
+	if cfg.LogLevel == "" {
+		cfg.LogLevel = "warn"
+	}
+	// diagnose without logging the query, adding the IPs to the sets of
+	// the running instance, or serving the metrics
+	cfg.QueryLog, cfg.IPSets, cfg.HTTPListen = "", nil, ""
+	s, err := freedns.NewServer(cfg)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer s.Shutdown()
+	res, trace := s.Query(q, net)
+	printTrace(s, trace)
+	fmt.Printf("\n;; ANSWER from %s\n%v\n", trace.Upstream, res)
+}
+
+func queryServer(q dns.Question, net string, server string) {
+	req := &dns.Msg{}
+	req.SetQuestion(q.Name, q.Qtype)
+	c := &dns.Client{Net: net}
+	res, rtt, err := c.Exchange(req, server)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Printf(";; SERVER %s, RTT %v\n", server, rtt)
+	fmt.Println(";; the decision trace is only available in-process, run without -s to get it")
+	fmt.Printf("\n%v\n", res)
+}
+
+func printTrace(s *freedns.Server, trace *freedns.Trace) {
+	fmt.Printf(";; QUESTION %s %s\n", trace.Question.Name, freedns.QueryTypeString(trace.Question.Qtype))
+	fmt.Printf(";; CACHE %s\n", trace.Cache)
+	if trace.Cache != "miss" {
+		// answered by the cache, or by a policy telling why
+		fmt.Printf(";; CHOSEN %s", trace.Upstream)
+		if trace.Reason != "" {
+			fmt.Printf(": %s", trace.Reason)
+		}
+		fmt.Println()
+		return
+	}
+	fmt.Printf(";; CLASSIFICATION %s", trace.Classification)
+	if trace.Classification != "unknown" {
+		fmt.Printf(" (%d local, %d foreign answers)", trace.LocalAnswers, trace.ForeignAnswers)
+	}
+	fmt.Println()
+
+	for _, u := range []struct {
+		role  string
+		trace freedns.UpstreamTrace
+	}{{"FAST", trace.Fast}, {"CLEAN", trace.Clean}} {
+		status := "no response"
+		if u.trace.Response != nil {
+			status = dns.RcodeToString[u.trace.Response.Rcode]
+		}
+		if u.trace.Err != nil {
+			status += " (" + u.trace.Err.Error() + ")"
+		}
+		fmt.Printf("\n;; %s %s %s in %v\n", u.role, u.trace.Upstream, status, u.trace.RTT.Round(100*time.Microsecond))
+		if u.trace.Response == nil {
+			continue
+		}
+		for _, rr := range u.trace.Response.Answer {
+			fmt.Printf("%s%s\n", rr, classifyRR(s, rr))
+		}
+	}
+
+	fmt.Printf("\n;; CHOSEN %s: %s\n", trace.Upstream, trace.Reason)
+}
+
+// classifyRR tells if the IP in an A record is local, and why.
+func classifyRR(s *freedns.Server, rr dns.RR) string {
+	a, ok := rr.(*dns.A)
+	if !ok {
+		return ""
+	}
+	addr, ok := netip.AddrFromSlice(a.A.To4())
+	if !ok {
+		return ""
+	}
+	m := s.ExplainIP(addr)
+	class := "\t; foreign"
+	if m.Local {
+		class = "\t; local"
+	}
+	if m.Rule != nil {
+		class += " by " + m.Rule.String()
+	}
+	return class
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns"
+)
+
+// replayMain replays a query log recorded with -query-log-answers with the
+// old and the new configurations, and reports the queries they answer
+// differently, to evaluate a change of the rules or the IP list.
+func replayMain(args []string) {
+	var (
+		logFile   string
+		oldConfig string
+		newConfig string
+	)
+
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.StringVar(&logFile, "log", "", "The query log recorded with -query-log-answers.")
+	fs.StringVar(&oldConfig, "old", "", "The JSON configuration in use, the default one if empty.")
+	fs.StringVar(&newConfig, "new", "", "The JSON configuration to evaluate.")
+	fs.Parse(args)
+	if logFile == "" || newConfig == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(logFile)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	entries, err := freedns.ReadQueryLog(f)
+	f.Close()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	replay := func(configFile string) []freedns.ReplayResult {
+		var cfg freedns.Config
+		if configFile != "" {
+			if err := freedns.LoadConfig(configFile, &cfg); err != nil {
+				log.Fatalln(err)
+			}
+		}
+		results, err := freedns.Replay(cfg, entries)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return results
+	}
+	oldResults := replay(oldConfig)
+	newResults := replay(newConfig)
+
+	// group the changes by the question, and the directions, keeping the
+	// ones blocked or filtered by the policies apart from the ones of the
+	// upstreams
+	type change struct {
+		question string
+		from, to string
+		reason   string
+		answers  string
+		count    int
+	}
+	type changeSet struct {
+		changes    map[string]*change
+		directions map[string]int
+		queries    int
+	}
+	upstreamChanges := &changeSet{changes: make(map[string]*change), directions: make(map[string]int)}
+	blockedChanges := &changeSet{changes: make(map[string]*change), directions: make(map[string]int)}
+	for i := range oldResults {
+		o, n := oldResults[i], newResults[i]
+		before, after := summarizeAnswer(o), summarizeAnswer(n)
+		if o.Upstream == n.Upstream && before == after {
+			continue
+		}
+		set := upstreamChanges
+		if o.Blocked || n.Blocked {
+			set = blockedChanges
+		}
+		set.queries++
+		set.directions[o.Upstream+" -> "+n.Upstream]++
+
+		question := n.Entry.Name + " " + n.Entry.Type
+		c, ok := set.changes[question]
+		if !ok {
+			c = &change{question: question, from: o.Upstream, to: n.Upstream, reason: n.Reason, answers: before + " -> " + after}
+			set.changes[question] = c
+		}
+		c.count++
+	}
+
+	report := func(title string, set *changeSet) {
+		var list []*change
+		for _, c := range set.changes {
+			list = append(list, c)
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].count != list[j].count {
+				return list[i].count > list[j].count
+			}
+			return list[i].question < list[j].question
+		})
+		fmt.Printf("%s: changed %d queries of %d questions", title, set.queries, len(set.changes))
+		for direction, n := range set.directions {
+			fmt.Printf(", %s %d", direction, n)
+		}
+		fmt.Println()
+		for _, c := range list {
+			fmt.Printf("%s\t%dx\t%s -> %s (%s)\t%s\n", c.question, c.count, c.from, c.to, c.reason, c.answers)
+		}
+		fmt.Println()
+	}
+	report("routed or answered differently", upstreamChanges)
+	report("blocked or filtered differently", blockedChanges)
+
+	fmt.Printf("replayed %d of %d queries, the others are answered by the cache or the policies, or have no recorded answers\n", len(oldResults), len(entries))
+}
+
+// summarizeAnswer returns the rcode, the IPs and the SVCB hints in the
+// answer, and its Extended DNS Error.
+func summarizeAnswer(r freedns.ReplayResult) string {
+	var ips []string
+	for _, rr := range r.Answer.Answer {
+		switch rr := rr.(type) {
+		case *dns.A:
+			ips = append(ips, rr.A.String())
+		case *dns.AAAA:
+			ips = append(ips, rr.AAAA.String())
+		}
+	}
+	sort.Strings(ips)
+	var hints []string
+	for _, addr := range r.Hints {
+		hints = append(hints, addr.String())
+	}
+	sort.Strings(hints)
+
+	summary := dns.RcodeToString[r.Answer.Rcode]
+	if r.Answer.Rcode == dns.RcodeSuccess && len(ips) > 0 {
+		summary = strings.Join(ips, ",")
+	}
+	if len(hints) > 0 {
+		summary += " hints " + strings.Join(hints, ",")
+	}
+	if r.EDE >= 0 {
+		summary += " EDE " + strconv.Itoa(r.EDE)
+	}
+	return summary
+}
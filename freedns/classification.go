@@ -0,0 +1,153 @@
+package freedns
+
+import (
+	"sync"
+	"time"
+
+	goc "github.com/louchenyao/golang-cache"
+	"github.com/miekg/dns"
+)
+
+// classificationWindow is the number of recent observations a
+// classification is decided by.
+const classificationWindow = 5
+
+// classification is what the answers tell about whether a domain is local.
+type classification struct {
+	// recent are the last observations, true if local, oldest first
+	recent []bool
+	// decided is the classification by the majority of recent, which is
+	// kept if they are tied
+	decided bool
+	// local and foreign count all the observations
+	local, foreign int
+	// updated is the time of the last observation, and verified of the
+	// last verification
+	updated  time.Time
+	verified time.Time
+}
+
+// add adds an observation, and decides by the majority of the recent ones.
+func (c *classification) add(local bool) {
+	c.recent = append(c.recent, local)
+	if len(c.recent) > classificationWindow {
+		c.recent = c.recent[1:]
+	}
+	if local {
+		c.local++
+	} else {
+		c.foreign++
+	}
+
+	n := 0
+	for _, local := range c.recent {
+		if local {
+			n++
+		}
+	}
+	if n*2 != len(c.recent) {
+		c.decided = n*2 > len(c.recent)
+	}
+}
+
+// domainClassifications caches the classifications of the domains. They
+// expire `ttl` after the last observation, and are verified again every
+// `verifyInterval` while in use.
+type domainClassifications struct {
+	ttl            time.Duration
+	verifyInterval time.Duration
+
+	mu    sync.Mutex
+	cache *goc.Cache
+}
+
+func newDomainClassifications(cacheCap int) *domainClassifications {
+	c, _ := goc.NewCache("lru", cacheCap)
+	return &domainClassifications{
+		ttl:            24 * time.Hour,
+		verifyInterval: time.Hour,
+		cache:          c,
+	}
+}
+
+// get returns a copy of the classification of `name` if it is cached and not
+// expired, and whether it is due to be verified, which is told once per
+// interval.
+func (d *domainClassifications) get(name string) (c classification, ok bool, verify bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	v, ok := d.cache.Get(name)
+	if !ok {
+		return c, false, false
+	}
+	cached := v.(*classification)
+	now := time.Now()
+	if now.Sub(cached.updated) > d.ttl {
+		return c, false, false
+	}
+	if now.Sub(cached.verified) > d.verifyInterval {
+		cached.verified = now
+		verify = true
+	}
+	c = *cached
+	c.recent = nil
+	return c, true, verify
+}
+
+// observe records an observation of `name`.
+func (d *domainClassifications) observe(name string, local bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	var c *classification
+	if v, ok := d.cache.Get(name); ok && now.Sub(v.(*classification).updated) <= d.ttl {
+		c = v.(*classification)
+	} else {
+		c = &classification{verified: now}
+		d.cache.Set(name, c)
+	}
+	c.add(local)
+	c.updated = now
+}
+
+// reset replaces the observations of `name` with `local`, for the
+// observations which are certain.
+func (d *domainClassifications) reset(name string, local bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	c := &classification{updated: now, verified: now}
+	c.add(local)
+	d.cache.Set(name, c)
+}
+
+// verify queries `q` at both upstreams and records what their answers tell,
+// like resolving an unclassified domain does.
+func (resolver *spoofingProofResolver) verify(q dns.Question, recursion bool, net string) {
+	var fast, clean *dns.Msg
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		fast, _ = resolver.exchange(q, recursion, net, resolver.fastUpstreamProvider.GetUpstream())
+	}()
+	go func() {
+		defer wg.Done()
+		clean, _ = resolver.exchange(q, recursion, net, resolver.cleanUpstreamProvider.GetUpstream())
+	}()
+	wg.Wait()
+
+	if resolver.poison != nil {
+		if poisoned, ok := resolver.poison.cached(q.Name); ok && poisoned {
+			resolver.classes.reset(q.Name, false)
+			return
+		}
+	}
+	hijacked := fast != nil && resolver.hijack != nil && resolver.hijack.hijacked(fast)
+	switch {
+	case fast != nil && !hijacked && fast.Rcode == dns.RcodeSuccess && containsA(fast) && resolver.isLocalAnswer(fast):
+		resolver.classes.observe(q.Name, true)
+	case clean != nil && clean.Rcode == dns.RcodeSuccess && containsA(clean):
+		resolver.classes.observe(q.Name, resolver.isLocalAnswer(clean))
+	}
+}
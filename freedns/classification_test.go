@@ -0,0 +1,87 @@
+package freedns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestDomainClassifications(t *testing.T) {
+	d := newDomainClassifications(16)
+
+	tests := []struct {
+		observed bool
+		local    bool
+	}{
+		{false, false},
+		// a single odd answer doesn't flip it
+		{true, false},
+		{true, true},
+		{false, true},
+		{false, false},
+		// 3 of the last 5, as the oldest observation is out of the window
+		{true, true},
+	}
+	for i, tt := range tests {
+		d.observe("example.com.", tt.observed)
+		if c, ok, _ := d.get("example.com."); !ok || c.decided != tt.local {
+			t.Errorf("observation %d: got %v %v, want %v", i, c.decided, ok, tt.local)
+		}
+	}
+
+	d.reset("example.com.", false)
+	if c, _, _ := d.get("example.com."); c.decided || c.local+c.foreign != 1 {
+		t.Errorf("the reset classification should be foreign")
+	}
+
+	d.verifyInterval = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	if _, _, verify := d.get("example.com."); !verify {
+		t.Errorf("the classification should be due to verify")
+	}
+	if _, _, verify := d.get("example.com."); verify {
+		t.Errorf("the verification should be told once per interval")
+	}
+
+	d.ttl = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	if _, ok, _ := d.get("example.com."); ok {
+		t.Errorf("the classification should expire")
+	}
+}
+
+func TestClassificationMigration(t *testing.T) {
+	w := newTestWorld(t)
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+
+	resolve := func(reason string) {
+		t.Helper()
+		q := dns.Question{Name: "cdn.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+		resolver.resolve(q, true, "udp", trace)
+		if trace.Reason != reason {
+			t.Errorf("got %v, want %v", trace.Reason, reason)
+		}
+	}
+
+	w.auth.Handle("cdn.example.com", dns.TypeA, freednstest.IPs("cdn.example.com", "104.244.42.1"))
+	resolve("fast answer has no local IPs")
+
+	// the domain moves to a CDN in China, which the answers of the clean
+	// upstream tell by the majority
+	w.auth.Handle("cdn.example.com", dns.TypeA, freednstest.IPs("cdn.example.com", "202.38.64.1"))
+	resolve("cached classification: foreign")
+	resolve("cached classification: foreign")
+	resolve("cached classification: china")
+
+	// and back abroad, which the verification tells with both upstreams
+	w.auth.Handle("cdn.example.com", dns.TypeA, freednstest.IPs("cdn.example.com", "104.244.42.1"))
+	q := dns.Question{Name: "cdn.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	for i := 0; i < 3; i++ {
+		resolver.verify(q, true, "udp")
+	}
+	resolve("cached classification: foreign")
+}
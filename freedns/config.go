@@ -0,0 +1,52 @@
+package freedns
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// LoadConfig reads the configuration in JSON from `filename` into `cfg`,
+// keeping the fields absent from the file. The keys are the field names of
+// Config, and the durations are strings like "24h".
+func LoadConfig(filename string, cfg *Config) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	type config Config
+	aux := struct {
+		*config
+		IPListInterval      string
+		HijackProbeInterval string
+		SelfTestInterval    string
+
+		ClassificationTTL            string
+		ClassificationVerifyInterval string
+	}{config: (*config)(cfg)}
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&aux); err != nil {
+		return Error(filename + ": " + err.Error())
+	}
+	for _, d := range []struct {
+		s string
+		d *time.Duration
+	}{
+		{aux.IPListInterval, &cfg.IPListInterval},
+		{aux.HijackProbeInterval, &cfg.HijackProbeInterval},
+		{aux.SelfTestInterval, &cfg.SelfTestInterval},
+		{aux.ClassificationTTL, &cfg.ClassificationTTL},
+		{aux.ClassificationVerifyInterval, &cfg.ClassificationVerifyInterval},
+	} {
+		if d.s == "" {
+			continue
+		}
+		if *d.d, err = time.ParseDuration(d.s); err != nil {
+			return Error(filename + ": " + err.Error())
+		}
+	}
+	return nil
+}
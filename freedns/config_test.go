@@ -0,0 +1,46 @@
+package freedns
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tempfile, err := ioutil.TempFile("", "test_config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tempfile.Name())
+	tempfile.WriteString(`{
+	"CleanUpstream": "127.0.0.1:5353",
+	"IPListInterval": "12h",
+	"HijackProbeInterval": "30m",
+	"ExcludeCIDRs": ["114.114.0.0/16"]
+}`)
+	tempfile.Close()
+
+	cfg := Config{FastUpstream: "114.114.114.114", CleanUpstream: "8.8.8.8"}
+	if err := LoadConfig(tempfile.Name(), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	want := Config{
+		FastUpstream:        "114.114.114.114",
+		CleanUpstream:       "127.0.0.1:5353",
+		IPListInterval:      12 * time.Hour,
+		HijackProbeInterval: 30 * time.Minute,
+		ExcludeCIDRs:        []string{"114.114.0.0/16"},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %+v, want %+v", cfg, want)
+	}
+
+	for _, bad := range []string{`{"Upstream": "8.8.8.8"}`, `{"IPListInterval": "1 day"}`, `[]`} {
+		ioutil.WriteFile(tempfile.Name(), []byte(bad), 0644)
+		if err := LoadConfig(tempfile.Name(), &Config{}); err == nil {
+			t.Errorf("%s should be rejected", bad)
+		}
+	}
+}
@@ -32,23 +32,41 @@ func (c *dnsCache) set(res *dns.Msg, net string) {
 	})
 }
 
-func (c *dnsCache) lookup(q dns.Question, recursion bool, net string) (*dns.Msg, bool) {
+// lookup returns the cached answer to `q`, whether it needs an update, and
+// whether it is expired.
+func (c *dnsCache) lookup(q dns.Question, recursion bool, net string) (*dns.Msg, bool, bool) {
 	key := requestToString(q, recursion, net)
 	ci, ok := c.backend.Get(key)
 	if ok {
 		entry := ci.(cacheEntry)
+		if entry.reply == nil {
+			return nil, true, false
+		}
 		res := entry.reply.Copy() // .Copy() is mandatory
 		delta := time.Now().Sub(entry.putin).Seconds()
-		needUpdate := subTTL(res, int(delta))
+		needUpdate, expired := subTTL(res, int(delta))
+
+		return res, needUpdate, expired
+	}
+	return nil, true, false
+}
 
-		return res, needUpdate
+// evict removes the answers to `q` over any net. The backend can't delete,
+// so they are replaced by empty entries, which miss.
+func (c *dnsCache) evict(q dns.Question) {
+	for _, recursion := range []bool{true, false} {
+		for _, net := range []string{"udp", "tcp"} {
+			key := requestToString(q, recursion, net)
+			if _, ok := c.backend.Get(key); ok {
+				c.backend.Set(key, cacheEntry{})
+			}
+		}
 	}
-	return nil, true
 }
 
 // requestToString generates a string that uniquely identifies the request.
 func requestToString(q dns.Question, recursion bool, net string) string {
-	s := q.Name + "_" + dns.TypeToString[q.Qtype] + "_" + dns.ClassToString[q.Qclass]
+	s := q.Name + "_" + QueryTypeString(q.Qtype) + "_" + dns.ClassToString[q.Qclass]
 	if recursion {
 		s += "_1"
 	} else {
@@ -59,14 +77,21 @@ func requestToString(q dns.Question, recursion bool, net string) string {
 }
 
 // subTTL substracts the ttl of `res` by delta in place,
-// and returns true if it will be expired in 3 seconds.
-func subTTL(res *dns.Msg, delta int) bool {
-	needUpdate := false
+// and returns true if it will be expired in 3 seconds,
+// and whether it is expired already.
+func subTTL(res *dns.Msg, delta int) (needUpdate bool, expired bool) {
 	S := func(rr []dns.RR) {
 		for i := 0; i < len(rr); i++ {
+			// the TTL of OPT holds the EDNS flags
+			if rr[i].Header().Rrtype == dns.TypeOPT {
+				continue
+			}
 			newTTL := int(rr[i].Header().Ttl)
 			newTTL -= delta
 
+			if newTTL <= 0 {
+				expired = true
+			}
 			if newTTL <= 3 {
 				newTTL = 3
 				needUpdate = true
@@ -80,5 +105,5 @@ func subTTL(res *dns.Msg, delta int) bool {
 	S(res.Ns)
 	S(res.Extra)
 
-	return needUpdate
+	return needUpdate, expired
 }
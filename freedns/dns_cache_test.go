@@ -31,28 +31,71 @@ func TestAll(t *testing.T) {
 
 	c := newDNSCache(10)
 	c.set(req, "udp")
+	short := req.Copy()
+	short.Question[0].Name = "short.org"
+	short.Answer[0].Header().Ttl = 1
+	c.set(short, "udp")
 
 	// query 1
 	time.Sleep(1 * time.Second)
-	res, upd := c.lookup(req.Question[0], req.RecursionDesired, "udp")
+	if _, upd, expired := c.lookup(short.Question[0], short.RecursionDesired, "udp"); !upd || !expired {
+		t.Errorf("the ttl of 1 should be run out")
+	}
+	res, upd, expired := c.lookup(req.Question[0], req.RecursionDesired, "udp")
 	if res.Answer[0].(*dns.A).Hdr.Name != req.Answer[0].(*dns.A).Hdr.Name {
 		t.Errorf("lookup returns wrong result!")
 	}
-	if upd || res.Answer[0].(*dns.A).Hdr.Ttl <= 3 {
+	if upd || expired || res.Answer[0].(*dns.A).Hdr.Ttl <= 3 {
 		t.Errorf("the ttl should be 4 and do not need to update")
 	}
 
 	// query 2
 	time.Sleep(1 * time.Second)
-	res, upd = c.lookup(req.Question[0], req.RecursionDesired, "udp")
+	res, upd, expired = c.lookup(req.Question[0], req.RecursionDesired, "udp")
 	if !upd || res.Answer[0].(*dns.A).Hdr.Ttl > 3 {
 		t.Errorf("the tll should be no more than 3 and need to update")
 	}
+	if expired {
+		t.Errorf("the ttl is not run out yet")
+	}
 
 	// query 3
 	req.Question[0].Name = "random.org"
-	res, upd = c.lookup(req.Question[0], req.RecursionDesired, "udp")
+	res, _, _ = c.lookup(req.Question[0], req.RecursionDesired, "udp")
 	if res != nil {
 		t.Errorf("res should be nil")
 	}
 }
+
+func TestEvict(t *testing.T) {
+	res := &dns.Msg{}
+	res.SetQuestion("example.com.", dns.TypeA)
+	c := newDNSCache(10)
+	c.set(res, "udp")
+	c.set(res, "tcp")
+
+	c.evict(res.Question[0])
+	for _, net := range []string{"udp", "tcp"} {
+		if res, upd, _ := c.lookup(res.Question[0], true, net); res != nil || !upd {
+			t.Errorf("the %s answer should be evicted", net)
+		}
+	}
+}
+
+func TestUnknownTypes(t *testing.T) {
+	res := &dns.Msg{}
+	res.SetQuestion("example.com.", typeHTTPS)
+	res.Answer = append(res.Answer, newHTTPS("example.com.", "1.1.1.1"))
+	c := newDNSCache(10)
+	c.set(res, "udp")
+
+	// miekg/dns has no names for HTTPS and SVCB
+	q := dns.Question{Name: "example.com.", Qtype: typeSVCB, Qclass: dns.ClassINET}
+	if res, _, _ := c.lookup(q, true, "udp"); res != nil {
+		t.Errorf("the HTTPS answer is served to SVCB: %v", res)
+	}
+	q.Qtype = typeHTTPS
+	if res, _, _ := c.lookup(q, true, "udp"); res == nil {
+		t.Error("the HTTPS answer should be cached")
+	}
+}
@@ -0,0 +1,131 @@
+package freedns
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+
+	"github.com/miekg/dns"
+)
+
+// The Extended DNS Errors of RFC 8914, which miekg/dns doesn't know and are
+// packed as dns.EDNS0_LOCAL.
+const (
+	edeOptionCode = 15
+
+	edeDNSSECBogus          = 6
+	edeStaleAnswer          = 3
+	edeBlocked              = 15
+	edeFiltered             = 17
+	edeProhibited           = 18
+	edeNoReachableAuthority = 22
+	edeNetworkError         = 23
+)
+
+// setEDE sets the Extended DNS Error of `res` to `code`, replacing the one
+// set before.
+func setEDE(res *dns.Msg, code uint16, text string) {
+	opt := res.IsEdns0()
+	if opt == nil {
+		opt = &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+		opt.SetUDPSize(dns.DefaultMsgSize)
+		res.Extra = append(res.Extra, opt)
+	}
+	data := make([]byte, 2, 2+len(text))
+	binary.BigEndian.PutUint16(data, code)
+	data = append(data, text...)
+	options := opt.Option[:0]
+	for _, o := range opt.Option {
+		if o.Option() != edeOptionCode {
+			options = append(options, o)
+		}
+	}
+	opt.Option = append(options, &dns.EDNS0_LOCAL{Code: edeOptionCode, Data: data})
+}
+
+// getEDE returns the Extended DNS Error of `res`.
+func getEDE(res *dns.Msg) (code uint16, text string, ok bool) {
+	opt := res.IsEdns0()
+	if opt == nil {
+		return 0, "", false
+	}
+	for _, o := range opt.Option {
+		if local, isLocal := o.(*dns.EDNS0_LOCAL); isLocal && local.Code == edeOptionCode && len(local.Data) >= 2 {
+			return binary.BigEndian.Uint16(local.Data), string(local.Data[2:]), true
+		}
+	}
+	return 0, "", false
+}
+
+// finishEDE removes the OPT record carrying the Extended DNS Error of `res`
+// if `req` is not an EDNS request, or appends ExtendedErrorText to its text.
+func (s *Server) finishEDE(req *dns.Msg, res *dns.Msg) {
+	code, text, ok := getEDE(res)
+	if !ok {
+		return
+	}
+	if req.IsEdns0() == nil {
+		extra := res.Extra[:0]
+		for _, rr := range res.Extra {
+			if rr.Header().Rrtype != dns.TypeOPT {
+				extra = append(extra, rr)
+			}
+		}
+		res.Extra = extra
+		return
+	}
+	if s.config.ExtendedErrorText != "" {
+		if text != "" {
+			text += "; "
+		}
+		setEDE(res, code, text+s.config.ExtendedErrorText)
+	}
+}
+
+// upstreamError sets the Extended DNS Error of the failure `res` by the
+// error `err` querying the upstream.
+func upstreamError(res *dns.Msg, err error) {
+	if netErr, ok := err.(net.Error); (ok && netErr.Timeout()) || err == Error("timeout") {
+		setEDE(res, edeNoReachableAuthority, "upstream timeout")
+	} else {
+		setEDE(res, edeNetworkError, err.Error())
+	}
+}
+
+// keepUpstreamEDE replaces the OPT record of the upstream answer `res` with
+// one carrying only its Extended DNS Error, if it has any, as the other
+// options and the payload size are of the hop to the upstream.
+func keepUpstreamEDE(res *dns.Msg) {
+	code, text, ok := getEDE(res)
+	extra := res.Extra[:0]
+	for _, rr := range res.Extra {
+		if rr.Header().Rrtype != dns.TypeOPT {
+			extra = append(extra, rr)
+		}
+	}
+	res.Extra = extra
+	if ok {
+		setEDE(res, code, text)
+	}
+}
+
+// clientAllowed tells if the client at `addr` is allowed by AllowClients.
+func (s *Server) clientAllowed(addr net.Addr) bool {
+	if len(s.allowClients) == 0 {
+		return true
+	}
+	var ip netip.Addr
+	switch addr := addr.(type) {
+	case *net.UDPAddr:
+		ip, _ = netip.AddrFromSlice(addr.IP)
+	case *net.TCPAddr:
+		ip, _ = netip.AddrFromSlice(addr.IP)
+	}
+	ip = ip.Unmap()
+	for _, p := range s.allowClients {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
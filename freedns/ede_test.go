@@ -0,0 +1,217 @@
+package freedns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+// runServer runs a server of `cfg` on 127.0.0.1:52346 until the test ends.
+func runServer(t *testing.T, cfg Config) *Server {
+	t.Helper()
+	cfg.Listen = "127.0.0.1:52346"
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- s.Run()
+	}()
+	t.Cleanup(func() {
+		s.Shutdown()
+		if err := <-runErr; err != nil {
+			t.Error(err)
+		}
+	})
+	for i := 0; i < 10; i++ {
+		if conn, err := net.Dial("tcp", cfg.Listen); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return s
+}
+
+// queryEDE queries `name` of `qtype`, with EDNS if `edns` is set, and returns
+// the response and its Extended DNS Error.
+func queryEDE(t *testing.T, name string, qtype uint16, edns bool) (res *dns.Msg, code int, text string) {
+	t.Helper()
+	req := &dns.Msg{}
+	req.SetQuestion(name, qtype)
+	if edns {
+		req.SetEdns0(dns.DefaultMsgSize, false)
+	}
+	res, _, err := (&dns.Client{Timeout: 5 * time.Second}).Exchange(req, "127.0.0.1:52346")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, text, ok := getEDE(res)
+	if !ok {
+		return res, -1, ""
+	}
+	return res, int(c), text
+}
+
+func TestExtendedErrors(t *testing.T) {
+	w := newTestWorld(t)
+	s := runServer(t, Config{
+		FastUpstream:      w.fast.Addr,
+		CleanUpstream:     w.clean.Addr,
+		CacheCap:          1024,
+		FilterAAAA:        true,
+		ExtendedErrorText: "admin@example.com",
+	})
+
+	res, code, text := queryEDE(t, "ustc.edu.cn.", dns.TypeAAAA, true)
+	if code != edeFiltered || text != "AAAA filtered; admin@example.com" {
+		t.Errorf("got EDE %d %q", code, text)
+	}
+	// only for the EDNS requests
+	res, code, _ = queryEDE(t, "ustc.edu.cn.", dns.TypeAAAA, false)
+	if code != -1 || res.IsEdns0() != nil {
+		t.Errorf("got EDE %d without EDNS", code)
+	}
+
+	// stale, cached a minute ago
+	stale := &dns.Msg{Answer: freednstest.IPs("stale.example.com", "192.0.2.1").Answer}
+	stale.SetQuestion("stale.example.com.", dns.TypeA)
+	stale.Answer[0].Header().Ttl = 30
+	s.recordsCache.backend.Set(requestToString(stale.Question[0], true, "udp"), cacheEntry{
+		putin: time.Now().Add(-time.Minute),
+		reply: stale,
+	})
+	res, code, text = queryEDE(t, "stale.example.com.", dns.TypeA, true)
+	if res.Rcode != dns.RcodeSuccess || code != edeStaleAnswer || text != "admin@example.com" {
+		t.Errorf("got %v with EDE %d %q", dns.RcodeToString[res.Rcode], code, text)
+	}
+	if stats := s.Stats(); stats.StaleHits != 1 {
+		t.Errorf("got %d stale hits", stats.StaleHits)
+	}
+
+	// refreshed ahead of the expiry
+	prefetched := &dns.Msg{Answer: freednstest.IPs("prefetched.example.com", "192.0.2.1").Answer}
+	prefetched.SetQuestion("prefetched.example.com.", dns.TypeA)
+	prefetched.Answer[0].Header().Ttl = 3
+	s.recordsCache.set(prefetched, "udp")
+	if _, code, _ := queryEDE(t, "prefetched.example.com.", dns.TypeA, true); code != -1 {
+		t.Errorf("got EDE %d before the expiry", code)
+	}
+	if stats := s.Stats(); stats.StaleHits != 1 {
+		t.Errorf("got %d stale hits, counting the ones before the expiry", stats.StaleHits)
+	}
+}
+
+func TestExtendedErrorsOfFailures(t *testing.T) {
+	// tells why to the EDNS queries, like a validating resolver to a name
+	// failing DNSSEC
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validating := &dns.Server{PacketConn: conn, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		res := &dns.Msg{}
+		res.SetRcode(req, dns.RcodeServerFailure)
+		if opt := req.IsEdns0(); opt != nil {
+			res.SetEdns0(opt.UDPSize(), false)
+			res.IsEdns0().Option = append(res.IsEdns0().Option, &dns.EDNS0_COOKIE{Code: dns.EDNS0COOKIE, Cookie: "0123456789abcdef"})
+			setEDE(res, edeDNSSECBogus, "RRSIG expired")
+		}
+		w.WriteMsg(res)
+	})}
+	go validating.ActivateAndServe()
+	defer validating.Shutdown()
+
+	runServer(t, Config{
+		FastUpstream:  conn.LocalAddr().String(),
+		CleanUpstream: conn.LocalAddr().String(),
+		CacheCap:      1024,
+	})
+	res, code, text := queryEDE(t, "dnssec-failed.org.", dns.TypeA, true)
+	if res.Rcode != dns.RcodeServerFailure || code != edeDNSSECBogus || text != "RRSIG expired" {
+		t.Errorf("got %v with EDE %d %q", dns.RcodeToString[res.Rcode], code, text)
+	}
+	// only the EDE of the upstream is passed on
+	if opt := res.IsEdns0(); opt == nil || len(opt.Option) != 1 {
+		t.Errorf("got %v", opt)
+	}
+	if res, _, _ := queryEDE(t, "dnssec-failed.org.", dns.TypeA, false); res.IsEdns0() != nil {
+		t.Errorf("the OPT record is answered to a client without EDNS: %v", res)
+	}
+}
+
+func TestExtendedErrorsOfTimeouts(t *testing.T) {
+	silent, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer silent.Close()
+
+	runServer(t, Config{
+		FastUpstream:  silent.LocalAddr().String(),
+		CleanUpstream: silent.LocalAddr().String(),
+		CacheCap:      1024,
+	})
+	res, code, text := queryEDE(t, "example.com.", dns.TypeA, true)
+	if res.Rcode != dns.RcodeServerFailure || code != edeNoReachableAuthority || text != "upstream timeout" {
+		t.Errorf("got %v with EDE %d %q", dns.RcodeToString[res.Rcode], code, text)
+	}
+}
+
+func TestProhibitedClients(t *testing.T) {
+	w := newTestWorld(t)
+	s := runServer(t, Config{
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
+		CacheCap:      1024,
+		AllowClients:  []string{"10.0.0.0/8", "fd00::/8"},
+	})
+	res, code, _ := queryEDE(t, "ustc.edu.cn.", dns.TypeA, true)
+	if res.Rcode != dns.RcodeRefused || code != edeProhibited {
+		t.Errorf("got %v with EDE %d", dns.RcodeToString[res.Rcode], code)
+	}
+	if !s.clientAllowed(&net.TCPAddr{IP: net.ParseIP("10.1.2.3")}) {
+		t.Errorf("10.1.2.3 should be allowed")
+	}
+
+	if _, err := NewServer(Config{FastUpstream: w.fast.Addr, CleanUpstream: w.clean.Addr, AllowClients: []string{"10.0.0.1"}}); err == nil {
+		t.Errorf("invalid CIDRs should be rejected")
+	}
+}
+
+func TestTruncation(t *testing.T) {
+	// answers up to the EDNS payload size
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	large := &dns.Server{PacketConn: conn, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		var ips []string
+		for i := 1; i <= 40; i++ {
+			ips = append(ips, fmt.Sprintf("192.0.2.%d", i))
+		}
+		res := &dns.Msg{}
+		res.SetReply(req)
+		res.Answer = freednstest.IPs(req.Question[0].Name, ips...).Answer
+		w.WriteMsg(res)
+	})}
+	go large.ActivateAndServe()
+	defer large.Shutdown()
+
+	runServer(t, Config{
+		FastUpstream:  conn.LocalAddr().String(),
+		CleanUpstream: conn.LocalAddr().String(),
+		CacheCap:      1024,
+	})
+	if res, _, _ := queryEDE(t, "example.com.", dns.TypeA, true); res.Truncated || len(res.Answer) != 40 {
+		t.Errorf("got %d records, truncated %v", len(res.Answer), res.Truncated)
+	}
+	if res, _, _ := queryEDE(t, "example.com.", dns.TypeA, false); !res.Truncated || len(res.Answer) == 40 {
+		t.Errorf("got %d records, truncated %v", len(res.Answer), res.Truncated)
+	}
+}
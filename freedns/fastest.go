@@ -0,0 +1,255 @@
+package freedns
+
+import (
+	"net"
+	"net/netip"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	goc "github.com/louchenyao/golang-cache"
+	"github.com/miekg/dns"
+)
+
+// The modes of the fastest IP selection.
+const (
+	FastestIPFirst = "first" // put the fastest IPs first
+	FastestIPOnly  = "only"  // answer with the fastest IP only
+)
+
+// ipProbe is the cached result of probing an IP.
+type ipProbe struct {
+	ok     bool
+	rtt    time.Duration
+	expire time.Time
+}
+
+// ipProber ranks the IPs in the answers by how fast they accept tcp
+// connections, like the speed check of SmartDNS.
+type ipProber struct {
+	only  bool
+	ports []int
+	// timeout is the timeout of the connections, and wait how long the
+	// answers wait for the probes, which go on in the background
+	timeout time.Duration
+	wait    time.Duration
+	ttl     time.Duration
+	dial    func(network, address string, timeout time.Duration) (net.Conn, error)
+
+	// results caches the probes by IP
+	results *goc.Cache
+
+	mu      sync.Mutex
+	probing map[netip.Addr]chan struct{}
+}
+
+func newIPProber(mode string, ports []int, cacheCap int) (*ipProber, error) {
+	if mode != FastestIPFirst && mode != FastestIPOnly {
+		return nil, Error("unknown fastest IP mode: " + mode)
+	}
+	if len(ports) == 0 {
+		ports = []int{80, 443}
+	}
+	c, _ := goc.NewCache("lru", cacheCap)
+	return &ipProber{
+		only:    mode == FastestIPOnly,
+		ports:   ports,
+		timeout: time.Second,
+		wait:    300 * time.Millisecond,
+		ttl:     10 * time.Minute,
+		dial:    net.DialTimeout,
+		results: c,
+		probing: make(map[netip.Addr]chan struct{}),
+	}, nil
+}
+
+// cached returns the cached probe of `ip`.
+func (p *ipProber) cached(ip netip.Addr) (ipProbe, bool) {
+	v, ok := p.results.Get(ip)
+	if !ok || v.(ipProbe).expire.Before(time.Now()) {
+		return ipProbe{}, false
+	}
+	return v.(ipProbe), true
+}
+
+// probe connects to the ports of `ip` in parallel, and caches the fastest
+// connection. It returns a channel closed once done, shared by the probes
+// of the same IP in progress.
+func (p *ipProber) probe(ip netip.Addr) <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if done, ok := p.probing[ip]; ok {
+		return done
+	}
+	done := make(chan struct{})
+	p.probing[ip] = done
+
+	go func() {
+		rtts := make(chan time.Duration, len(p.ports))
+		for _, port := range p.ports {
+			go func(port int) {
+				start := time.Now()
+				conn, err := p.dial("tcp", net.JoinHostPort(ip.String(), strconv.Itoa(port)), p.timeout)
+				if err != nil {
+					rtts <- -1
+					return
+				}
+				conn.Close()
+				rtts <- time.Since(start)
+			}(port)
+		}
+		result := ipProbe{}
+		for range p.ports {
+			if rtt := <-rtts; rtt >= 0 {
+				result = ipProbe{ok: true, rtt: rtt}
+				break
+			}
+		}
+		result.expire = time.Now().Add(p.ttl)
+		p.results.Set(ip, result)
+
+		p.mu.Lock()
+		delete(p.probing, ip)
+		p.mu.Unlock()
+		close(done)
+	}()
+	return done
+}
+
+// rank probes the IPs not cached, waiting until one is reachable, all are
+// done or the wait is over, and returns the reachable IPs from the fastest.
+func (p *ipProber) rank(ips []netip.Addr) []netip.Addr {
+	reachable := false
+	pending := 0
+	probed := make(chan netip.Addr, len(ips))
+	for _, ip := range ips {
+		if probe, ok := p.cached(ip); ok {
+			reachable = reachable || probe.ok
+			continue
+		}
+		pending++
+		go func(ip netip.Addr, done <-chan struct{}) {
+			<-done
+			probed <- ip
+		}(ip, p.probe(ip))
+	}
+
+	if !reachable && pending > 0 {
+		timeout := time.NewTimer(p.wait)
+		defer timeout.Stop()
+	wait:
+		for ; pending > 0; pending-- {
+			select {
+			case ip := <-probed:
+				if probe, ok := p.cached(ip); ok && probe.ok {
+					break wait
+				}
+			case <-timeout.C:
+				break wait
+			}
+		}
+	}
+
+	type ranked struct {
+		ip  netip.Addr
+		rtt time.Duration
+	}
+	var list []ranked
+	for _, ip := range ips {
+		if probe, ok := p.cached(ip); ok && probe.ok {
+			list = append(list, ranked{ip, probe.rtt})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].rtt < list[j].rtt
+	})
+	var fastest []netip.Addr
+	for _, r := range list {
+		fastest = append(fastest, r.ip)
+	}
+	return fastest
+}
+
+// apply reorders the address records of `res` from the fastest IP, or keeps
+// the fastest one only. The records in `extra` from the answer of the other
+// upstream are candidates too, whose IPs are added to `res` if they rank
+// first. `res` is kept if no IP is reachable.
+func (p *ipProber) apply(res *dns.Msg, extra []dns.RR) {
+	var positions []int
+	records := make(map[netip.Addr]dns.RR)
+	var ips []netip.Addr
+	add := func(rr dns.RR) {
+		ip, ok := addrOf(rr)
+		if !ok {
+			return
+		}
+		if _, ok := records[ip]; !ok {
+			records[ip] = rr
+			ips = append(ips, ip)
+		}
+	}
+	for i, rr := range res.Answer {
+		if t := rr.Header().Rrtype; t == dns.TypeA || t == dns.TypeAAAA {
+			positions = append(positions, i)
+			add(rr)
+		}
+	}
+	if len(positions) == 0 {
+		return
+	}
+	owner := res.Answer[positions[0]].Header()
+	for _, rr := range extra {
+		if rr.Header().Rrtype == owner.Rrtype {
+			add(rr)
+		}
+	}
+
+	fastest := p.rank(ips)
+	if len(fastest) == 0 {
+		return
+	}
+	if p.only {
+		fastest = fastest[:1]
+	}
+	// the reachable IPs first, then the others of the answer
+	var sorted []dns.RR
+	seen := make(map[netip.Addr]bool)
+	for _, ip := range fastest {
+		rr := dns.Copy(records[ip])
+		rr.Header().Name, rr.Header().Ttl = owner.Name, owner.Ttl
+		sorted = append(sorted, rr)
+		seen[ip] = true
+	}
+	if !p.only {
+		for _, i := range positions {
+			rr := res.Answer[i]
+			if ip, ok := addrOf(rr); ok && !seen[ip] {
+				sorted = append(sorted, rr)
+			}
+		}
+	}
+
+	// replace the address records in place, after the CNAMEs
+	answer := make([]dns.RR, 0, len(res.Answer)-len(positions)+len(sorted))
+	for i, rr := range res.Answer {
+		if i == positions[0] {
+			answer = append(answer, sorted...)
+		}
+		if t := rr.Header().Rrtype; t != dns.TypeA && t != dns.TypeAAAA {
+			answer = append(answer, rr)
+		}
+	}
+	res.Answer = answer
+}
+
+// addrOf returns the address of an A or AAAA record.
+func addrOf(rr dns.RR) (netip.Addr, bool) {
+	switch rr := rr.(type) {
+	case *dns.A:
+		return netip.AddrFromSlice(rr.A.To4())
+	case *dns.AAAA:
+		return netip.AddrFromSlice(rr.AAAA)
+	}
+	return netip.Addr{}, false
+}
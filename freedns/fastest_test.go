@@ -0,0 +1,171 @@
+package freedns
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+// listenProbed listens on `ips` at the same tcp port, and returns the port.
+func listenProbed(t *testing.T, ips ...string) int {
+	t.Helper()
+	var port int
+	for i := 0; i < 10; i++ {
+		l, err := net.Listen("tcp", ips[0]+":0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port = l.Addr().(*net.TCPAddr).Port
+		listeners := []net.Listener{l}
+		for _, ip := range ips[1:] {
+			l, err := net.Listen("tcp", net.JoinHostPort(ip, strconv.Itoa(port)))
+			if err != nil {
+				break
+			}
+			listeners = append(listeners, l)
+		}
+		if len(listeners) == len(ips) {
+			for _, l := range listeners {
+				t.Cleanup(func(l net.Listener) func() {
+					return func() { l.Close() }
+				}(l))
+			}
+			return port
+		}
+		for _, l := range listeners {
+			l.Close()
+		}
+	}
+	t.Fatal("no port free on all the IPs")
+	return 0
+}
+
+func answerAddrs(res *dns.Msg) string {
+	var addrs []string
+	for _, rr := range res.Answer {
+		switch rr := rr.(type) {
+		case *dns.CNAME:
+			addrs = append(addrs, rr.Target)
+		case *dns.A:
+			addrs = append(addrs, rr.A.String())
+		}
+	}
+	return strings.Join(addrs, " ")
+}
+
+func TestIPProber(t *testing.T) {
+	port := listenProbed(t, "127.0.0.2", "127.0.0.4")
+
+	newProber := func(mode string) *ipProber {
+		p, err := newIPProber(mode, []int{port}, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 127.0.0.4 is slower, and 127.0.0.3 refuses
+		p.dial = func(network, address string, timeout time.Duration) (net.Conn, error) {
+			if strings.HasPrefix(address, "127.0.0.4:") {
+				time.Sleep(100 * time.Millisecond)
+			}
+			return net.DialTimeout(network, address, timeout)
+		}
+		return p
+	}
+	newAnswer := func() *dns.Msg {
+		return &dns.Msg{Answer: freednstest.RRs(
+			"www.example.com. 300 IN CNAME cdn.example.com.",
+			"cdn.example.com. 300 IN A 127.0.0.3",
+			"cdn.example.com. 300 IN A 127.0.0.4",
+			"cdn.example.com. 300 IN A 127.0.0.2",
+		).Answer}
+	}
+
+	p := newProber(FastestIPFirst)
+	res := newAnswer()
+	p.apply(res, nil)
+	// the answer doesn't wait for the slower probes
+	if got := answerAddrs(res); got != "cdn.example.com. 127.0.0.2 127.0.0.3 127.0.0.4" {
+		t.Errorf("got %q", got)
+	}
+	time.Sleep(200 * time.Millisecond)
+	res = newAnswer()
+	p.apply(res, nil)
+	if got := answerAddrs(res); got != "cdn.example.com. 127.0.0.2 127.0.0.4 127.0.0.3" {
+		t.Errorf("got %q with the probes cached", got)
+	}
+	if probe, ok := p.cached(netip.MustParseAddr("127.0.0.3")); !ok || probe.ok {
+		t.Errorf("127.0.0.3 should be cached as unreachable")
+	}
+
+	p = newProber(FastestIPOnly)
+	res = newAnswer()
+	p.apply(res, nil)
+	if got := answerAddrs(res); got != "cdn.example.com. 127.0.0.2" {
+		t.Errorf("got %q with the fastest only", got)
+	}
+
+	// kept as it is if none is reachable
+	res = &dns.Msg{Answer: freednstest.IPs("example.com", "127.0.0.3").Answer}
+	p.apply(res, nil)
+	if got := answerAddrs(res); got != "127.0.0.3" {
+		t.Errorf("got %q with none reachable", got)
+	}
+
+	if _, err := newIPProber("fastest", nil, 64); err == nil {
+		t.Errorf("unknown modes should be rejected")
+	}
+}
+
+func TestFastestIPFromBothUpstreams(t *testing.T) {
+	port := listenProbed(t, "127.0.0.2", "127.0.0.5")
+
+	fast, err := freednstest.NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fast.Close()
+	clean, err := freednstest.NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean.Close()
+	// the clean answer arrives first, but the fast one is local
+	a := freednstest.IPs("cdn.example.com", "127.0.0.3")
+	a.Delay = 50 * time.Millisecond
+	fast.Handle("cdn.example.com", dns.TypeA, a)
+	clean.Handle("cdn.example.com", dns.TypeA, freednstest.IPs("cdn.example.com", "127.0.0.2"))
+	fast.Handle("foreign.example.com", dns.TypeA, freednstest.IPs("foreign.example.com", "127.0.0.5"))
+	clean.Handle("foreign.example.com", dns.TypeA, freednstest.IPs("foreign.example.com", "192.0.2.1"))
+
+	// the loopback IPs are local except 127.0.0.5
+	localIPs := chinaip.ClassifierFunc(func(ip netip.Addr) bool {
+		return ip.IsLoopback() && ip != netip.MustParseAddr("127.0.0.5")
+	})
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{fast.Addr}, &staticUpstreamProvider{clean.Addr}, localIPs, 1024)
+	if resolver.fastest, err = newIPProber(FastestIPOnly, []int{port}, 64); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		domain string
+		answer string
+	}{
+		// the clean answer to the local domain is a candidate too
+		{"cdn.example.com.", "127.0.0.2"},
+		// but not the fast answer to a foreign one
+		{"foreign.example.com.", "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		q := dns.Question{Name: tt.domain, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		res, upstream, _ := resolver.resolve(q, true, "udp", nil)
+		if got := answerAddrs(res); got != tt.answer {
+			t.Errorf("%s: got %q from %v, want %q", tt.domain, got, upstream, tt.answer)
+		}
+	}
+}
@@ -1,8 +1,16 @@
 package freedns
 
 import (
+	"net/http"
+	"net/netip"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/miekg/dns"
 	"github.com/sirupsen/logrus"
+	"github.com/tuna/freedns-go/chinaip"
 )
 
 // Config stores the configuration for the Server
@@ -12,6 +20,136 @@ type Config struct {
 	Listen        string
 	CacheCap      int // the maximum items can be cached
 	LogLevel      string
+
+	// CountryDB is an optional MaxMind GeoIP2/GeoLite2 Country mmdb file.
+	// The IPs located in CountryCodes (CN by default) are considered local
+	// in addition to the builtin China IP list, or instead of it if
+	// CountryDBOnly is set.
+	CountryDB     string
+	CountryCodes  []string
+	CountryDBOnly bool
+
+	// IPListURL is an optional China IP list refreshed every IPListInterval
+	// (24h by default), which replaces the builtin one once verified.
+	// The last verified list is persisted to IPListFile if it is set.
+	// IPListPublicKey is a base64 ed25519 key to verify IPListSignatureURL.
+	// The list is in IPListFormat, see chinaip.ParseList: "cidr" (default)
+	// or "apnic", whose records of IPListCountry (CN by default) are taken.
+	IPListURL          string
+	IPListInterval     time.Duration
+	IPListFile         string
+	IPListFormat       string
+	IPListCountry      string
+	IPListChecksumURL  string
+	IPListSignatureURL string
+	IPListPublicKey    string
+
+	// IncludeCIDRs and ExcludeCIDRs override the local IP classification,
+	// together with the include/exclude rules in IPOverlayFile.
+	IncludeCIDRs  []string
+	ExcludeCIDRs  []string
+	IPOverlayFile string
+
+	// HTTPListen is the optional address serving the metrics at /metrics,
+	// and the readiness at /readyz.
+	HTTPListen string
+	// QueryLog is the optional file to append the query log to.
+	// QueryLogAnswers records the answers of both upstreams in the log too,
+	// to evaluate configuration changes with Replay.
+	QueryLog        string
+	QueryLogAnswers bool
+
+	// IPSets are the specs of the set sinks, see NewSetSink, which receive
+	// the IPs in the answers from the clean upstream if IPSetCleanUpstream
+	// is set, and the answers to IPSetDomains and their subdomains.
+	// SetSinks are the extra sinks created by the caller, who closes them
+	// after Shutdown, which only closes the ones of IPSets.
+	IPSets             []string
+	IPSetCleanUpstream bool
+	IPSetDomains       []string
+	SetSinks           []SetSink `json:"-"`
+
+	// DetectHijack probes the fast upstream with random nonexistent names
+	// at startup and every HijackProbeInterval (1h by default), and treats
+	// its answers with the IPs it answers them with as NXDOMAIN.
+	DetectHijack        bool
+	HijackProbeInterval time.Duration
+
+	// BlackholeProbe is an optional ip:port running no DNS server, on a
+	// path through the GFW. The unclassified domains are queried there too,
+	// up to BlackholeProbeRate (10 by default) per second, and the ones
+	// answered are poisoned, and thus never local.
+	BlackholeProbe     string
+	BlackholeProbeRate int
+
+	// SelfTest checks the upstreams at startup and every SelfTestInterval
+	// (10m by default), see RunSelfTest, and reports the result at /readyz.
+	// SelfTestChinaDomains and SelfTestPoisonedDomains override the domains
+	// checked.
+	SelfTest                bool
+	SelfTestInterval        time.Duration
+	SelfTestChinaDomains    []string
+	SelfTestPoisonedDomains []string
+
+	// DegradeAfter is the consecutive transport failures or timeouts after
+	// which an upstream is down, and the answers of the other one are served
+	// instead, with their TTLs capped to 10s, until it answers again. The
+	// rcodes it answers don't count. 0 (the default) disables it.
+	DegradeAfter int
+
+	// The domains are classified by the majority of the recent answers.
+	// The classifications expire ClassificationTTL (24h by default) after
+	// the last answer, and are verified again by querying both upstreams
+	// every ClassificationVerifyInterval (1h by default) while in use.
+	ClassificationTTL            time.Duration
+	ClassificationVerifyInterval time.Duration
+
+	// LocalIPPolicy tells the local answers by their A records: "any" (the
+	// default), "all" or "majority" of them are local. MixedAnswers rewrites
+	// the accepted fast answers mixing local and foreign IPs: "keep" them
+	// (the default), "strip" the foreign IPs or "reorder" the local IPs
+	// first.
+	LocalIPPolicy string
+	MixedAnswers  string
+
+	// FastestIP probes the IPs in the A and AAAA answers by connecting to
+	// FastestIPPorts (80 and 443 by default), and puts the fastest first
+	// ("first") or answers with it only ("only").
+	FastestIP      string
+	FastestIPPorts []int
+
+	// FilterAAAA answers the AAAA queries with no records, and removes the
+	// ipv6hints from the SVCB and HTTPS records, which would bypass it.
+	// NoECH removes their ECH configs.
+	FilterAAAA bool
+	NoECH      bool
+
+	// RebindProtection strips ("strip") the special-purpose addresses, such
+	// as the private ones, from the upstream answers, or refuses the answers
+	// with them ("refuse"), except for RebindAllowDomains and their
+	// subdomains. It is disabled if empty.
+	RebindProtection   string
+	RebindAllowDomains []string
+
+	// AllowClients are the CIDRs of the clients allowed to query, all if
+	// empty. The others are refused.
+	AllowClients []string
+	// ExtendedErrorText is appended to the text of the Extended DNS Errors
+	// in the responses, e.g. a contact.
+	ExtendedErrorText string
+
+	// UpstreamMaxInFlight limits the queries in flight to each upstream,
+	// unlimited if it is 0. Up to UpstreamMaxQueued more wait in a queue,
+	// and the others fail at once.
+	UpstreamMaxInFlight int
+	UpstreamMaxQueued   int
+
+	// WarmupFile is a domain list or a query log, like the one of the
+	// previous run, whose WarmupTop most frequent questions (all if 0) are
+	// resolved at startup, WarmupRate (20 by default) per second.
+	WarmupFile string
+	WarmupTop  int
+	WarmupRate int
 }
 
 // Server is type of the freedns server instance
@@ -23,6 +161,21 @@ type Server struct {
 
 	resolver     *spoofingProofResolver
 	recordsCache *dnsCache
+
+	ipListUpdater *chinaip.Updater
+
+	stats      *serverStats
+	queryLog   *queryLogger
+	httpServer *http.Server
+	setSinks   []SetSink
+
+	allowClients []netip.Prefix
+
+	selfTestMu sync.Mutex
+	selfTest   *SelfTest
+
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 var log = logrus.New()
@@ -36,7 +189,10 @@ func (e Error) Error() string {
 
 // NewServer creates a new freedns server instance.
 func NewServer(cfg Config) (*Server, error) {
-	s := &Server{}
+	s := &Server{
+		stats: &serverStats{},
+		done:  make(chan struct{}),
+	}
 
 	// set log level
 	if level, parseError := logrus.ParseLevel(cfg.LogLevel); parseError == nil {
@@ -83,14 +239,167 @@ func NewServer(cfg Config) (*Server, error) {
 
 	s.recordsCache = newDNSCache(cfg.CacheCap)
 
-	s.resolver = newSpoofingProofResolver(fastUpstreamProvider, cleanUpstreamProvider, cfg.CacheCap)
+	localIPs, err := newLocalIPClassifier(cfg, chinaip.ClassifierFunc(chinaip.Contains))
+	if err != nil {
+		return nil, err
+	}
+	if s.resolver, err = newResolver(cfg, fastUpstreamProvider, cleanUpstreamProvider, localIPs, cfg.CacheCap); err != nil {
+		return nil, err
+	}
+	s.resolver.evict = s.recordsCache.evict
+	if cfg.UpstreamMaxInFlight > 0 {
+		s.resolver.limits = newUpstreamLimiter(cfg.UpstreamMaxInFlight, cfg.UpstreamMaxQueued)
+		s.resolver.exchange = s.resolver.limits.wrap(s.resolver.exchange)
+	}
+	if cfg.FastestIP != "" {
+		if s.resolver.fastest, err = newIPProber(cfg.FastestIP, cfg.FastestIPPorts, cfg.CacheCap); err != nil {
+			return nil, err
+		}
+	}
+	for _, cidr := range cfg.AllowClients {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, Error("invalid client CIDR: " + cidr)
+		}
+		s.allowClients = append(s.allowClients, p.Masked())
+	}
+	if cfg.BlackholeProbe != "" {
+		addr, err := normalizeDnsAddress(cfg.BlackholeProbe)
+		if err != nil {
+			return nil, err
+		}
+		s.resolver.poison = newPoisonDetector(addr, cfg.BlackholeProbeRate, cfg.CacheCap)
+	}
+
+	if s.ipListUpdater, err = newIPListUpdater(cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.HTTPListen != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", s.serveMetrics)
+		mux.HandleFunc("/readyz", s.serveReady)
+		s.httpServer = &http.Server{
+			Addr:    cfg.HTTPListen,
+			Handler: mux,
+		}
+	}
+
+	if cfg.QueryLog != "" {
+		if s.queryLog, err = newQueryLogger(cfg.QueryLog); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.setSinks, err = newSetSinks(cfg); err != nil {
+		return nil, err
+	}
 
 	return s, nil
 }
 
+// newLocalIPClassifier creates the classifier deciding which IPs are local,
+// on top of the China IP list `chinaIPs`.
+func newLocalIPClassifier(cfg Config, chinaIPs chinaip.Classifier) (chinaip.Classifier, error) {
+	local := chinaIPs
+
+	if cfg.CountryDB != "" {
+		codes := cfg.CountryCodes
+		if len(codes) == 0 {
+			codes = []string{"CN"}
+		}
+		countryDB, err := chinaip.OpenCountryDB(cfg.CountryDB, codes)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.CountryDBOnly {
+			local = countryDB
+		} else {
+			local = chinaip.Union(local, countryDB)
+		}
+	}
+
+	rules, err := overlayRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) > 0 {
+		local = chinaip.NewOverlay(local, rules)
+	}
+	return local, nil
+}
+
+// overlayRules collects the include/exclude rules in the configuration.
+func overlayRules(cfg Config) ([]chinaip.Rule, error) {
+	var rules []chinaip.Rule
+	for _, list := range []struct {
+		cidrs  []string
+		action string
+	}{{cfg.IncludeCIDRs, "include"}, {cfg.ExcludeCIDRs, "exclude"}} {
+		for _, cidr := range list.cidrs {
+			rule, err := chinaip.ParseRule(list.action + " " + cidr)
+			if err != nil {
+				return nil, err
+			}
+			rule.Source = "config"
+			rules = append(rules, rule)
+		}
+	}
+
+	if cfg.IPOverlayFile != "" {
+		f, err := os.Open(cfg.IPOverlayFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		fileRules, err := chinaip.ParseRules(f, cfg.IPOverlayFile)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, fileRules...)
+	}
+	return rules, nil
+}
+
+// ExplainIP returns the effective local classification of `addr`, and the
+// overlay rule deciding it if any.
+func (s *Server) ExplainIP(addr netip.Addr) chinaip.Match {
+	return explainIP(s.resolver.localIPs, addr)
+}
+
+// LocalRanges returns the IPv4 ranges considered local, which are the China
+// IP list with the overlay rules applied. It fails with a country database,
+// whose local IPs can't be listed.
+func (s *Server) LocalRanges() ([]chinaip.Range, error) {
+	return localRanges(s.config, chinaip.Current())
+}
+
 // Run tcp and udp server.
 func (s *Server) Run() error {
-	errChan := make(chan error, 2)
+	errChan := make(chan error, 3)
+
+	if s.ipListUpdater != nil {
+		go s.updateIPList()
+	}
+
+	if s.resolver.hijack != nil {
+		go s.probeHijack()
+	}
+
+	if s.config.SelfTest {
+		go s.selfTestPeriodically()
+	}
+
+	if s.config.WarmupFile != "" {
+		go s.warmup()
+	}
+
+	if s.httpServer != nil {
+		go func() {
+			err := s.httpServer.ListenAndServe()
+			errChan <- err
+		}()
+	}
 
 	go func() {
 		err := s.tcpServer.ListenAndServe()
@@ -104,16 +413,29 @@ func (s *Server) Run() error {
 
 	select {
 	case err := <-errChan:
-		s.tcpServer.Shutdown()
-		s.udpServer.Shutdown()
+		s.Shutdown()
 		return err
 	}
 }
 
 // Shutdown shuts down the freedns server
 func (s *Server) Shutdown() {
+	// stop serving before closing the query log and the sinks they feed
 	s.tcpServer.Shutdown()
 	s.udpServer.Shutdown()
+	s.stopOnce.Do(func() {
+		close(s.done)
+		if s.httpServer != nil {
+			s.httpServer.Close()
+		}
+		if s.queryLog != nil {
+			s.queryLog.close()
+		}
+		// the ones of the caller are the caller's to close
+		for _, sink := range s.setSinks[len(s.config.SetSinks):] {
+			sink.Close()
+		}
+	})
 }
 
 func (s *Server) handle(w dns.ResponseWriter, req *dns.Msg, net string) {
@@ -128,15 +450,73 @@ func (s *Server) handle(w dns.ResponseWriter, req *dns.Msg, net string) {
 		}).Warn()
 		return
 	}
+	if !s.clientAllowed(w.RemoteAddr()) {
+		res.SetRcode(req, dns.RcodeRefused)
+		setEDE(res, edeProhibited, "")
+		s.finishEDE(req, res)
+		w.WriteMsg(res)
+		atomic.AddUint64(&s.stats.queries, 1)
+		atomic.AddUint64(&s.stats.failures, 1)
+		log.WithFields(logrus.Fields{
+			"op":     "handle",
+			"client": w.RemoteAddr().String(),
+			"domain": req.Question[0].Name,
+		}).Warn("client prohibited")
+		return
+	}
 
-	res, upstream := s.lookup(req, net)
+	start := time.Now()
+	// trace the upstream answers in the background for the query log
+	var trace *Trace
+	traced := make(chan struct{})
+	if s.queryLog != nil && s.config.QueryLogAnswers {
+		trace = &Trace{
+			Question: req.Question[0],
+			Done:     func() { close(traced) },
+		}
+	}
+	res, upstream := s.lookup(req, net, trace)
+	s.finishEDE(req, res)
+	// the upstreams may answer up to upstreamUDPSize
+	if net == "udp" {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		res.Truncate(size)
+	}
 	w.WriteMsg(res)
 
+	atomic.AddUint64(&s.stats.queries, 1)
+	if res.Rcode != dns.RcodeSuccess && res.Rcode != dns.RcodeNameError {
+		atomic.AddUint64(&s.stats.failures, 1)
+	}
+	if s.queryLog != nil {
+		entry := &QueryLogEntry{
+			Time:     start,
+			Client:   w.RemoteAddr().String(),
+			Name:     req.Question[0].Name,
+			Type:     QueryTypeString(req.Question[0].Qtype),
+			Net:      net,
+			Upstream: upstream,
+			Rcode:    dns.RcodeToString[res.Rcode],
+			Duration: float64(time.Since(start)) / float64(time.Millisecond),
+		}
+		if trace == nil {
+			s.queryLog.log(entry)
+		} else {
+			s.queryLog.logLater(entry, traced, func(e *QueryLogEntry) {
+				e.Fast = packAnswer(trace.Fast.Response)
+				e.Clean = packAnswer(trace.Clean.Response)
+			})
+		}
+	}
+
 	// logging
 	l := log.WithFields(logrus.Fields{
 		"op":       "handle",
 		"domain":   req.Question[0].Name,
-		"type":     dns.TypeToString[req.Question[0].Qtype],
+		"type":     QueryTypeString(req.Question[0].Qtype),
 		"upstream": upstream,
 		"status":   dns.RcodeToString[res.Rcode],
 	})
@@ -147,40 +527,148 @@ func (s *Server) handle(w dns.ResponseWriter, req *dns.Msg, net string) {
 	}
 }
 
+// Query resolves `q` like a request from a client over `net`, and returns
+// the response with the trace of the decisions made. Unlike the requests
+// from clients, it waits for both upstreams to complete the trace.
+func (s *Server) Query(q dns.Question, net string) (*dns.Msg, *Trace) {
+	req := &dns.Msg{
+		MsgHdr: dns.MsgHdr{
+			Id:               dns.Id(),
+			RecursionDesired: true,
+		},
+		Question: []dns.Question{q},
+	}
+	trace := &Trace{Question: q}
+	res, _ := s.lookup(req, net, trace)
+	return res, trace
+}
+
+// newResolver creates the resolver of `cfg`, with its answer policies, the
+// rebinding protection, the classification settings, and the hijack and
+// health trackers. The servers and the replays share it, so that they decide
+// alike.
+func newResolver(cfg Config, fast upstreamProvider, clean upstreamProvider, localIPs chinaip.Classifier, cacheCap int) (*spoofingProofResolver, error) {
+	if err := checkAnswerPolicies(cfg); err != nil {
+		return nil, err
+	}
+	resolver := newSpoofingProofResolver(fast, clean, localIPs, cacheCap)
+	resolver.localIPPolicy, resolver.mixedAnswers = cfg.LocalIPPolicy, cfg.MixedAnswers
+	resolver.noECH, resolver.filterAAAA = cfg.NoECH, cfg.FilterAAAA
+	if cfg.RebindProtection != "" {
+		var err error
+		if resolver.rebind, err = newRebindFilter(cfg.RebindProtection, cfg.RebindAllowDomains); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.ClassificationTTL > 0 {
+		resolver.classes.ttl = cfg.ClassificationTTL
+	}
+	if cfg.ClassificationVerifyInterval > 0 {
+		resolver.classes.verifyInterval = cfg.ClassificationVerifyInterval
+	}
+	if cfg.DetectHijack {
+		resolver.hijack = newHijackDetector()
+	}
+	if cfg.DegradeAfter > 0 {
+		resolver.health = newUpstreamHealth(cfg.DegradeAfter)
+	}
+	return resolver, nil
+}
+
+// answerByPolicy answers `req` without asking the upstreams if a policy of
+// `cfg` does: the AAAA queries with FilterAAAA, and the PTR queries of the
+// private addresses.
+func answerByPolicy(cfg Config, req *dns.Msg, trace *Trace) (*dns.Msg, string, bool) {
+	if cfg.FilterAAAA && req.Question[0].Qtype == dns.TypeAAAA {
+		res, upstream := answerLocally(req, dns.RcodeSuccess, "filter", "AAAA filtered", trace)
+		setEDE(res, edeFiltered, "AAAA filtered")
+		return res, upstream, true
+	}
+	// no upstream knows the names of the private addresses
+	if addr, ok := reverseAddr(req.Question[0]); ok && isPrivateAddr(addr) {
+		res, upstream := answerLocally(req, dns.RcodeNameError, "local", "private address", trace)
+		return res, upstream, true
+	}
+	return nil, "", false
+}
+
+// answerLocally answers `req` with no records and `rcode`, as if from
+// `upstream`, for `reason`.
+func answerLocally(req *dns.Msg, rcode int, upstream string, reason string, trace *Trace) (*dns.Msg, string) {
+	res := &dns.Msg{}
+	res.SetRcode(req, rcode)
+	res.RecursionAvailable = true
+	if trace != nil {
+		trace.Cache = "policy"
+		trace.Upstream = upstream
+		trace.Reason = reason
+		if trace.Done != nil {
+			trace.Done()
+		}
+	}
+	return res, upstream
+}
+
 // lookup queries the dns request `q` on either the local cache or upstreams,
 // and returns the result and which upstream is used. It updates the local cache
-// if necessary.
-func (s *Server) lookup(req *dns.Msg, net string) (*dns.Msg, string) {
+// if necessary. The decisions are recorded in `trace` if it is not nil.
+func (s *Server) lookup(req *dns.Msg, net string, trace *Trace) (*dns.Msg, string) {
+	if res, upstream, ok := answerByPolicy(s.config, req, trace); ok {
+		return res, upstream
+	}
+
 	// 1. lookup the cache first
-	res, upd := s.recordsCache.lookup(req.Question[0], req.RecursionDesired, net)
+	res, upd, expired := s.recordsCache.lookup(req.Question[0], req.RecursionDesired, net)
 	var upstream string
 
 	if res != nil {
 		if upd {
 			go func() {
-				r, u := s.resolver.resolve(req.Question[0], req.RecursionDesired, net)
+				r, u, role := s.resolver.resolve(req.Question[0], req.RecursionDesired, net, nil)
 				if r.Rcode == dns.RcodeSuccess {
 					log.WithFields(logrus.Fields{
 						"op":       "update_cache",
 						"domain":   req.Question[0].Name,
-						"type":     dns.TypeToString[req.Question[0].Qtype],
+						"type":     QueryTypeString(req.Question[0].Qtype),
 						"upstream": u,
 					}).Info()
 					s.recordsCache.set(r, net)
+					s.sinkAnswer(r, role)
 				}
 			}()
 		}
 		upstream = "cache"
+		atomic.AddUint64(&s.stats.cacheHits, 1)
+		// the ones refreshed ahead of the expiry are not stale yet
+		if expired {
+			atomic.AddUint64(&s.stats.staleHits, 1)
+			setEDE(res, edeStaleAnswer, "")
+		}
+		if trace != nil {
+			trace.Cache = "hit"
+			if upd {
+				trace.Cache = "stale"
+			}
+			trace.Upstream = upstream
+			if trace.Done != nil {
+				trace.Done()
+			}
+		}
 	} else {
-		res, upstream = s.resolver.resolve(req.Question[0], req.RecursionDesired, net)
+		if trace != nil {
+			trace.Cache = "miss"
+		}
+		var role int
+		res, upstream, role = s.resolver.resolve(req.Question[0], req.RecursionDesired, net, trace)
 		if res.Rcode == dns.RcodeSuccess {
 			log.WithFields(logrus.Fields{
 				"op":       "update_cache",
 				"domain":   req.Question[0].Name,
-				"type":     dns.TypeToString[req.Question[0].Qtype],
+				"type":     QueryTypeString(req.Question[0].Qtype),
 				"upstream": upstream,
 			}).Info()
 			s.recordsCache.set(res, net)
+			s.sinkAnswer(res, role)
 		}
 	}
 
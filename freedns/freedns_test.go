@@ -1,48 +1,65 @@
 package freedns
 
 import (
+	"io/ioutil"
+	"net/netip"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
 )
 
 func TestSmokingNewRunAndShutdown(t *testing.T) {
+	w := newTestWorld(t)
+
 	// new the server
 	s, err := NewServer(Config{
-		FastUpstream:  "114.114.114.114",
-		CleanUpstream: "8.8.8.8",
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
 		Listen:        "127.0.0.1:52345",
 		CacheCap:      1024 * 5,
 	})
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
 	// run the server
-	shut := make(chan bool, 2)
+	runErr := make(chan error, 1)
 	go func() {
-		err := s.Run()
-		if err != nil {
+		runErr <- s.Run()
+	}()
+	defer func() {
+		s.Shutdown()
+		if err := <-runErr; err != nil {
 			t.Error(err)
 		}
-		_ = <-shut
-		s.Shutdown()
 	}()
 
+	// wait for the server to be up
+	warmup := dns.Question{Name: "warmup.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	for i := 0; i < 10; i++ {
+		if _, err := naiveResolve(warmup, true, "udp", "127.0.0.1:52345"); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	before := s.Stats()
+
 	tests := []struct {
 		domain           string
 		qtype            uint16
 		net              string
 		expectedUpstream string
 	}{
-		{"ustc.edu.cn.", dns.TypeMX, "udp", "8.8.8.8:53"},
-		{"ustc.edu.cn.", dns.TypeA, "udp", "114.114.114.114:53"},
-		{"ustc.edu.cn.", dns.TypeMX, "udp", "114.114.114.114:53"},
-		{"google.com.", dns.TypeA, "udp", "8.8.8.8:53"},
-		{"mi.cn.", dns.TypeA, "udp", "114.114.114.114:53"},
-		{"xiaomi.com.", dns.TypeA, "udp", "114.114.114.114:53"},
-		{"youtube.com.", dns.TypeA, "udp", "8.8.8.8:53"},
-		{"twitter.com.", dns.TypeA, "tcp", "8.8.8.8:53"},
+		{"ustc.edu.cn.", dns.TypeMX, "udp", w.clean.Addr},
+		{"ustc.edu.cn.", dns.TypeA, "udp", w.fast.Addr},
+		{"ustc.edu.cn.", dns.TypeMX, "udp", w.fast.Addr},
+		{"google.com.", dns.TypeA, "udp", w.clean.Addr},
+		{"mi.cn.", dns.TypeA, "udp", w.fast.Addr},
+		{"google.com.", dns.TypeA, "tcp", w.clean.Addr},
+		{"twitter.com.", dns.TypeA, "tcp", w.clean.Addr},
 	}
 
 	for _, tt := range tests {
@@ -71,8 +88,125 @@ func TestSmokingNewRunAndShutdown(t *testing.T) {
 
 		if len(want.Answer) != len(got.Answer) || len(want.Question) != len(got.Question) || len(want.Extra) != len(got.Extra) {
 			t.Errorf("got different resolve results from expectedUpstream and freedns")
+		} else if firstAnswer(want) != firstAnswer(got) {
+			t.Errorf("%s: got %q, want %q", tt.domain, firstAnswer(got), firstAnswer(want))
+		}
+	}
+
+	if stats := s.Stats(); stats.Queries-before.Queries != uint64(len(tests)) || stats.CacheHits-before.CacheHits != 1 {
+		t.Errorf("got stats %+v, before %+v", stats, before)
+	}
+}
+
+func TestExplainIP(t *testing.T) {
+	tempfile, err := ioutil.TempFile("", "test_overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tempfile.Name())
+	tempfile.WriteString("include 8.8.8.0/24\n")
+	tempfile.Close()
+
+	s, err := NewServer(Config{
+		FastUpstream:  "114.114.114.114",
+		CleanUpstream: "8.8.8.8",
+		Listen:        "127.0.0.1:52345",
+		CacheCap:      1024,
+		ExcludeCIDRs:  []string{"114.114.0.0/16"},
+		IPOverlayFile: tempfile.Name(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ip    string
+		local bool
+		rule  bool
+	}{
+		{"8.8.8.8", true, true},
+		{"114.114.114.114", false, true},
+		{"1.0.1.1", true, false},
+		{"1.1.1.1", false, false},
+	}
+	for _, tt := range tests {
+		m := s.ExplainIP(netip.MustParseAddr(tt.ip))
+		if m.Local != tt.local || (m.Rule != nil) != tt.rule {
+			t.Errorf("%s: got %+v", tt.ip, m)
 		}
 	}
 
-	shut <- true
+	if _, err := NewServer(Config{FastUpstream: "114.114.114.114", CleanUpstream: "8.8.8.8", IncludeCIDRs: []string{"wtf"}}); err == nil {
+		t.Errorf("invalid CIDR should be rejected")
+	}
+}
+
+func TestLocalRanges(t *testing.T) {
+	s, err := NewServer(Config{
+		FastUpstream:  "114.114.114.114",
+		CleanUpstream: "8.8.8.8",
+		CacheCap:      1024,
+		IncludeCIDRs:  []string{"8.8.8.0/24"},
+		ExcludeCIDRs:  []string{"114.114.0.0/16"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranges, err := s.LocalRanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := chinaip.NewSet(ranges)
+	for ip, want := range map[string]bool{"8.8.8.8": true, "114.114.114.114": false, "1.0.1.1": true, "1.1.1.1": false} {
+		if set.Contains(netip.MustParseAddr(ip)) != want {
+			t.Errorf("%s should be local: %v", ip, want)
+		}
+	}
+
+	s = &Server{config: Config{CountryDB: "GeoLite2-Country.mmdb"}}
+	if _, err := s.LocalRanges(); err == nil {
+		t.Errorf("the country database can't be listed")
+	}
+}
+
+func TestQueryTrace(t *testing.T) {
+	w := newTestWorld(t)
+	s, err := NewServer(Config{
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
+		CacheCap:      1024,
+		FilterAAAA:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := func(name string, qtype uint16) *Trace {
+		_, trace := s.Query(dns.Question{Name: name, Qtype: qtype, Qclass: dns.ClassINET}, "udp")
+		return trace
+	}
+
+	// the fast upstream is poisoned
+	trace := query("google.com.", dns.TypeA)
+	if trace.Cache != "miss" || trace.Classification != "unknown" || trace.Upstream != w.clean.Addr || trace.Reason != "fast answer has no local IPs" {
+		t.Errorf("got %+v", trace)
+	}
+	if trace.Fast.Upstream != w.fast.Addr || trace.Fast.Response == nil || firstAnswer(trace.Fast.Response) == "142.250.66.78" {
+		t.Errorf("got the fast trace %+v", trace.Fast)
+	}
+	if trace.Clean.Upstream != w.clean.Addr || trace.Clean.Err != nil || firstAnswer(trace.Clean.Response) != "142.250.66.78" || trace.Clean.RTT <= 0 {
+		t.Errorf("got the clean trace %+v", trace.Clean)
+	}
+
+	query("ustc.edu.cn.", dns.TypeA)
+	trace = query("ustc.edu.cn.", dns.TypeMX)
+	if trace.Classification != "china" || trace.LocalAnswers != 1 || trace.ForeignAnswers != 0 || trace.Upstream != w.fast.Addr || trace.Reason != "cached classification: china" {
+		t.Errorf("got %+v", trace)
+	}
+
+	if trace = query("google.com.", dns.TypeA); trace.Cache != "hit" || trace.Upstream != "cache" {
+		t.Errorf("got %+v", trace)
+	}
+	if trace = query("google.com.", dns.TypeAAAA); trace.Cache != "policy" || trace.Upstream != "filter" || trace.Reason != "AAAA filtered" {
+		t.Errorf("got %+v", trace)
+	}
 }
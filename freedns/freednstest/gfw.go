@@ -0,0 +1,182 @@
+package freednstest
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// GFW simulates the DNS poisoning on the path to an upstream. It relays the
+// queries to the upstream, and for the poisoned domains it injects a forged
+// reply ahead of the real one over udp, which is the reply clients accept.
+// Like the real one, it answers A records whatever the query type is, and
+// leaves tcp alone.
+type GFW struct {
+	// Addr is the ip:port to reach the upstream through the GFW.
+	Addr string
+
+	upstream string
+
+	mu       sync.Mutex
+	poisoned map[string][]net.IP
+	injected int
+	delay    time.Duration
+
+	pc net.PacketConn
+	l  net.Listener
+	wg sync.WaitGroup
+}
+
+// NewGFW starts a GFW in front of `upstream` on a random local port.
+func NewGFW(upstream string) (*GFW, error) {
+	pc, l, err := listenLocal()
+	if err != nil {
+		return nil, err
+	}
+	g := &GFW{
+		Addr:     pc.LocalAddr().String(),
+		upstream: upstream,
+		poisoned: make(map[string][]net.IP),
+		pc:       pc,
+		l:        l,
+	}
+	g.wg.Add(2)
+	go g.serveUDP()
+	go g.serveTCP()
+	return g, nil
+}
+
+// Poison forges the replies to the queries of `domain` and its subdomains
+// with `ips`, or a fixed foreign IP if none is given.
+func (g *GFW) Poison(domain string, ips ...string) {
+	if len(ips) == 0 {
+		ips = []string{"31.13.64.1"}
+	}
+	var forged []net.IP
+	for _, ip := range ips {
+		forged = append(forged, net.ParseIP(ip).To4())
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.poisoned[strings.ToLower(dns.Fqdn(domain))] = forged
+}
+
+// Delay delays the forged replies by `d`, like a GFW far away on the path.
+func (g *GFW) Delay(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.delay = d
+}
+
+// Injected returns how many forged replies are injected.
+func (g *GFW) Injected() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.injected
+}
+
+// Close stops the GFW.
+func (g *GFW) Close() {
+	g.pc.Close()
+	g.l.Close()
+	g.wg.Wait()
+}
+
+// forged returns the forged IPs for `name`, or nil if it is not poisoned,
+// and the delay of the forged reply.
+func (g *GFW) forged(name string) ([]net.IP, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	name = strings.ToLower(name)
+	for {
+		if ips, ok := g.poisoned[name]; ok {
+			g.injected++
+			return ips, g.delay
+		}
+		i := strings.IndexByte(name, '.')
+		if i < 0 || i == len(name)-1 {
+			return nil, 0
+		}
+		name = name[i+1:]
+	}
+}
+
+func (g *GFW) serveUDP() {
+	defer g.wg.Done()
+	buf := make([]byte, dns.MaxMsgSize)
+	for {
+		n, client, err := g.pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		packet := append([]byte(nil), buf[:n]...)
+
+		req := &dns.Msg{}
+		if req.Unpack(packet) == nil && len(req.Question) == 1 {
+			if ips, delay := g.forged(req.Question[0].Name); ips != nil {
+				res := &dns.Msg{}
+				res.SetReply(req)
+				for _, ip := range ips {
+					res.Answer = append(res.Answer, &dns.A{
+						Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+						A:   ip,
+					})
+				}
+				if forged, err := res.Pack(); err == nil {
+					time.AfterFunc(delay, func() {
+						g.pc.WriteTo(forged, client)
+					})
+				}
+			}
+		}
+
+		go g.relayUDP(packet, client)
+	}
+}
+
+// relayUDP relays the query to the upstream, and the reply back to the client.
+func (g *GFW) relayUDP(packet []byte, client net.Addr) {
+	conn, err := net.Dial("udp", g.upstream)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write(packet); err != nil {
+		return
+	}
+	buf := make([]byte, dns.MaxMsgSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return
+	}
+	g.pc.WriteTo(buf[:n], client)
+}
+
+func (g *GFW) serveTCP() {
+	defer g.wg.Done()
+	for {
+		client, err := g.l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer client.Close()
+			upstream, err := net.Dial("tcp", g.upstream)
+			if err != nil {
+				return
+			}
+			defer upstream.Close()
+			go func() {
+				io.Copy(upstream, client)
+				upstream.Close()
+			}()
+			io.Copy(client, upstream)
+		}()
+	}
+}
@@ -0,0 +1,57 @@
+package freednstest
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestGFW(t *testing.T) {
+	u, err := NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer u.Close()
+	u.Handle("google.com", dns.TypeA, IPs("google.com", "142.250.66.78"))
+	u.Handle("www.google.com", dns.TypeA, IPs("www.google.com", "142.250.66.78"))
+	u.Handle("example.com", dns.TypeA, IPs("example.com", "93.184.216.34"))
+
+	g, err := NewGFW(u.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	g.Poison("google.com")
+	g.Poison("twitter.com", "8.7.198.45")
+
+	tests := []struct {
+		net   string
+		name  string
+		qtype uint16
+		want  string
+	}{
+		{"udp", "google.com.", dns.TypeA, "31.13.64.1"},
+		{"udp", "www.google.com.", dns.TypeA, "31.13.64.1"},
+		{"udp", "twitter.com.", dns.TypeAAAA, "8.7.198.45"},
+		{"udp", "example.com.", dns.TypeA, "93.184.216.34"},
+		{"udp", "notgoogle.com.", dns.TypeA, ""},
+		{"tcp", "google.com.", dns.TypeA, "142.250.66.78"},
+	}
+	for _, tt := range tests {
+		res, _, err := exchange(t, tt.net, g.Addr, tt.name, tt.qtype)
+		if err != nil {
+			t.Errorf("%s over %s: %v", tt.name, tt.net, err)
+			continue
+		}
+		got := ""
+		if len(res.Answer) > 0 {
+			got = res.Answer[0].(*dns.A).A.String()
+		}
+		if got != tt.want {
+			t.Errorf("%s over %s: got %q, want %q", tt.name, tt.net, got, tt.want)
+		}
+	}
+	if g.Injected() != 3 {
+		t.Errorf("got %d forged replies, want 3", g.Injected())
+	}
+}
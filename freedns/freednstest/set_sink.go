@@ -0,0 +1,79 @@
+package freednstest
+
+import (
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// SetEntry is an IP added to a SetSink.
+type SetEntry struct {
+	Domain string
+	Addr   netip.Addr
+	TTL    time.Duration
+}
+
+// SetSink records the IPs added to it, implementing freedns.SetSink.
+type SetSink struct {
+	mu      sync.Mutex
+	entries []SetEntry
+	closed  bool
+	added   chan struct{}
+}
+
+// NewSetSink creates an empty SetSink.
+func NewSetSink() *SetSink {
+	return &SetSink{added: make(chan struct{}, 1)}
+}
+
+// Add records the IPs.
+func (s *SetSink) Add(domain string, addrs []netip.Addr, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, addr := range addrs {
+		s.entries = append(s.entries, SetEntry{domain, addr, ttl})
+	}
+	select {
+	case s.added <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close records that the sink is closed.
+func (s *SetSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// Closed tells if the sink is closed.
+func (s *SetSink) Closed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// Entries returns the IPs added so far.
+func (s *SetSink) Entries() []SetEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SetEntry(nil), s.entries...)
+}
+
+// Wait waits until `n` IPs are added or `timeout` elapses, as the IPs may be
+// added in the background, and returns the IPs added.
+func (s *SetSink) Wait(n int, timeout time.Duration) []SetEntry {
+	deadline := time.After(timeout)
+	for {
+		if entries := s.Entries(); len(entries) >= n {
+			return entries
+		}
+		select {
+		case <-s.added:
+		case <-deadline:
+			return s.Entries()
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Package freednstest provides fake DNS upstreams and a poisoning simulator,
+// to test how DNS requests are routed without reaching the network.
+package freednstest
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Answer is the scripted answer of an upstream to a question.
+type Answer struct {
+	Rcode  int
+	Answer []dns.RR
+	Ns     []dns.RR
+	Extra  []dns.RR
+
+	// Delay is the time to wait before replying.
+	Delay time.Duration
+	// Drop never replies.
+	Drop bool
+	// Truncate replies over udp with the TC bit set and no records,
+	// to make the client retry over tcp.
+	Truncate bool
+}
+
+// IPs returns the answer of A and AAAA records of `name` pointing to `ips`.
+func IPs(name string, ips ...string) Answer {
+	var a Answer
+	for _, ip := range ips {
+		hdr := dns.RR_Header{Name: dns.Fqdn(name), Class: dns.ClassINET, Ttl: 300}
+		addr := net.ParseIP(ip)
+		if addr.To4() != nil {
+			hdr.Rrtype = dns.TypeA
+			a.Answer = append(a.Answer, &dns.A{Hdr: hdr, A: addr.To4()})
+		} else {
+			hdr.Rrtype = dns.TypeAAAA
+			a.Answer = append(a.Answer, &dns.AAAA{Hdr: hdr, AAAA: addr})
+		}
+	}
+	return a
+}
+
+// RRs returns the answer of the records in the zone file format,
+// e.g. "example.com. 300 IN MX 10 mail.example.com.". It panics on invalid
+// records.
+func RRs(records ...string) Answer {
+	var a Answer
+	for _, s := range records {
+		rr, err := dns.NewRR(s)
+		if err != nil {
+			panic(err)
+		}
+		a.Answer = append(a.Answer, rr)
+	}
+	return a
+}
+
+// Query is a query received by an upstream.
+type Query struct {
+	Question dns.Question
+	Net      string
+}
+
+type answerKey struct {
+	name  string
+	qtype uint16
+}
+
+// Upstream is a fake DNS server listening on the same local port over udp
+// and tcp. It replies the scripted answers, forwards the other queries if a
+// forwarder is set, and replies NXDOMAIN otherwise.
+type Upstream struct {
+	// Addr is the ip:port the upstream listens on.
+	Addr string
+
+	mu       sync.Mutex
+	answers  map[answerKey]Answer
+	fallback func(q dns.Question) Answer
+	forward  string
+	queries  []Query
+
+	udpServer *dns.Server
+	tcpServer *dns.Server
+}
+
+// NewUpstream starts a fake upstream on a random local port.
+func NewUpstream() (*Upstream, error) {
+	pc, l, err := listenLocal()
+	if err != nil {
+		return nil, err
+	}
+	u := &Upstream{
+		Addr:    pc.LocalAddr().String(),
+		answers: make(map[answerKey]Answer),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	u.udpServer = &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(u.serve), NotifyStartedFunc: wg.Done}
+	u.tcpServer = &dns.Server{Listener: l, Handler: dns.HandlerFunc(u.serve), NotifyStartedFunc: wg.Done}
+	go u.udpServer.ActivateAndServe()
+	go u.tcpServer.ActivateAndServe()
+	wg.Wait()
+	return u, nil
+}
+
+// Handle scripts the answer to the queries of `name` and `qtype`.
+// dns.TypeANY matches the queries of any type without a specific answer.
+func (u *Upstream) Handle(name string, qtype uint16, a Answer) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.answers[answerKey{strings.ToLower(dns.Fqdn(name)), qtype}] = a
+}
+
+// HandleFunc answers the queries without a scripted answer with `f`.
+func (u *Upstream) HandleFunc(f func(q dns.Question) Answer) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.fallback = f
+}
+
+// Forward forwards the queries without a scripted answer to `upstream` over
+// udp, like a recursive resolver.
+func (u *Upstream) Forward(upstream string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.forward = upstream
+}
+
+// Queries returns the queries received so far.
+func (u *Upstream) Queries() []Query {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]Query(nil), u.queries...)
+}
+
+// Close stops the upstream.
+func (u *Upstream) Close() {
+	u.udpServer.Shutdown()
+	u.tcpServer.Shutdown()
+}
+
+func (u *Upstream) serve(w dns.ResponseWriter, req *dns.Msg) {
+	if len(req.Question) != 1 {
+		return
+	}
+	q := req.Question[0]
+	network := "tcp"
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		network = "udp"
+	}
+
+	u.mu.Lock()
+	u.queries = append(u.queries, Query{q, network})
+	a, ok := u.answers[answerKey{strings.ToLower(q.Name), q.Qtype}]
+	if !ok {
+		a, ok = u.answers[answerKey{strings.ToLower(q.Name), dns.TypeANY}]
+	}
+	fallback, forward := u.fallback, u.forward
+	u.mu.Unlock()
+
+	if !ok {
+		switch {
+		case fallback != nil:
+			a = fallback(q)
+		case forward != "":
+			res, _, err := (&dns.Client{}).Exchange(req, forward)
+			if err != nil {
+				a.Rcode = dns.RcodeServerFailure
+				break
+			}
+			a = Answer{Rcode: res.Rcode, Answer: res.Answer, Ns: res.Ns, Extra: res.Extra}
+		default:
+			a.Rcode = dns.RcodeNameError
+		}
+	}
+
+	time.Sleep(a.Delay)
+	if a.Drop {
+		return
+	}
+	res := &dns.Msg{}
+	res.SetRcode(req, a.Rcode)
+	res.RecursionAvailable = true
+	if a.Truncate && network == "udp" {
+		res.Truncated = true
+	} else {
+		res.Answer, res.Ns, res.Extra = a.Answer, a.Ns, a.Extra
+	}
+	w.WriteMsg(res)
+}
+
+// listenLocal listens on the same random local port over udp and tcp.
+func listenLocal() (net.PacketConn, net.Listener, error) {
+	var err error
+	for i := 0; i < 10; i++ {
+		var pc net.PacketConn
+		pc, err = net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			return nil, nil, err
+		}
+		var l net.Listener
+		if l, err = net.Listen("tcp", pc.LocalAddr().String()); err == nil {
+			return pc, l, nil
+		}
+		// the tcp port is taken, try another one
+		pc.Close()
+	}
+	return nil, nil, err
+}
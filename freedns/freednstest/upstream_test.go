@@ -0,0 +1,89 @@
+package freednstest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func exchange(t *testing.T, net string, addr string, name string, qtype uint16) (*dns.Msg, time.Duration, error) {
+	t.Helper()
+	req := &dns.Msg{}
+	req.SetQuestion(name, qtype)
+	c := &dns.Client{Net: net, Timeout: 500 * time.Millisecond}
+	return c.Exchange(req, addr)
+}
+
+func TestUpstream(t *testing.T) {
+	u, err := NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer u.Close()
+
+	u.Handle("example.com", dns.TypeA, IPs("example.com", "1.2.3.4", "2001:db8::1"))
+	u.Handle("example.com", dns.TypeANY, RRs("example.com. 300 IN MX 10 mail.example.com."))
+	u.Handle("slow.example.com", dns.TypeA, Answer{Delay: 200 * time.Millisecond})
+	u.Handle("drop.example.com", dns.TypeA, Answer{Drop: true})
+	u.Handle("big.example.com", dns.TypeA, Answer{Truncate: true, Answer: IPs("big.example.com", "1.2.3.4").Answer})
+
+	res, _, err := exchange(t, "udp", u.Addr, "EXAMPLE.com.", dns.TypeA)
+	if err != nil || len(res.Answer) != 2 || res.Answer[0].(*dns.A).A.String() != "1.2.3.4" {
+		t.Errorf("scripted answer: got %v, %v", res, err)
+	}
+	res, _, err = exchange(t, "tcp", u.Addr, "example.com.", dns.TypeMX)
+	if err != nil || len(res.Answer) != 1 || res.Answer[0].Header().Rrtype != dns.TypeMX {
+		t.Errorf("wildcard answer: got %v, %v", res, err)
+	}
+	res, _, err = exchange(t, "udp", u.Addr, "other.example.com.", dns.TypeA)
+	if err != nil || res.Rcode != dns.RcodeNameError {
+		t.Errorf("unscripted answer: got %v, %v", res, err)
+	}
+	if _, rtt, err := exchange(t, "udp", u.Addr, "slow.example.com.", dns.TypeA); err != nil || rtt < 200*time.Millisecond {
+		t.Errorf("delayed answer: got %v, %v", rtt, err)
+	}
+	if _, _, err := exchange(t, "udp", u.Addr, "drop.example.com.", dns.TypeA); err == nil {
+		t.Errorf("dropped answer should time out")
+	}
+	res, _, err = exchange(t, "udp", u.Addr, "big.example.com.", dns.TypeA)
+	if err != nil || !res.Truncated || len(res.Answer) != 0 {
+		t.Errorf("truncated answer over udp: got %v, %v", res, err)
+	}
+	res, _, err = exchange(t, "tcp", u.Addr, "big.example.com.", dns.TypeA)
+	if err != nil || res.Truncated || len(res.Answer) != 1 {
+		t.Errorf("truncated answer over tcp: got %v, %v", res, err)
+	}
+
+	queries := u.Queries()
+	if len(queries) != 7 || queries[1].Net != "tcp" || queries[1].Question.Qtype != dns.TypeMX {
+		t.Errorf("got queries %v", queries)
+	}
+}
+
+func TestUpstreamForward(t *testing.T) {
+	auth, err := NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer auth.Close()
+	auth.Handle("example.com", dns.TypeA, IPs("example.com", "1.2.3.4"))
+
+	u, err := NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer u.Close()
+	u.Forward(auth.Addr)
+	u.Handle("local.example.com", dns.TypeA, IPs("local.example.com", "10.0.0.1"))
+
+	for name, want := range map[string]int{"example.com.": 1, "local.example.com.": 1, "nx.example.com.": 0} {
+		res, _, err := exchange(t, "tcp", u.Addr, name, dns.TypeA)
+		if err != nil || len(res.Answer) != want {
+			t.Errorf("%s: got %v, %v", name, res, err)
+		}
+	}
+	if queries := auth.Queries(); len(queries) != 2 || queries[0].Net != "udp" {
+		t.Errorf("should forward the unscripted queries over udp, got %v", queries)
+	}
+}
@@ -0,0 +1,94 @@
+package freedns
+
+import (
+	"sync"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// The roles of the upstreams.
+const (
+	roleFast = iota
+	roleClean
+)
+
+var roleNames = [2]string{"fast", "clean"}
+
+// degradedTTL caps the TTLs of the answers served by the other upstream
+// while one is down, so that they don't outlive the outage in caches.
+const degradedTTL = 10
+
+// upstreamHealth counts the consecutive transport failures and timeouts of
+// the upstreams, to degrade to the other one when one is down.
+type upstreamHealth struct {
+	// degradedAnswers is updated atomically, and comes first to be 64-bit
+	// aligned on 32-bit platforms
+	degradedAnswers uint64
+
+	after int
+
+	mu       sync.Mutex
+	failures [2]int
+}
+
+func newUpstreamHealth(after int) *upstreamHealth {
+	return &upstreamHealth{after: after}
+}
+
+// record records whether an exchange with the upstream of `role` succeeded.
+func (h *upstreamHealth) record(role int, upstream string, ok bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l := log.WithFields(logrus.Fields{
+		"op":       "upstream_health",
+		"role":     roleNames[role],
+		"upstream": upstream,
+	})
+	if ok {
+		if h.failures[role] >= h.after {
+			l.Warn("recovered")
+		}
+		h.failures[role] = 0
+		return
+	}
+	h.failures[role]++
+	if h.failures[role] == h.after {
+		l.Warnf("degraded after %d consecutive failures", h.after)
+	}
+}
+
+// down returns whether the upstream of `role` is down, and the other one is
+// not, so that the answers of the other one are served.
+func (h *upstreamHealth) down(role int) bool {
+	if h == nil {
+		return false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failures[role] >= h.after && h.failures[1-role] < h.after
+}
+
+// upstreamUnreachable returns whether the exchange with an upstream failed
+// on the transport or timed out. The rcodes it answers, like the SERVFAILs
+// of the DNSSEC-bogus names, tell nothing of its health.
+func upstreamUnreachable(res *dns.Msg, err error) bool {
+	return err != nil || res == nil
+}
+
+// upstreamFailed returns whether the exchange with an upstream failed, or
+// its answer is a failure.
+func upstreamFailed(res *dns.Msg, err error) bool {
+	return err != nil || res == nil || res.Rcode == dns.RcodeServerFailure || res.Rcode == dns.RcodeRefused
+}
+
+// capTTL caps the TTLs of the records in `res` to `ttl`.
+func capTTL(res *dns.Msg, ttl uint32) {
+	for _, rrs := range [][]dns.RR{res.Answer, res.Ns, res.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype != dns.TypeOPT && rr.Header().Ttl > ttl {
+				rr.Header().Ttl = ttl
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package freedns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestDegradedMode(t *testing.T) {
+	w := newTestWorld(t)
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+	resolver.health = newUpstreamHealth(2)
+
+	resolve := func(name string, upstream string, reason string, answer string) *dns.Msg {
+		t.Helper()
+		q := dns.Question{Name: name, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+		res, u, _ := resolver.resolve(q, true, "udp", trace)
+		if u != upstream || trace.Reason != reason || firstAnswer(res) != answer {
+			t.Errorf("%s: got %q from %v: %v", name, firstAnswer(res), u, trace.Reason)
+		}
+		return res
+	}
+
+	// the SERVFAILs it answers don't tell the clean upstream is down
+	w.clean.HandleFunc(func(q dns.Question) freednstest.Answer {
+		return freednstest.Answer{Rcode: dns.RcodeServerFailure}
+	})
+	for i := 0; i < 3; i++ {
+		resolve("google.com.", w.clean.Addr, "fast answer has no local IPs", "")
+	}
+	w.clean.HandleFunc(nil)
+
+	// but refusing the connections does, as nothing listens on the port
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := conn.LocalAddr().String()
+	conn.Close()
+	clean := resolver.cleanUpstreamProvider.(*staticUpstreamProvider)
+	clean.upstream = closed
+	resolve("google.com.", closed, "fast answer has no local IPs", "")
+	resolve("twitter.com.", closed, "fast answer has no local IPs", "")
+
+	// then the answers of the fast upstream are served, with short TTLs
+	res := resolve("google.com.", w.fast.Addr, "fast answer has no local IPs, clean upstream down", "31.13.64.1")
+	if ttl := res.Answer[0].Header().Ttl; ttl > degradedTTL {
+		t.Errorf("got ttl %d of the degraded answer", ttl)
+	}
+	resolve("ustc.edu.cn.", w.fast.Addr, "fast answer contains local IPs", "202.38.64.246")
+
+	// the clean upstream is back, and the answer degraded is still
+	// classified as unknown
+	clean.upstream = w.clean.Addr
+	resolve("google.com.", w.fast.Addr, "fast answer has no local IPs, clean upstream down", "31.13.64.1")
+	for i := 0; resolver.health.down(roleClean); i++ {
+		if i == 100 {
+			t.Fatal("the clean upstream should recover")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	resolve("google.com.", w.clean.Addr, "fast answer has no local IPs", "142.250.66.78")
+
+	if resolver.health.degradedAnswers != 2 {
+		t.Errorf("got %d degraded answers, want 2", resolver.health.degradedAnswers)
+	}
+}
+
+func TestUpstreamHealth(t *testing.T) {
+	h := newUpstreamHealth(2)
+	h.record(roleFast, "fast", false)
+	if h.down(roleFast) {
+		t.Errorf("fast should not be down after 1 failure")
+	}
+	h.record(roleFast, "fast", false)
+	if !h.down(roleFast) || h.down(roleClean) {
+		t.Errorf("fast should be down after 2 failures")
+	}
+	// both are down, so neither is degraded to the other
+	h.record(roleClean, "clean", false)
+	h.record(roleClean, "clean", false)
+	if h.down(roleFast) || h.down(roleClean) {
+		t.Errorf("neither should be down when both fail")
+	}
+	h.record(roleClean, "clean", true)
+	if !h.down(roleFast) {
+		t.Errorf("fast should be down once clean recovers")
+	}
+
+	var nilHealth *upstreamHealth
+	if nilHealth.down(roleFast) {
+		t.Errorf("nothing should be down without the health check")
+	}
+}
@@ -0,0 +1,145 @@
+package freedns
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// hijackProbeTLDs are the TLDs of the nonexistent names probing the fast
+// upstream, as some ISPs only hijack some of them.
+var hijackProbeTLDs = []string{"com.", "net.", "cn."}
+
+// hijackDetector learns the IPs an upstream answers nonexistent names with,
+// which ISPs use to redirect the mistyped names to their ad servers.
+type hijackDetector struct {
+	mu sync.Mutex
+	// ips are the learned hijack IPs, and when they expire
+	ips map[netip.Addr]time.Time
+}
+
+func newHijackDetector() *hijackDetector {
+	return &hijackDetector{ips: make(map[netip.Addr]time.Time)}
+}
+
+// probe queries random nonexistent names with `exchange`, and learns the IPs
+// in the answers for `ttl`. It returns the IPs learned.
+func (d *hijackDetector) probe(exchange func(q dns.Question) (*dns.Msg, error), ttl time.Duration) ([]netip.Addr, error) {
+	var learned []netip.Addr
+	var lastErr error
+	answered := 0
+	for _, tld := range hijackProbeTLDs {
+		label := make([]byte, 8)
+		rand.Read(label)
+		q := dns.Question{Name: "freedns-probe-" + hex.EncodeToString(label) + "." + tld, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		res, err := exchange(q)
+		if err != nil || res == nil {
+			lastErr = err
+			continue
+		}
+		answered++
+		if res.Rcode != dns.RcodeSuccess {
+			continue
+		}
+		learned = append(learned, answerIPs(res)...)
+	}
+	if answered == 0 {
+		if lastErr == nil {
+			lastErr = Error("no answer")
+		}
+		return nil, lastErr
+	}
+	d.learn(learned, ttl)
+	return learned, nil
+}
+
+// learn takes `ips` as hijack IPs for `ttl`, and forgets the expired ones.
+func (d *hijackDetector) learn(ips []netip.Addr, ttl time.Duration) {
+	expire := time.Now().Add(ttl)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, ip := range ips {
+		d.ips[ip] = expire
+	}
+	for ip, t := range d.ips {
+		if t.Before(time.Now()) {
+			delete(d.ips, ip)
+		}
+	}
+}
+
+// hijacked returns whether `res` contains a learned hijack IP.
+func (d *hijackDetector) hijacked(res *dns.Msg) bool {
+	ips := answerIPs(res)
+	if len(ips) == 0 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, ip := range ips {
+		if _, ok := d.ips[ip]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// answerIPs returns the A and AAAA addresses in the answer section.
+func answerIPs(res *dns.Msg) []netip.Addr {
+	var ips []netip.Addr
+	for _, rr := range res.Answer {
+		var ip netip.Addr
+		switch rr := rr.(type) {
+		case *dns.A:
+			ip, _ = netip.AddrFromSlice(rr.A.To4())
+		case *dns.AAAA:
+			ip, _ = netip.AddrFromSlice(rr.AAAA)
+		default:
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	return ips
+}
+
+// probeHijack probes the fast upstream for NXDOMAIN hijacking at startup and
+// every HijackProbeInterval until the server is shut down.
+func (s *Server) probeHijack() {
+	interval := s.config.HijackProbeInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		upstream := s.resolver.fastUpstreamProvider.GetUpstream()
+		l := log.WithFields(logrus.Fields{
+			"op":       "probe_hijack",
+			"upstream": upstream,
+		})
+		// the IPs are kept for a few intervals, as the hijacking may be
+		// intermittent
+		ips, err := s.resolver.hijack.probe(func(q dns.Question) (*dns.Msg, error) {
+			return s.resolver.exchange(q, true, "udp", upstream)
+		}, 3*interval)
+		if err != nil {
+			l.Warn(err)
+		} else if len(ips) > 0 {
+			l.WithField("ips", ips).Warn("the fast upstream hijacks NXDOMAIN")
+		} else {
+			l.Debug()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package freedns
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestHijackDetector(t *testing.T) {
+	fast, err := freednstest.NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fast.Close()
+	clean, err := freednstest.NewUpstream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clean.Close()
+
+	// the fast upstream answers the nonexistent names with an ad server
+	// in China
+	fast.Handle("ustc.edu.cn", dns.TypeA, freednstest.IPs("ustc.edu.cn", "202.38.64.246"))
+	fast.HandleFunc(func(q dns.Question) freednstest.Answer {
+		return freednstest.IPs(q.Name, "220.181.57.216")
+	})
+	clean.Handle("ustc.edu.cn", dns.TypeA, freednstest.IPs("ustc.edu.cn", "202.38.64.246"))
+
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{fast.Addr}, &staticUpstreamProvider{clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+	resolver.hijack = newHijackDetector()
+	resolve := func(name string) (*dns.Msg, *Trace) {
+		q := dns.Question{Name: name, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+		res, _, _ := resolver.resolve(q, true, "udp", trace)
+		return res, trace
+	}
+
+	// the hijack IPs are not learned yet
+	if res, trace := resolve("nx1.example.com."); res.Rcode != dns.RcodeSuccess || trace.Upstream != fast.Addr {
+		t.Errorf("got %v from %v before probing", dns.RcodeToString[res.Rcode], trace.Upstream)
+	}
+
+	ips, err := resolver.hijack.probe(func(q dns.Question) (*dns.Msg, error) {
+		return naiveResolve(q, true, "udp", fast.Addr)
+	}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := netip.MustParseAddr("220.181.57.216")
+	if !reflect.DeepEqual(ips, []netip.Addr{want, want, want}) {
+		t.Errorf("got hijack IPs %v", ips)
+	}
+
+	tests := []struct {
+		name   string
+		rcode  int
+		reason string
+	}{
+		{"nx2.example.com.", dns.RcodeNameError, "fast answer is hijacked NXDOMAIN"},
+		// classified as a china domain before probing, but not answered
+		// with the ad server either
+		{"nx1.example.com.", dns.RcodeNameError, "cached classification: china"},
+		{"ustc.edu.cn.", dns.RcodeSuccess, "fast answer contains local IPs"},
+	}
+	for _, tt := range tests {
+		res, trace := resolve(tt.name)
+		if res.Rcode != tt.rcode || trace.Reason != tt.reason {
+			t.Errorf("%s: got %v: %v, want %v: %v", tt.name, dns.RcodeToString[res.Rcode], trace.Reason, dns.RcodeToString[tt.rcode], tt.reason)
+		}
+		// the trace keeps the hijacked answer for diagnostics
+		if tt.rcode == dns.RcodeNameError && firstAnswer(trace.Fast.Response) != "220.181.57.216" {
+			t.Errorf("%s: got fast answer %v", tt.name, trace.Fast.Response)
+		}
+	}
+
+	// the upstream is unreachable
+	if _, err := resolver.hijack.probe(func(q dns.Question) (*dns.Msg, error) {
+		return nil, Error("timeout")
+	}, time.Minute); err == nil {
+		t.Errorf("probing an unreachable upstream should fail")
+	}
+}
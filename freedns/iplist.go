@@ -0,0 +1,85 @@
+package freedns
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+// newIPListUpdater creates the updater of the China IP list and loads the
+// persisted list. It returns nil if IPListURL is not set.
+func newIPListUpdater(cfg Config) (*chinaip.Updater, error) {
+	if cfg.IPListURL == "" {
+		return nil, nil
+	}
+
+	u := &chinaip.Updater{
+		URL:          cfg.IPListURL,
+		File:         cfg.IPListFile,
+		Format:       cfg.IPListFormat,
+		Country:      ipListCountry(cfg),
+		ChecksumURL:  cfg.IPListChecksumURL,
+		SignatureURL: cfg.IPListSignatureURL,
+	}
+	if cfg.IPListPublicKey != "" {
+		key, err := base64.StdEncoding.DecodeString(cfg.IPListPublicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, Error("Invalid ed25519 public key: " + cfg.IPListPublicKey)
+		}
+		u.PublicKey = key
+	}
+
+	if u.File != "" {
+		l := log.WithFields(logrus.Fields{
+			"op":   "load_ip_list",
+			"file": u.File,
+		})
+		if set, err := u.LoadFile(); err != nil {
+			l.Warn(err)
+		} else {
+			l.WithField("ranges", set.Len()).Info()
+		}
+	}
+	return u, nil
+}
+
+// ipListCountry returns the country of the records taken from the APNIC
+// format lists.
+func ipListCountry(cfg Config) string {
+	if cfg.IPListCountry == "" {
+		return "CN"
+	}
+	return cfg.IPListCountry
+}
+
+// updateIPList refreshes the China IP list every IPListInterval until the
+// server is shut down.
+func (s *Server) updateIPList() {
+	interval := s.config.IPListInterval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		l := log.WithFields(logrus.Fields{
+			"op":  "update_ip_list",
+			"url": s.ipListUpdater.URL,
+		})
+		if set, err := s.ipListUpdater.Update(); err != nil {
+			l.Warn(err)
+		} else {
+			l.WithField("ranges", set.Len()).Info()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
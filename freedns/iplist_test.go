@@ -0,0 +1,63 @@
+package freedns
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestNewIPListUpdater(t *testing.T) {
+	if u, err := newIPListUpdater(Config{}); u != nil || err != nil {
+		t.Errorf("updater should not be created without url")
+	}
+	if _, err := newIPListUpdater(Config{IPListURL: "http://127.0.0.1/list", IPListPublicKey: "asdf"}); err == nil {
+		t.Errorf("invalid public key should be rejected")
+	}
+}
+
+func TestUpdateIPList(t *testing.T) {
+	defer chinaip.Replace(chinaip.Current())
+
+	var list strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&list, "10.%d.%d.0/24\n", i/128, i%128*2)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(list.String()))
+	}))
+	defer ts.Close()
+
+	tempfile, err := ioutil.TempFile("", "test_iplist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempfile.Close()
+	defer os.Remove(tempfile.Name())
+
+	u, err := newIPListUpdater(Config{IPListURL: ts.URL, IPListFile: tempfile.Name()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{
+		config:        Config{IPListInterval: time.Hour},
+		ipListUpdater: u,
+		done:          make(chan struct{}),
+	}
+	go s.updateIPList()
+	defer close(s.done)
+
+	for i := 0; i < 100 && !chinaip.Contains(netip.MustParseAddr("10.0.0.1")); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !chinaip.Contains(netip.MustParseAddr("10.0.0.1")) || chinaip.Contains(netip.MustParseAddr("114.114.114.114")) {
+		t.Errorf("China IP list is not updated")
+	}
+}
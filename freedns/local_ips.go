@@ -0,0 +1,78 @@
+package freedns
+
+import (
+	"net/netip"
+	"os"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+// LocalIPClassifier decides which IPs are local like a server of the same
+// configuration, without the server: no listeners, query log, sets or
+// updates of the China IP list.
+type LocalIPClassifier struct {
+	cfg      Config
+	chinaIPs *chinaip.Set
+	local    chinaip.Classifier
+}
+
+// NewLocalIPClassifier creates the classifier of the local IPs of `cfg`. The
+// China IP list is the one persisted to IPListFile if it is set and exists,
+// and the builtin one otherwise, like a server before its first update.
+func NewLocalIPClassifier(cfg Config) (*LocalIPClassifier, error) {
+	chinaIPs := chinaip.Current()
+	if cfg.IPListFile != "" {
+		f, err := os.Open(cfg.IPListFile)
+		if err == nil {
+			ranges, err := chinaip.ParseList(f, cfg.IPListFormat, ipListCountry(cfg))
+			f.Close()
+			if err != nil {
+				return nil, Error(cfg.IPListFile + ": " + err.Error())
+			}
+			chinaIPs = chinaip.NewSet(ranges)
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	local, err := newLocalIPClassifier(cfg, chinaIPs)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalIPClassifier{cfg: cfg, chinaIPs: chinaIPs, local: local}, nil
+}
+
+// Contains tells if `addr` is local.
+func (c *LocalIPClassifier) Contains(addr netip.Addr) bool {
+	return c.local.Contains(addr)
+}
+
+// Explain returns the local classification of `addr`, and the overlay rule
+// deciding it if any.
+func (c *LocalIPClassifier) Explain(addr netip.Addr) chinaip.Match {
+	return explainIP(c.local, addr)
+}
+
+// Ranges returns the IPv4 ranges considered local, which are the China IP
+// list with the overlay rules applied. It fails with a country database,
+// whose local IPs can't be listed.
+func (c *LocalIPClassifier) Ranges() ([]chinaip.Range, error) {
+	return localRanges(c.cfg, c.chinaIPs)
+}
+
+func explainIP(local chinaip.Classifier, addr netip.Addr) chinaip.Match {
+	if o, ok := local.(*chinaip.Overlay); ok {
+		return o.Explain(addr)
+	}
+	return chinaip.Match{Local: local.Contains(addr)}
+}
+
+func localRanges(cfg Config, chinaIPs *chinaip.Set) ([]chinaip.Range, error) {
+	if cfg.CountryDB != "" {
+		return nil, Error("can't list the local IPs in the country database")
+	}
+	rules, err := overlayRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return chinaip.ApplyRules(chinaIPs.Ranges(), rules), nil
+}
@@ -0,0 +1,83 @@
+package freedns
+
+import (
+	"io/ioutil"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+func TestLocalIPClassifier(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test_ip_list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	listFile := filepath.Join(dir, "china_ip_list.txt")
+	if err := ioutil.WriteFile(listFile, []byte("1.1.1.0/24\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the persisted list replaces the builtin one
+	c, err := NewLocalIPClassifier(Config{
+		IPListFile:   listFile,
+		IncludeCIDRs: []string{"8.8.8.0/24"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranges, err := c.Ranges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := chinaip.NewSet(ranges)
+	for ip, want := range map[string]bool{"1.1.1.1": true, "8.8.8.8": true, "1.0.1.1": false} {
+		addr := netip.MustParseAddr(ip)
+		if c.Contains(addr) != want || set.Contains(addr) != want {
+			t.Errorf("%s should be local: %v", ip, want)
+		}
+	}
+	if m := c.Explain(netip.MustParseAddr("8.8.8.8")); !m.Local || m.Rule == nil {
+		t.Errorf("got %+v", m)
+	}
+
+	// the builtin one before the list is persisted
+	c, err = NewLocalIPClassifier(Config{IPListFile: filepath.Join(dir, "missing.txt")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Contains(netip.MustParseAddr("1.0.1.1")) {
+		t.Error("1.0.1.1 should be local")
+	}
+
+	// the APNIC delegated statistics
+	apnicFile := filepath.Join(dir, "delegated-apnic-latest")
+	apnic := "2|apnic|20240101|3|19830613|20240101|+1000\napnic|CN|ipv4|1.1.1.0|256|20110414|allocated\napnic|JP|ipv4|1.0.16.0|4096|20110412|allocated\n"
+	if err := ioutil.WriteFile(apnicFile, []byte(apnic), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err = NewLocalIPClassifier(Config{IPListFile: apnicFile, IPListFormat: "apnic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Contains(netip.MustParseAddr("1.1.1.1")) || c.Contains(netip.MustParseAddr("1.0.16.1")) || c.Contains(netip.MustParseAddr("1.0.1.1")) {
+		t.Error("the CN records of the APNIC list should be local")
+	}
+	c, err = NewLocalIPClassifier(Config{IPListFile: apnicFile, IPListFormat: "apnic", IPListCountry: "JP"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Contains(netip.MustParseAddr("1.0.16.1")) || c.Contains(netip.MustParseAddr("1.1.1.1")) {
+		t.Error("the JP records of the APNIC list should be local")
+	}
+
+	if err := ioutil.WriteFile(listFile, []byte("wtf\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewLocalIPClassifier(Config{IPListFile: listFile}); err == nil {
+		t.Error("the invalid list should be rejected")
+	}
+}
@@ -0,0 +1,144 @@
+package freedns
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	goc "github.com/louchenyao/golang-cache"
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// poisonDetector tells the poisoned names by querying them at an IP running
+// no DNS server, on a path through the GFW: any reply to them is forged.
+type poisonDetector struct {
+	addr    string
+	timeout time.Duration
+	ttl     time.Duration
+	// errorTTL is how long the failed probes, like the ones refused by
+	// ICMP, are cached as unknown
+	errorTTL time.Duration
+
+	// results caches the probe results of the names
+	results *goc.Cache
+
+	// a token bucket limiting the probes to `rate` per second
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// probeResult is a cached probe result.
+type probeResult struct {
+	poisoned bool
+	// failed is set if the probe failed, which tells nothing
+	failed bool
+	expire time.Time
+}
+
+func newPoisonDetector(addr string, rate int, cacheCap int) *poisonDetector {
+	if rate <= 0 {
+		rate = 10
+	}
+	c, _ := goc.NewCache("lru", cacheCap)
+	return &poisonDetector{
+		addr:     addr,
+		timeout:  500 * time.Millisecond,
+		ttl:      24 * time.Hour,
+		errorTTL: time.Minute,
+		results:  c,
+		rate:     float64(rate),
+		tokens:   float64(rate),
+	}
+}
+
+// lookup returns the cached probe of `name`.
+func (d *poisonDetector) lookup(name string) (probeResult, bool) {
+	v, ok := d.results.Get(strings.ToLower(name))
+	if !ok || v.(probeResult).expire.Before(time.Now()) {
+		return probeResult{}, false
+	}
+	return v.(probeResult), true
+}
+
+// cached returns the cached probe result of `name`, if the probe succeeded.
+func (d *poisonDetector) cached(name string) (poisoned bool, ok bool) {
+	r, ok := d.lookup(name)
+	if !ok || r.failed {
+		return false, false
+	}
+	return r.poisoned, true
+}
+
+// allow takes a token if the rate limit allows a probe.
+func (d *poisonDetector) allow() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	if !d.last.IsZero() {
+		d.tokens += now.Sub(d.last).Seconds() * d.rate
+		if d.tokens > d.rate {
+			d.tokens = d.rate
+		}
+	}
+	d.last = now
+	if d.tokens < 1 {
+		return false
+	}
+	d.tokens--
+	return true
+}
+
+// check returns a channel receiving whether `q` is poisoned, from the cache
+// or by probing. It returns nil if it can't tell, as the probes are over
+// the rate limit or the last one failed.
+func (d *poisonDetector) check(q dns.Question) <-chan bool {
+	ch := make(chan bool, 1)
+	if r, ok := d.lookup(q.Name); ok {
+		if r.failed {
+			return nil
+		}
+		ch <- r.poisoned
+		return ch
+	}
+	if !d.allow() {
+		return nil
+	}
+	go func() {
+		poisoned, err := d.probe(q)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"op":     "probe_poison",
+				"domain": q.Name,
+			}).Warn(err)
+			d.results.Set(strings.ToLower(q.Name), probeResult{failed: true, expire: time.Now().Add(d.errorTTL)})
+		} else {
+			d.results.Set(strings.ToLower(q.Name), probeResult{poisoned: poisoned, expire: time.Now().Add(d.ttl)})
+		}
+		ch <- poisoned
+	}()
+	return ch
+}
+
+// probe queries `q` at the blackhole address, which never replies unless
+// the reply is forged.
+func (d *poisonDetector) probe(q dns.Question) (bool, error) {
+	req := &dns.Msg{
+		MsgHdr: dns.MsgHdr{
+			Id:               dns.Id(),
+			RecursionDesired: true,
+		},
+		Question: []dns.Question{q},
+	}
+	c := &dns.Client{Net: "udp", Timeout: d.timeout}
+	_, _, err := c.Exchange(req, d.addr)
+	if err == nil {
+		return true, nil
+	}
+	if err, ok := err.(interface{ Timeout() bool }); ok && err.Timeout() {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,129 @@
+package freedns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestPoisonDetector(t *testing.T) {
+	w := newTestWorld(t)
+	// forged with local IPs, which fool the local IP classification
+	w.gfw.Poison("google.com", "202.38.64.1")
+
+	// the blackhole never replies, but the GFW on the path does
+	blackhole, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer blackhole.Close()
+	gfw, err := freednstest.NewGFW(blackhole.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gfw.Close()
+	gfw.Poison("google.com", "202.38.64.1")
+	// the blackhole is abroad, farther than the fast upstream
+	gfw.Delay(100 * time.Millisecond)
+
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+	resolver.poison = newPoisonDetector(gfw.Addr, 10, 1024)
+
+	evicted := make(chan string, 4)
+	resolver.evict = func(q dns.Question) { evicted <- q.Name }
+	resolve := func(domain string) (*dns.Msg, string, *Trace) {
+		q := dns.Question{Name: domain, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+		res, upstream, _ := resolver.resolve(q, true, "udp", trace)
+		return res, upstream, trace
+	}
+
+	// the local answer doesn't wait for the probe, but is withdrawn then
+	res, upstream, trace := resolve("google.com.")
+	if upstream != w.fast.Addr || firstAnswer(res) != "202.38.64.1" {
+		t.Errorf("got %v from %v: %v", firstAnswer(res), upstream, trace.Reason)
+	}
+	select {
+	case name := <-evicted:
+		if name != "google.com." {
+			t.Errorf("got %v evicted", name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the local answer should be evicted")
+	}
+
+	tests := []struct {
+		domain   string
+		upstream string
+		reason   string
+		answer   string
+	}{
+		{"google.com.", w.clean.Addr, "cached classification: foreign", "142.250.66.78"},
+		{"ustc.edu.cn.", w.fast.Addr, "fast answer contains local IPs", "202.38.64.246"},
+	}
+	for _, tt := range tests {
+		res, upstream, trace := resolve(tt.domain)
+		if upstream != tt.upstream || trace.Reason != tt.reason || firstAnswer(res) != tt.answer {
+			t.Errorf("%s: got %v from %v: %v", tt.domain, firstAnswer(res), upstream, trace.Reason)
+		}
+	}
+	// told poisoned before the fast answer comes
+	detector := resolver.poison
+	resolver = newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+	resolver.poison = detector
+	if _, upstream, trace := resolve("google.com."); upstream != w.clean.Addr || trace.Reason != "blackhole probe answered" {
+		t.Errorf("got %v: %v with the probe cached", upstream, trace.Reason)
+	}
+
+	if poisoned, ok := resolver.poison.cached("google.com."); !ok || !poisoned {
+		t.Errorf("google.com should be cached as poisoned")
+	}
+	for i := 0; i < 100; i++ {
+		if _, ok := resolver.poison.cached("ustc.edu.cn."); ok {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if poisoned, ok := resolver.poison.cached("ustc.edu.cn."); !ok || poisoned {
+		t.Errorf("ustc.edu.cn should be cached as clean")
+	}
+}
+
+func TestPoisonDetectorErrors(t *testing.T) {
+	// nothing listens on the port, so the probes are refused
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().String()
+	conn.Close()
+
+	d := newPoisonDetector(addr, 10, 16)
+	q := dns.Question{Name: "example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	if ch := d.check(q); ch == nil || <-ch {
+		t.Fatalf("a failed probe should tell not poisoned")
+	}
+	if d.check(q) != nil {
+		t.Errorf("the failed probe should be cached as unknown")
+	}
+	if _, ok := d.cached(q.Name); ok {
+		t.Errorf("the failed probe tells nothing")
+	}
+}
+
+func TestPoisonDetectorRateLimit(t *testing.T) {
+	d := newPoisonDetector("127.0.0.1:53", 3, 16)
+	allowed := 0
+	for i := 0; i < 10; i++ {
+		if d.allow() {
+			allowed++
+		}
+	}
+	if allowed != 3 {
+		t.Errorf("got %d probes allowed, want 3", allowed)
+	}
+}
@@ -0,0 +1,134 @@
+package freedns
+
+import (
+	"net/netip"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+)
+
+// The policies telling a local answer by its A records.
+const (
+	LocalIPPolicyAny      = "any"      // any of them is local
+	LocalIPPolicyAll      = "all"      // all of them are local
+	LocalIPPolicyMajority = "majority" // more than half of them are local
+)
+
+// The rewrites of the accepted fast answers mixing local and foreign IPs.
+const (
+	MixedAnswersKeep    = "keep"    // keep them as they are
+	MixedAnswersStrip   = "strip"   // remove the foreign IPs
+	MixedAnswersReorder = "reorder" // put the local IPs first
+)
+
+// checkAnswerPolicies validates the policies in the configuration.
+func checkAnswerPolicies(cfg Config) error {
+	switch cfg.LocalIPPolicy {
+	case "", LocalIPPolicyAny, LocalIPPolicyAll, LocalIPPolicyMajority:
+	default:
+		return Error("unknown local IP policy: " + cfg.LocalIPPolicy)
+	}
+	switch cfg.MixedAnswers {
+	case "", MixedAnswersKeep, MixedAnswersStrip, MixedAnswersReorder:
+	default:
+		return Error("unknown rewrite of mixed answers: " + cfg.MixedAnswers)
+	}
+	return nil
+}
+
+// isLocalAnswer tells if `res` is a local answer by the local IP policy.
+func (resolver *spoofingProofResolver) isLocalAnswer(res *dns.Msg) bool {
+	local, total := countLocalIPs(res, resolver.localIPs)
+	switch resolver.localIPPolicy {
+	case LocalIPPolicyAll:
+		return total > 0 && local == total
+	case LocalIPPolicyMajority:
+		return local*2 > total
+	}
+	return local > 0
+}
+
+// countLocalIPs counts the A records and the ipv4hints of the HTTPS records
+// in `res`, and the local ones of them.
+func countLocalIPs(res *dns.Msg, localIPs chinaip.Classifier) (local int, total int) {
+	for _, addr := range svcbHints(res) {
+		if addr.Is4() {
+			total++
+			if localIPs.Contains(addr) {
+				local++
+			}
+		}
+	}
+	for _, rrs := range [][]dns.RR{res.Answer, res.Ns, res.Extra} {
+		for _, rr := range rrs {
+			if a, ok := rr.(*dns.A); ok {
+				total++
+				if isLocalA(a, localIPs) {
+					local++
+				}
+			}
+		}
+	}
+	return local, total
+}
+
+func isLocalA(a *dns.A, localIPs chinaip.Classifier) bool {
+	ip, ok := netip.AddrFromSlice(a.A.To4())
+	return ok && localIPs.Contains(ip)
+}
+
+// rewriteMixedAnswer removes the foreign A records from the answer section
+// of `res`, or moves the local ones first, by the rewrite of mixed answers,
+// and the same for the ipv4hints of each HTTPS record. The answer is kept if
+// none of them is local.
+func (resolver *spoofingProofResolver) rewriteMixedAnswer(res *dns.Msg) {
+	if resolver.mixedAnswers != MixedAnswersStrip && resolver.mixedAnswers != MixedAnswersReorder {
+		return
+	}
+	isLocal := func(addr netip.Addr) bool {
+		return addr.Is4() && resolver.localIPs.Contains(addr)
+	}
+	rewriteSVCB(res, func(s *svcb) {
+		for _, addr := range s.hints() {
+			if isLocal(addr) {
+				if resolver.mixedAnswers == MixedAnswersStrip {
+					// the ipv6hints are kept like the AAAA records
+					s.filterHints(func(addr netip.Addr) bool { return addr.Is6() || isLocal(addr) })
+				} else {
+					s.sortHints(isLocal)
+				}
+				return
+			}
+		}
+	})
+
+	var positions []int
+	var local, foreign []dns.RR
+	for i, rr := range res.Answer {
+		if a, ok := rr.(*dns.A); ok {
+			positions = append(positions, i)
+			if isLocalA(a, resolver.localIPs) {
+				local = append(local, rr)
+			} else {
+				foreign = append(foreign, rr)
+			}
+		}
+	}
+	if len(local) == 0 || len(foreign) == 0 {
+		return
+	}
+
+	if resolver.mixedAnswers == MixedAnswersReorder {
+		for i, rr := range append(local, foreign...) {
+			res.Answer[positions[i]] = rr
+		}
+		return
+	}
+	answer := res.Answer[:0]
+	for _, rr := range res.Answer {
+		if a, ok := rr.(*dns.A); !ok || isLocalA(a, resolver.localIPs) {
+			answer = append(answer, rr)
+		}
+	}
+	res.Answer = answer
+}
@@ -0,0 +1,85 @@
+package freedns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestLocalIPPolicy(t *testing.T) {
+	tests := []struct {
+		policy string
+		ips    []string
+		local  bool
+	}{
+		{LocalIPPolicyAny, []string{"202.38.64.1", "31.13.64.1", "31.13.64.2"}, true},
+		{LocalIPPolicyAll, []string{"202.38.64.1", "31.13.64.1"}, false},
+		{LocalIPPolicyAll, []string{"202.38.64.1", "202.38.64.2"}, true},
+		{LocalIPPolicyMajority, []string{"202.38.64.1", "31.13.64.1"}, false},
+		{LocalIPPolicyMajority, []string{"202.38.64.1", "202.38.64.2", "31.13.64.1"}, true},
+		{LocalIPPolicyAll, nil, false},
+	}
+	for _, tt := range tests {
+		resolver := &spoofingProofResolver{localIPs: chinaip.ClassifierFunc(chinaip.Contains), localIPPolicy: tt.policy}
+		res := &dns.Msg{Answer: freednstest.IPs("example.com", tt.ips...).Answer}
+		if local := resolver.isLocalAnswer(res); local != tt.local {
+			t.Errorf("%s %v: got %v, want %v", tt.policy, tt.ips, local, tt.local)
+		}
+	}
+}
+
+func TestMixedAnswers(t *testing.T) {
+	w := newTestWorld(t)
+	w.auth.Handle("mixed.example.com", dns.TypeA, freednstest.RRs(
+		"mixed.example.com. 300 IN CNAME cdn.example.com.",
+		"cdn.example.com. 300 IN A 31.13.64.1",
+		"cdn.example.com. 300 IN A 202.38.64.1",
+		"cdn.example.com. 300 IN A 31.13.64.2",
+	))
+
+	tests := []struct {
+		policy  string
+		rewrite string
+		answer  string
+	}{
+		{LocalIPPolicyAny, MixedAnswersKeep, "cdn.example.com. 31.13.64.1 202.38.64.1 31.13.64.2"},
+		{LocalIPPolicyAny, MixedAnswersStrip, "cdn.example.com. 202.38.64.1"},
+		{LocalIPPolicyAny, MixedAnswersReorder, "cdn.example.com. 202.38.64.1 31.13.64.1 31.13.64.2"},
+		// not accepted as local, so the clean answer is kept as it is
+		{LocalIPPolicyMajority, MixedAnswersStrip, "cdn.example.com. 31.13.64.1 202.38.64.1 31.13.64.2"},
+	}
+	for _, tt := range tests {
+		resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+		resolver.localIPPolicy, resolver.mixedAnswers = tt.policy, tt.rewrite
+
+		q := dns.Question{Name: "mixed.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		res, upstream, _ := resolver.resolve(q, true, "udp", nil)
+		var answer []string
+		for _, rr := range res.Answer {
+			switch rr := rr.(type) {
+			case *dns.CNAME:
+				answer = append(answer, rr.Target)
+			case *dns.A:
+				answer = append(answer, rr.A.String())
+			}
+		}
+		if got := strings.Join(answer, " "); got != tt.answer {
+			t.Errorf("%s %s: got %q from %v, want %q", tt.policy, tt.rewrite, got, upstream, tt.answer)
+		}
+	}
+}
+
+func TestCheckAnswerPolicies(t *testing.T) {
+	if err := checkAnswerPolicies(Config{LocalIPPolicy: "most"}); err == nil {
+		t.Errorf("unknown policies should be rejected")
+	}
+	if err := checkAnswerPolicies(Config{MixedAnswers: "drop"}); err == nil {
+		t.Errorf("unknown rewrites should be rejected")
+	}
+	if err := checkAnswerPolicies(Config{}); err != nil {
+		t.Error(err)
+	}
+}
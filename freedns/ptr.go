@@ -0,0 +1,57 @@
+package freedns
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// sharedAddressSpace is the range of the carrier-grade NATs of RFC 6598.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// reverseAddr returns the address a PTR query of a full in-addr.arpa or
+// ip6.arpa name is for.
+func reverseAddr(q dns.Question) (netip.Addr, bool) {
+	if q.Qtype != dns.TypePTR {
+		return netip.Addr{}, false
+	}
+	name := strings.ToLower(dns.Fqdn(q.Name))
+	switch {
+	case strings.HasSuffix(name, ".in-addr.arpa."):
+		labels := strings.Split(strings.TrimSuffix(name, ".in-addr.arpa."), ".")
+		if len(labels) != 4 {
+			return netip.Addr{}, false
+		}
+		for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+			labels[i], labels[j] = labels[j], labels[i]
+		}
+		addr, err := netip.ParseAddr(strings.Join(labels, "."))
+		return addr, err == nil
+	case strings.HasSuffix(name, ".ip6.arpa."):
+		nibbles := strings.Split(strings.TrimSuffix(name, ".ip6.arpa."), ".")
+		if len(nibbles) != 32 {
+			return netip.Addr{}, false
+		}
+		var b strings.Builder
+		for i := len(nibbles) - 1; i >= 0; i-- {
+			if len(nibbles[i]) != 1 {
+				return netip.Addr{}, false
+			}
+			b.WriteString(nibbles[i])
+			if i%4 == 0 && i > 0 {
+				b.WriteByte(':')
+			}
+		}
+		addr, err := netip.ParseAddr(b.String())
+		return addr, err == nil
+	}
+	return netip.Addr{}, false
+}
+
+// isPrivateAddr tells if `addr` is in a private, loopback, link-local or
+// shared range, which no upstream knows about.
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr)
+}
@@ -0,0 +1,76 @@
+package freedns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestReverseAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+	}{
+		{"246.64.38.202.in-addr.arpa.", "202.38.64.246"},
+		{"1.0.168.192.IN-ADDR.ARPA", "192.168.0.1"},
+		{"b.a.9.8.7.6.5.0.4.0.0.0.3.0.0.0.2.0.0.0.1.0.0.0.0.0.0.0.1.2.3.4.ip6.arpa.", "4321:0:1:2:3:4:567:89ab"},
+		// not full addresses
+		{"168.192.in-addr.arpa.", ""},
+		{"1.2.3.4.5.in-addr.arpa.", ""},
+		{"256.0.0.10.in-addr.arpa.", ""},
+		{"0.8.b.d.0.1.0.0.2.ip6.arpa.", ""},
+		{"example.com.", ""},
+	}
+	for _, tt := range tests {
+		addr, ok := reverseAddr(dns.Question{Name: tt.name, Qtype: dns.TypePTR, Qclass: dns.ClassINET})
+		if tt.addr == "" {
+			if ok {
+				t.Errorf("%s: got %v", tt.name, addr)
+			}
+		} else if !ok || addr != netip.MustParseAddr(tt.addr) {
+			t.Errorf("%s: got %v, want %v", tt.name, addr, tt.addr)
+		}
+	}
+	if _, ok := reverseAddr(dns.Question{Name: "1.0.168.192.in-addr.arpa.", Qtype: dns.TypeA, Qclass: dns.ClassINET}); ok {
+		t.Errorf("only the PTR queries are reversed")
+	}
+
+	for ip, private := range map[string]bool{"10.1.2.3": true, "100.64.0.1": true, "127.0.0.1": true, "fe80::1": true, "fd00::1": true, "202.38.64.246": false, "8.8.8.8": false} {
+		if isPrivateAddr(netip.MustParseAddr(ip)) != private {
+			t.Errorf("%s: want private %v", ip, private)
+		}
+	}
+}
+
+func TestPTRRouting(t *testing.T) {
+	w := newTestWorld(t)
+	w.auth.Handle("246.64.38.202.in-addr.arpa", dns.TypePTR, freednstest.RRs("246.64.38.202.in-addr.arpa. 300 IN PTR revproxy.ustc.edu.cn."))
+	w.auth.Handle("8.8.8.8.in-addr.arpa", dns.TypePTR, freednstest.RRs("8.8.8.8.in-addr.arpa. 300 IN PTR dns.google."))
+
+	s, err := NewServer(Config{
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		rcode    int
+		upstream string
+		reason   string
+	}{
+		{"246.64.38.202.in-addr.arpa.", dns.RcodeSuccess, w.fast.Addr, "reversed address is local"},
+		{"8.8.8.8.in-addr.arpa.", dns.RcodeSuccess, w.clean.Addr, "reversed address is foreign"},
+		{"1.1.168.192.in-addr.arpa.", dns.RcodeNameError, "local", "private address"},
+	}
+	for _, tt := range tests {
+		res, trace := s.Query(dns.Question{Name: tt.name, Qtype: dns.TypePTR, Qclass: dns.ClassINET}, "udp")
+		if res.Rcode != tt.rcode || trace.Upstream != tt.upstream || trace.Reason != tt.reason {
+			t.Errorf("%s: got %v from %v: %v", tt.name, dns.RcodeToString[res.Rcode], trace.Upstream, trace.Reason)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package freedns
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// QueryLogEntry is a line of the query log, encoded in JSON.
+type QueryLogEntry struct {
+	Time     time.Time `json:"time"`
+	Client   string    `json:"client,omitempty"`
+	Name     string    `json:"name"`
+	Type     string    `json:"type"`
+	Net      string    `json:"net,omitempty"`
+	Upstream string    `json:"upstream,omitempty"`
+	Rcode    string    `json:"rcode,omitempty"`
+	Duration float64   `json:"duration_ms,omitempty"`
+
+	// Fast and Clean are the answers of the upstreams in the wire format,
+	// if Config.QueryLogAnswers is set and the query is not answered by
+	// the cache.
+	Fast  []byte `json:"fast,omitempty"`
+	Clean []byte `json:"clean,omitempty"`
+}
+
+// queryLogger appends the entries to the query log file. The entries are
+// buffered, and flushed every second or when the logger is closed.
+type queryLogger struct {
+	mu  sync.Mutex
+	f   *os.File
+	w   *bufio.Writer
+	enc *json.Encoder
+
+	done chan struct{}
+	wg   sync.WaitGroup
+	// pending counts the entries waiting for the upstream answers
+	pending sync.WaitGroup
+}
+
+func newQueryLogger(filename string) (*queryLogger, error) {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	l := &queryLogger{
+		f:    f,
+		w:    bufio.NewWriter(f),
+		done: make(chan struct{}),
+	}
+	l.enc = json.NewEncoder(l.w)
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				l.mu.Lock()
+				l.w.Flush()
+				l.mu.Unlock()
+			case <-l.done:
+				return
+			}
+		}
+	}()
+	return l, nil
+}
+
+func (l *queryLogger) log(e *QueryLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.enc.Encode(e)
+}
+
+// logLater logs the entry once `ready` is closed, after `fill` completes it.
+func (l *queryLogger) logLater(e *QueryLogEntry, ready <-chan struct{}, fill func(e *QueryLogEntry)) {
+	l.pending.Add(1)
+	go func() {
+		defer l.pending.Done()
+		<-ready
+		fill(e)
+		l.log(e)
+	}()
+}
+
+func (l *queryLogger) close() error {
+	l.pending.Wait()
+	close(l.done)
+	l.wg.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.w.Flush(); err != nil {
+		l.f.Close()
+		return err
+	}
+	return l.f.Close()
+}
+
+// packAnswer packs `res` for the query log, or returns nil if there is none.
+func packAnswer(res *dns.Msg) []byte {
+	if res == nil {
+		return nil
+	}
+	data, _ := res.Pack()
+	return data
+}
+
+// QueryTypeString returns the name of the query type `qtype`, like "HTTPS",
+// or "TYPE65280" as in RFC 3597 for the ones miekg/dns doesn't know.
+func QueryTypeString(qtype uint16) string {
+	switch qtype {
+	case typeHTTPS:
+		return "HTTPS"
+	case typeSVCB:
+		return "SVCB"
+	}
+	return dns.Type(qtype).String()
+}
+
+// ParseQueryType parses the query types of the query log, the names and the
+// RFC 3597 "TYPE65280" form.
+func ParseQueryType(s string) (uint16, bool) {
+	s = strings.ToUpper(s)
+	switch s {
+	case "HTTPS":
+		return typeHTTPS, true
+	case "SVCB":
+		return typeSVCB, true
+	}
+	if qtype, ok := dns.StringToType[s]; ok {
+		return qtype, true
+	}
+	if !strings.HasPrefix(s, "TYPE") {
+		return 0, false
+	}
+	qtype, err := strconv.ParseUint(s[len("TYPE"):], 10, 16)
+	return uint16(qtype), err == nil
+}
+
+// ReadQueryLog reads the entries of a query log. Plain domain lists are
+// accepted too, with lines like "example.com" or "example.com AAAA".
+func ReadQueryLog(r io.Reader) ([]QueryLogEntry, error) {
+	var entries []QueryLogEntry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var e QueryLogEntry
+		if strings.HasPrefix(line, "{") {
+			if err := json.Unmarshal([]byte(line), &e); err != nil {
+				return nil, err
+			}
+		} else {
+			fields := strings.Fields(line)
+			e.Name = fields[0]
+			e.Type = "A"
+			if len(fields) > 1 {
+				e.Type = strings.ToUpper(fields[1])
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,79 @@
+package freedns
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestQueryLog(t *testing.T) {
+	tempfile, err := ioutil.TempFile("", "test_query_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempfile.Close()
+	defer os.Remove(tempfile.Name())
+
+	l, err := newQueryLogger(tempfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := QueryLogEntry{
+		Time:     time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Client:   "127.0.0.1:5353",
+		Name:     "example.com.",
+		Type:     "AAAA",
+		Net:      "udp",
+		Upstream: "8.8.8.8:53",
+		Rcode:    "NOERROR",
+		Duration: 12.5,
+		Fast:     []byte{1, 2, 3},
+	}
+	l.log(&want)
+	if err := l.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(tempfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := ReadQueryLog(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !reflect.DeepEqual(entries[0], want) {
+		t.Errorf("got %+v, want %+v", entries, want)
+	}
+}
+
+func TestReadDomainList(t *testing.T) {
+	entries, err := ReadQueryLog(strings.NewReader("# domains\nexample.com\n\nexample.org aaaa\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Name != "example.com" || entries[0].Type != "A" || entries[1].Type != "AAAA" {
+		t.Errorf("got %+v", entries)
+	}
+}
+
+func TestParseQueryType(t *testing.T) {
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA, typeSVCB, typeHTTPS, 65280} {
+		s := QueryTypeString(qtype)
+		if got, ok := ParseQueryType(s); !ok || got != qtype {
+			t.Errorf("%d: %s is parsed as %d", qtype, s, got)
+		}
+	}
+	if qtype, ok := ParseQueryType("https"); !ok || qtype != typeHTTPS {
+		t.Errorf("got %d", qtype)
+	}
+	if _, ok := ParseQueryType("TYPEX"); ok {
+		t.Error("TYPEX should be invalid")
+	}
+}
@@ -0,0 +1,105 @@
+package freedns
+
+import (
+	"net/netip"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// The handlings of the upstream answers with special-purpose addresses.
+const (
+	RebindStrip  = "strip"  // remove the records of the addresses
+	RebindRefuse = "refuse" // answer REFUSED instead
+)
+
+// specialPrefixes are the special-purpose ranges of RFC 6890 no public name
+// should resolve to, besides the private ones of isPrivateAddr.
+var specialPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+// isSpecialAddr tells if `addr` is private or of special purpose.
+func isSpecialAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if isPrivateAddr(addr) {
+		return true
+	}
+	for _, p := range specialPrefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// rebindFilter protects the LAN from DNS rebinding, by stripping or refusing
+// the upstream answers of public names with special-purpose addresses.
+type rebindFilter struct {
+	refuse bool
+	// allow are the domains, with their subdomains, allowed to resolve to
+	// such addresses
+	allow []string
+}
+
+func newRebindFilter(mode string, allow []string) (*rebindFilter, error) {
+	if mode != RebindStrip && mode != RebindRefuse {
+		return nil, Error("unknown rebinding protection: " + mode)
+	}
+	return &rebindFilter{refuse: mode == RebindRefuse, allow: allow}, nil
+}
+
+// apply strips the records of special-purpose addresses from the answer and
+// additional sections of `res`, and the hints of its HTTPS records, or tells
+// to refuse `res` if it has any. It returns whether `res` has any.
+func (f *rebindFilter) apply(q dns.Question, res *dns.Msg) (rebinding bool, refuse bool) {
+	if matchDomain(q.Name, f.allow) {
+		return false, false
+	}
+	for _, rrs := range [][]dns.RR{res.Answer, res.Extra} {
+		for _, rr := range rrs {
+			if addr, ok := addrOf(rr); ok && isSpecialAddr(addr) {
+				rebinding = true
+			}
+		}
+	}
+	for _, rr := range res.Answer {
+		if s := parseSVCB(rr); s != nil {
+			for _, addr := range s.hints() {
+				rebinding = rebinding || isSpecialAddr(addr)
+			}
+		}
+	}
+	if !rebinding {
+		return false, false
+	}
+	log.WithFields(logrus.Fields{
+		"op":     "rebind",
+		"domain": q.Name,
+		"type":   QueryTypeString(q.Qtype),
+	}).Warn("answer with special-purpose addresses")
+	if f.refuse {
+		return true, true
+	}
+
+	strip := func(rrs []dns.RR) []dns.RR {
+		kept := rrs[:0]
+		for _, rr := range rrs {
+			if addr, ok := addrOf(rr); !ok || !isSpecialAddr(addr) {
+				kept = append(kept, rr)
+			}
+		}
+		return kept
+	}
+	res.Answer, res.Extra = strip(res.Answer), strip(res.Extra)
+	rewriteSVCB(res, func(s *svcb) {
+		s.filterHints(func(addr netip.Addr) bool { return !isSpecialAddr(addr) })
+	})
+	return true, false
+}
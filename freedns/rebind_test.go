@@ -0,0 +1,76 @@
+package freedns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestIsSpecialAddr(t *testing.T) {
+	for ip, special := range map[string]bool{
+		"192.168.1.1":         true,
+		"0.0.0.0":             true,
+		"169.254.169.254":     true,
+		"239.255.255.250":     true,
+		"::ffff:192.168.1.1":  true,
+		"::":                  true,
+		"fc00::1":             true,
+		"202.38.64.246":       false,
+		"2001:da8:d800::1":    false,
+		"::ffff:202.38.64.50": false,
+	} {
+		if isSpecialAddr(netip.MustParseAddr(ip)) != special {
+			t.Errorf("%s: want special %v", ip, special)
+		}
+	}
+}
+
+func TestRebindFilter(t *testing.T) {
+	w := newTestWorld(t)
+	w.auth.Handle("evil.com", dns.TypeA, freednstest.IPs("evil.com", "93.184.216.34", "192.168.1.1"))
+	w.auth.Handle("evil.com", typeHTTPS, freednstest.Answer{Answer: []dns.RR{newHTTPS("evil.com", "10.0.0.1", "93.184.216.34")}})
+	w.auth.Handle("nas.example.com", dns.TypeA, freednstest.IPs("nas.example.com", "192.168.1.2"))
+
+	newServer := func(mode string) *Server {
+		s, err := NewServer(Config{
+			FastUpstream:       w.fast.Addr,
+			CleanUpstream:      w.clean.Addr,
+			RebindProtection:   mode,
+			RebindAllowDomains: []string{"example.com"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	query := func(s *Server, name string, qtype uint16) (*dns.Msg, *Trace) {
+		return s.Query(dns.Question{Name: name, Qtype: qtype, Qclass: dns.ClassINET}, "udp")
+	}
+
+	s := newServer(RebindStrip)
+	res, trace := query(s, "evil.com.", dns.TypeA)
+	if got := answerAddrs(res); got != "93.184.216.34" || trace.Reason != "fast answer has no local IPs, rebinding stripped" {
+		t.Errorf("got %q: %v", got, trace.Reason)
+	}
+	res, _ = query(s, "evil.com.", typeHTTPS)
+	if hints := svcbHints(res); len(hints) != 1 || hints[0] != netip.MustParseAddr("93.184.216.34") {
+		t.Errorf("got hints %v", hints)
+	}
+	// allowed
+	res, _ = query(s, "nas.example.com.", dns.TypeA)
+	if got := answerAddrs(res); got != "192.168.1.2" {
+		t.Errorf("got %q for the allowed domain", got)
+	}
+
+	s = newServer(RebindRefuse)
+	res, trace = query(s, "evil.com.", dns.TypeA)
+	if res.Rcode != dns.RcodeRefused || trace.Reason != "fast answer has no local IPs, rebinding refused" {
+		t.Errorf("got %v: %v", dns.RcodeToString[res.Rcode], trace.Reason)
+	}
+
+	if _, err := newRebindFilter("drop", nil); err == nil {
+		t.Errorf("unknown modes should be rejected")
+	}
+}
@@ -0,0 +1,139 @@
+package freedns
+
+import (
+	"math"
+	"net/netip"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// The upstreams of the replays, answering with the recorded answers.
+const (
+	ReplayFast  = "fast"
+	ReplayClean = "clean"
+)
+
+// ReplayResult is the decision on a query replayed from the query log.
+type ReplayResult struct {
+	Entry *QueryLogEntry
+	// Upstream is ReplayFast or ReplayClean, or "filter" and "local" for the
+	// queries answered by the policies, and Reason tells why.
+	Upstream string
+	Reason   string
+	Answer   *dns.Msg
+	// EDE is the Extended DNS Error code of Answer, -1 if it has none, and
+	// Hints are the ipv4hints and ipv6hints of its HTTPS and SVCB records.
+	EDE   int
+	Hints []netip.Addr
+	// Blocked is set if Answer is not the one of an upstream, but blocked or
+	// filtered by a policy.
+	Blocked bool
+}
+
+func newReplayResult(e *QueryLogEntry, upstream string, reason string, res *dns.Msg) ReplayResult {
+	r := ReplayResult{
+		Entry:    e,
+		Upstream: upstream,
+		Reason:   reason,
+		Answer:   res,
+		EDE:      -1,
+		Hints:    svcbHints(res),
+	}
+	if code, _, ok := getEDE(res); ok {
+		r.EDE = int(code)
+	}
+	r.Blocked = upstream != ReplayFast && upstream != ReplayClean || r.EDE == edeBlocked || r.EDE == edeFiltered
+	return r
+}
+
+// Replay resolves the queries in the log again with the configuration `cfg`,
+// answered by the upstream answers recorded in the log instead of the
+// upstreams, to evaluate how `cfg` routes them. The resolver is configured
+// like the one of the server, with the local IPs of NewLocalIPClassifier,
+// and the policies answering without the upstreams apply too. The
+// IPs probed at runtime are not: the fastest IPs and the blackhole probes are
+// off, and the hijack IPs are learned from the fast answers to the names the
+// clean upstream answers with NXDOMAIN. The entries without recorded answers,
+// which are answered by the cache or by the policies, are skipped.
+func Replay(cfg Config, entries []QueryLogEntry) ([]ReplayResult, error) {
+	localIPs, err := NewLocalIPClassifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCap := cfg.CacheCap
+	if cacheCap <= 0 {
+		cacheCap = 1024 * 10
+	}
+	resolver, err := newResolver(cfg, &staticUpstreamProvider{ReplayFast}, &staticUpstreamProvider{ReplayClean}, localIPs.local, cacheCap)
+	if err != nil {
+		return nil, err
+	}
+	// the verification would query the upstreams in the background, with
+	// the answers recorded for another entry
+	resolver.classes.verifyInterval = math.MaxInt64
+	if resolver.hijack != nil {
+		for i := range entries {
+			fast, clean := recordedAnswer(entries[i].Fast), recordedAnswer(entries[i].Clean)
+			if fast != nil && clean != nil && fast.Rcode == dns.RcodeSuccess && clean.Rcode == dns.RcodeNameError {
+				resolver.hijack.learn(answerIPs(fast), 24*time.Hour)
+			}
+		}
+	}
+
+	var results []ReplayResult
+	for i := range entries {
+		e := &entries[i]
+		if e.Fast == nil && e.Clean == nil {
+			continue
+		}
+		qtype, ok := ParseQueryType(e.Type)
+		if !ok {
+			return nil, Error("unknown query type " + e.Type)
+		}
+		q := dns.Question{Name: dns.Fqdn(e.Name), Qtype: qtype, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+
+		req := &dns.Msg{}
+		req.SetQuestion(q.Name, q.Qtype)
+		if res, upstream, ok := answerByPolicy(cfg, req, trace); ok {
+			results = append(results, newReplayResult(e, upstream, trace.Reason, res))
+			continue
+		}
+
+		resolver.exchange = func(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error) {
+			data := e.Fast
+			if upstream == ReplayClean {
+				data = e.Clean
+			}
+			if data == nil {
+				return nil, Error("no answer recorded")
+			}
+			res := &dns.Msg{}
+			if err := res.Unpack(data); err != nil {
+				return nil, err
+			}
+			return res, nil
+		}
+		// tracing waits for both upstreams, and nothing is verified in the
+		// background, so exchange is not in use when it is replaced for
+		// the next entry
+		res, upstream, _ := resolver.resolve(q, true, e.Net, trace)
+		results = append(results, newReplayResult(e, upstream, trace.Reason, res))
+	}
+	return results, nil
+}
+
+// recordedAnswer unpacks an answer recorded in the query log, and returns nil
+// if there is none or it is malformed.
+func recordedAnswer(data []byte) *dns.Msg {
+	if data == nil {
+		return nil
+	}
+	res := &dns.Msg{}
+	if err := res.Unpack(data); err != nil {
+		return nil
+	}
+	return res
+}
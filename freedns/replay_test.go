@@ -0,0 +1,213 @@
+package freedns
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestReplay(t *testing.T) {
+	w := newTestWorld(t)
+
+	tempfile, err := ioutil.TempFile("", "test_query_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempfile.Close()
+	defer os.Remove(tempfile.Name())
+
+	s, err := NewServer(Config{
+		FastUpstream:    w.fast.Addr,
+		CleanUpstream:   w.clean.Addr,
+		Listen:          "127.0.0.1:52346",
+		CacheCap:        1024,
+		QueryLog:        tempfile.Name(),
+		QueryLogAnswers: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Run()
+
+	questions := []dns.Question{
+		{Name: "ustc.edu.cn.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		{Name: "google.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		{Name: "mi.cn.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		// answered by the cache
+		{Name: "mi.cn.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+	}
+	for i, q := range questions {
+		var err error
+		for retry := 0; retry < 10; retry++ {
+			if _, err = naiveResolve(q, true, "udp", "127.0.0.1:52346"); err == nil {
+				break
+			}
+			// wait for the server to be up
+			time.Sleep(50 * time.Millisecond)
+		}
+		if err != nil {
+			t.Fatalf("query %d: %v", i, err)
+		}
+	}
+	s.Shutdown()
+
+	f, err := os.Open(tempfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := ReadQueryLog(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(questions) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(questions))
+	}
+
+	results, err := Replay(Config{}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("the cache hit should be skipped, got %d results", len(results))
+	}
+	want := []string{ReplayFast, ReplayClean, ReplayFast}
+	for i, r := range results {
+		if r.Upstream != want[i] {
+			t.Errorf("%s: got %s, want %s", r.Entry.Name, r.Upstream, want[i])
+		}
+		if upstream := map[string]string{w.fast.Addr: ReplayFast, w.clean.Addr: ReplayClean}[r.Entry.Upstream]; upstream != r.Upstream {
+			t.Errorf("%s: the replay chooses %s, but %s was chosen", r.Entry.Name, r.Upstream, r.Entry.Upstream)
+		}
+	}
+
+	// trusting the forged IP routes google.com to the poisoned fast upstream
+	results, err = Replay(Config{IncludeCIDRs: []string{"31.13.64.0/24"}}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := results[1]; r.Upstream != ReplayFast || r.Reason != "fast answer contains local IPs" || firstAnswer(r.Answer) != "31.13.64.1" {
+		t.Errorf("%s: got %s (%s) %s", r.Entry.Name, r.Upstream, r.Reason, firstAnswer(r.Answer))
+	}
+}
+
+func TestReplayPolicies(t *testing.T) {
+	entry := func(name string, qtype uint16, fast dns.RR, cleanRcode int) QueryLogEntry {
+		pack := func(rcode int, rrs ...dns.RR) []byte {
+			res := &dns.Msg{}
+			res.SetQuestion(name, qtype)
+			res.Response, res.Rcode = true, rcode
+			res.Answer = rrs
+			data, err := res.Pack()
+			if err != nil {
+				t.Fatal(err)
+			}
+			return data
+		}
+		var clean []dns.RR
+		if cleanRcode == dns.RcodeSuccess {
+			clean = append(clean, fast)
+		}
+		return QueryLogEntry{
+			Name:  name,
+			Type:  QueryTypeString(qtype),
+			Net:   "udp",
+			Fast:  pack(dns.RcodeSuccess, fast),
+			Clean: pack(cleanRcode, clean...),
+		}
+	}
+	rr := func(s string) dns.RR {
+		rr, err := dns.NewRR(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rr
+	}
+	entries := []QueryLogEntry{
+		entry("example.com.", dns.TypeAAAA, rr("example.com. 300 IN AAAA 2001:db8::1"), dns.RcodeSuccess),
+		entry("1.1.168.192.in-addr.arpa.", dns.TypePTR, rr("1.1.168.192.in-addr.arpa. 300 IN PTR router.lan."), dns.RcodeSuccess),
+		entry("rebind.example.", dns.TypeA, rr("rebind.example. 300 IN A 192.168.1.1"), dns.RcodeSuccess),
+		entry("typo.example.", dns.TypeA, rr("typo.example. 300 IN A 114.114.114.114"), dns.RcodeNameError),
+		entry("example.com.", typeHTTPS, newHTTPS("example.com.", "114.114.114.114"), dns.RcodeSuccess),
+	}
+
+	results, err := Replay(Config{}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the names of the private addresses are never asked
+	if r := results[1]; r.Upstream != "local" || !r.Blocked || r.Answer.Rcode != dns.RcodeNameError {
+		t.Errorf("%s: got %s %s blocked %v", r.Entry.Name, r.Upstream, dns.RcodeToString[r.Answer.Rcode], r.Blocked)
+	}
+	for _, r := range append(results[:1:1], results[2:]...) {
+		if r.Blocked || r.EDE != -1 {
+			t.Errorf("%s %s: blocked %v, EDE %d without the policies", r.Entry.Name, r.Entry.Type, r.Blocked, r.EDE)
+		}
+	}
+	if r := results[3]; r.Upstream != ReplayFast || firstAnswer(r.Answer) != "114.114.114.114" {
+		t.Errorf("%s: got %s %s", r.Entry.Name, r.Upstream, firstAnswer(r.Answer))
+	}
+	if r := results[4]; len(r.Hints) != 1 || r.Hints[0].String() != "114.114.114.114" {
+		t.Errorf("%s: got hints %v", r.Entry.Name, r.Hints)
+	}
+
+	results, err = Replay(Config{
+		FilterAAAA:       true,
+		RebindProtection: RebindRefuse,
+		DetectHijack:     true,
+	}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		upstream string
+		rcode    int
+		ede      int
+		blocked  bool
+	}{
+		{"filter", dns.RcodeSuccess, edeFiltered, true},
+		{"local", dns.RcodeNameError, -1, true},
+		{ReplayClean, dns.RcodeRefused, edeBlocked, true},
+		{ReplayClean, dns.RcodeNameError, -1, false},
+		{ReplayFast, dns.RcodeSuccess, -1, false},
+	}
+	for i, r := range results {
+		if r.Upstream != want[i].upstream || r.Answer.Rcode != want[i].rcode || r.EDE != want[i].ede || r.Blocked != want[i].blocked {
+			t.Errorf("%s %s: got %s %s EDE %d blocked %v (%s)", r.Entry.Name, r.Entry.Type, r.Upstream, dns.RcodeToString[r.Answer.Rcode], r.EDE, r.Blocked, r.Reason)
+		}
+	}
+}
+
+func TestReplayWithoutVerification(t *testing.T) {
+	pack := func(ip string) []byte {
+		res := &dns.Msg{}
+		res.SetQuestion("ustc.edu.cn.", dns.TypeA)
+		res.Response = true
+		res.Answer = freednstest.IPs("ustc.edu.cn", ip).Answer
+		data, err := res.Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	var entries []QueryLogEntry
+	for i := 0; i < 10; i++ {
+		entries = append(entries, QueryLogEntry{Name: "ustc.edu.cn.", Type: "A", Net: "udp", Fast: pack("202.38.64.246"), Clean: pack("202.38.64.246")})
+	}
+
+	// the classification is never verified with the answers of the other
+	// entries in the background
+	results, err := Replay(Config{ClassificationVerifyInterval: time.Nanosecond}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results[1:] {
+		if r.Upstream != ReplayFast || r.Reason != "cached classification: china" {
+			t.Errorf("got %s (%s)", r.Upstream, r.Reason)
+		}
+	}
+}
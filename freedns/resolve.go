@@ -1,9 +1,10 @@
 package freedns
 
 import (
+	"net/netip"
+	"sync/atomic"
 	"time"
 
-	goc "github.com/louchenyao/golang-cache"
 	"github.com/miekg/dns"
 	"github.com/sirupsen/logrus"
 	"github.com/tuna/freedns-go/chinaip"
@@ -14,92 +15,413 @@ type spoofingProofResolver struct {
 	fastUpstreamProvider  upstreamProvider
 	cleanUpstreamProvider upstreamProvider
 
-	// cnDomains caches if a domain belongs to China.
-	cnDomains *goc.Cache
+	// localIPs decides if an IP belongs to China.
+	localIPs chinaip.Classifier
+
+	// classes caches if a domain belongs to China.
+	classes *domainClassifications
+
+	// exchange queries an upstream, which is naiveResolve except in replays,
+	// limited by limits if it is not nil.
+	exchange func(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error)
+
+	// hijack turns the fast answers with the IPs it learned into NXDOMAIN
+	// if it is not nil.
+	hijack *hijackDetector
+
+	// poison probes if the unclassified domains are poisoned if it is not
+	// nil.
+	poison *poisonDetector
+
+	// health serves the answers of the other upstream while one is down if
+	// it is not nil.
+	health *upstreamHealth
+
+	// localIPPolicy tells the local answers, and mixedAnswers rewrites the
+	// accepted fast ones mixing local and foreign IPs, see policy.go.
+	localIPPolicy string
+	mixedAnswers  string
+
+	// fastest ranks the IPs in the answers by probing them if it is not nil.
+	fastest *ipProber
+
+	// noECH removes the ECH configs from the SVCB and HTTPS records, and
+	// filterAAAA their ipv6hints.
+	noECH      bool
+	filterAAAA bool
+
+	// rebind strips or refuses the answers with special-purpose addresses
+	// if it is not nil.
+	rebind *rebindFilter
+
+	limits *upstreamLimiter
+
+	// evict removes the answers to a question from the cache, if it is not
+	// nil.
+	evict func(q dns.Question)
 }
 
-func newSpoofingProofResolver(fastUpstreamProvider upstreamProvider, cleanUpstreamProvider upstreamProvider, cacheCap int) *spoofingProofResolver {
-	c, _ := goc.NewCache("lru", cacheCap)
+func newSpoofingProofResolver(fastUpstreamProvider upstreamProvider, cleanUpstreamProvider upstreamProvider, localIPs chinaip.Classifier, cacheCap int) *spoofingProofResolver {
 	return &spoofingProofResolver{
 		fastUpstreamProvider:  fastUpstreamProvider,
 		cleanUpstreamProvider: cleanUpstreamProvider,
-		cnDomains:             c,
+		localIPs:              localIPs,
+		classes:               newDomainClassifications(cacheCap),
+		exchange:              naiveResolve,
 	}
 }
 
-// resovle returns the response and which upstream is used
-func (resolver *spoofingProofResolver) resolve(q dns.Question, recursion bool, net string) (*dns.Msg, string) {
+// resovle returns the response, which upstream is used and its role,
+// roleFast or roleClean, as both upstreams may have the same address.
+// If `trace` is not nil, the decisions are recorded in it, and it waits for
+// both upstreams to complete the trace, or completes it in the background if
+// trace.Done is set.
+func (resolver *spoofingProofResolver) resolve(q dns.Question, recursion bool, net string, trace *Trace) (*dns.Msg, string, int) {
 	type result struct {
 		res *dns.Msg
 		err error
+		rtt time.Duration
+		// traced is a copy of res for the trace, as res may be modified
+		// once returned
+		traced *dns.Msg
+		// hijacked is set if res replaces a hijacked answer
+		hijacked bool
 	}
 	fastCh := make(chan result, 4)
 	cleanCh := make(chan result, 4)
 
-	fail := &dns.Msg{
-		MsgHdr: dns.MsgHdr{
-			Rcode: dns.RcodeServerFailure,
-		},
+	// each failure gets its own message, as the one returned may be modified
+	fail := func() *dns.Msg {
+		return &dns.Msg{
+			MsgHdr: dns.MsgHdr{
+				Rcode: dns.RcodeServerFailure,
+			},
+		}
 	}
 
-	Q := func(ch chan result, upstream string) {
-		res, err := naiveResolve(q, recursion, net, upstream)
+	Q := func(ch chan result, upstream string, role int) {
+		start := time.Now()
+		res, err := resolver.exchange(q, recursion, net, upstream)
+		// the queries rejected by the limits tell nothing of the upstream
+		if resolver.health != nil && err != errUpstreamBusy {
+			resolver.health.record(role, upstream, !upstreamUnreachable(res, err))
+		}
 		if res == nil {
-			res = fail
+			res = fail()
 		}
-		ch <- result{res, err}
+		r := result{res: res, err: err, rtt: time.Since(start)}
+		if trace != nil {
+			r.traced = res.Copy()
+		}
+		if role == roleFast && resolver.hijack != nil && res.Rcode == dns.RcodeSuccess && resolver.hijack.hijacked(res) {
+			r.res = &dns.Msg{
+				MsgHdr: dns.MsgHdr{
+					Rcode: dns.RcodeNameError,
+				},
+			}
+			r.hijacked = true
+		}
+		ch <- r
 	}
 
 	cleanUpstream := resolver.cleanUpstreamProvider.GetUpstream()
 	fastUpstream := resolver.fastUpstreamProvider.GetUpstream()
 
-	go Q(cleanCh, cleanUpstream)
-	go Q(fastCh, fastUpstream)
+	go Q(cleanCh, cleanUpstream, roleClean)
+	go Q(fastCh, fastUpstream, roleFast)
 
 	// send timeout results
-	go func() {
-		time.Sleep(1900 * time.Millisecond)
-		fastCh <- result{fail, Error("timeout")}
-		cleanCh <- result{fail, Error("timeout")}
-	}()
+	time.AfterFunc(1900*time.Millisecond, func() {
+		for _, ch := range []chan result{fastCh, cleanCh} {
+			timeout := result{res: fail(), err: Error("timeout"), rtt: 1900 * time.Millisecond}
+			if trace != nil {
+				timeout.traced = fail()
+			}
+			ch <- timeout
+		}
+	})
 
 	var r result
-	var upstream string
+	var upstream, reason string
+	var role int
+	// poisoned is set once the poison detector tells q is poisoned
+	poisoned := false
+	// degraded is set if the answer comes from the other upstream, as the
+	// right one is down
+	degraded := false
+	fastDown, cleanDown := resolver.health.down(roleFast), resolver.health.down(roleClean)
+	// local is set if the fast answer is accepted as local
+	local := false
+	// withdraw is the pending poison probe of the local answer
+	var withdraw <-chan bool
+	// the results received from each upstream, for tracing
+	var fastR, cleanR *result
+	recv := func(ch chan result, saved **result) result {
+		r := <-ch
+		*saved = &r
+		return r
+	}
 
 	for i := 0; i < 1; i++ {
+		// 0. the PTR queries are routed by the address reversed instead
+		if addr, ok := reverseAddr(q); ok {
+			isCN := resolver.localIPs.Contains(addr)
+			if trace != nil {
+				trace.Classification = "foreign"
+				if isCN {
+					trace.Classification = "china"
+				}
+			}
+			switch {
+			case isCN && fastDown:
+				r = recv(cleanCh, &cleanR)
+				upstream = cleanUpstream
+				role = roleClean
+				reason = "reversed address is local, fast upstream down"
+				degraded = true
+			case isCN:
+				r = recv(fastCh, &fastR)
+				upstream = fastUpstream
+				role = roleFast
+				reason = "reversed address is local"
+			case cleanDown:
+				r = recv(fastCh, &fastR)
+				upstream = fastUpstream
+				role = roleFast
+				reason = "reversed address is foreign, clean upstream down"
+				degraded = true
+			default:
+				r = recv(cleanCh, &cleanR)
+				upstream = cleanUpstream
+				role = roleClean
+				reason = "reversed address is foreign"
+			}
+			break
+		}
+
 		// 1. if we can distinguish if it is a china domain, we directly uses the right upstream
-		isCN, ok := resolver.cnDomains.Get(q.Name)
+		class, ok, verify := resolver.classes.get(q.Name)
+		if verify {
+			go resolver.verify(q, recursion, net)
+		}
+		isCN := class.decided
+		if trace != nil {
+			trace.Classification = "unknown"
+			if ok && isCN {
+				trace.Classification = "china"
+			} else if ok {
+				trace.Classification = "foreign"
+			}
+			trace.LocalAnswers, trace.ForeignAnswers = class.local, class.foreign
+		}
 		if ok {
-			if isCN.(bool) {
-				r = <-fastCh
+			switch {
+			case isCN && fastDown:
+				r = recv(cleanCh, &cleanR)
+				upstream = cleanUpstream
+				role = roleClean
+				reason = "cached classification: china, fast upstream down"
+				degraded = true
+			case isCN:
+				r = recv(fastCh, &fastR)
+				upstream = fastUpstream
+				role = roleFast
+				reason = "cached classification: china"
+				local = true
+			case cleanDown:
+				r = recv(fastCh, &fastR)
 				upstream = fastUpstream
-			} else {
-				r = <-cleanCh
+				role = roleFast
+				reason = "cached classification: foreign, clean upstream down"
+				degraded = true
+			default:
+				r = recv(cleanCh, &cleanR)
 				upstream = cleanUpstream
+				role = roleClean
+				reason = "cached classification: foreign"
 			}
 			break
 		}
 
+		if fastDown {
+			r = recv(cleanCh, &cleanR)
+			upstream = cleanUpstream
+			role = roleClean
+			reason = "fast upstream down"
+			degraded = true
+			break
+		}
+
+		// the forged answers may contain local IPs too, so probe the domain
+		// for poisoning in parallel
+		var probe <-chan bool
+		if resolver.poison != nil {
+			probe = resolver.poison.check(q)
+		}
+
 		// 2. try to resolve by fast dns. if it contains A record which means we can decide if this is a china domain
-		r = <-fastCh
+		r = recv(fastCh, &fastR)
 		upstream = fastUpstream
-		if r.res != nil && r.res.Rcode == dns.RcodeSuccess && containsA(r.res) && containsChinaip(r.res) {
-			break
+		role = roleFast
+		if r.res != nil && r.res.Rcode == dns.RcodeSuccess && containsA(r.res) && resolver.isLocalAnswer(r.res) {
+			// the local answer doesn't wait for the probe, which takes its
+			// whole timeout for the real domains, but is withdrawn once the
+			// probe is answered
+			if probe != nil {
+				select {
+				case poisoned = <-probe:
+				default:
+					withdraw = probe
+				}
+			}
+			if !poisoned {
+				reason = "fast answer contains local IPs"
+				local = true
+				break
+			}
+		}
+		switch {
+		case poisoned:
+			reason = "blackhole probe answered"
+		case r.hijacked:
+			reason = "fast answer is hijacked NXDOMAIN"
+		case r.res == nil || r.res.Rcode != dns.RcodeSuccess:
+			reason = "fast upstream failed"
+		case !containsA(r.res):
+			reason = "fast answer has no A records"
+		default:
+			reason = "fast answer has no local IPs"
 		}
 
-		// 3. the domain may not belong to China, use the clean upstream
-		r = <-cleanCh
+		// 3. the domain may not belong to China, use the clean upstream,
+		// unless it is down and the fast one answered
+		if cleanDown && !upstreamFailed(r.res, r.err) {
+			reason += ", clean upstream down"
+			degraded = true
+			break
+		}
+		r = recv(cleanCh, &cleanR)
 		upstream = cleanUpstream
+		role = roleClean
+		if probe != nil && !poisoned {
+			select {
+			case poisoned = <-probe:
+			default:
+			}
+		}
 	}
 
-	// update cnDomains cache
-	if r.res != nil && r.res.Rcode == dns.RcodeSuccess && containsA(r.res) {
-		resolver.cnDomains.Set(q.Name, containsChinaip(r.res))
+	if r.res != nil && r.res.Rcode == dns.RcodeServerFailure && r.err != nil {
+		upstreamError(r.res, r.err)
+	}
+	if resolver.rebind != nil && r.res != nil && r.res.Rcode == dns.RcodeSuccess {
+		if rebinding, refuse := resolver.rebind.apply(q, r.res); refuse {
+			r.res = &dns.Msg{
+				MsgHdr: dns.MsgHdr{
+					Rcode: dns.RcodeRefused,
+				},
+			}
+			setEDE(r.res, edeBlocked, "DNS rebinding")
+			reason += ", rebinding refused"
+		} else if rebinding {
+			reason += ", rebinding stripped"
+		}
 	}
 
-	return r.res, upstream
+	// update the classification, the poisoned domains are never local, and
+	// the answers of the other upstream may tell the wrong one
+	if degraded {
+		capTTL(r.res, degradedTTL)
+		atomic.AddUint64(&resolver.health.degradedAnswers, 1)
+	} else if poisoned {
+		resolver.classes.reset(q.Name, false)
+	} else if r.res != nil && r.res.Rcode == dns.RcodeSuccess && containsA(r.res) {
+		resolver.classes.observe(q.Name, resolver.isLocalAnswer(r.res))
+	}
+	if withdraw != nil {
+		go resolver.withdrawIfPoisoned(q, withdraw)
+	}
+	if local && r.res != nil && r.res.Rcode == dns.RcodeSuccess {
+		resolver.rewriteMixedAnswer(r.res)
+	}
+	if (resolver.noECH || resolver.filterAAAA) && r.res != nil && r.res.Rcode == dns.RcodeSuccess {
+		rewriteSVCB(r.res, func(s *svcb) {
+			if resolver.noECH {
+				s.removeParam(svcParamECH)
+			}
+			if resolver.filterAAAA {
+				s.filterHints(netip.Addr.Is4)
+			}
+		})
+	}
+	if resolver.fastest != nil && r.res != nil && r.res.Rcode == dns.RcodeSuccess && (q.Qtype == dns.TypeA || q.Qtype == dns.TypeAAAA) {
+		// the local IPs in the clean answer to a local domain are candidates
+		// too, unlike the IPs in the fast answer to a foreign one, which may
+		// be forged
+		var extra []dns.RR
+		if local && cleanR == nil {
+			select {
+			case c := <-cleanCh:
+				cleanR = &c
+			default:
+			}
+		}
+		if local && cleanR != nil && cleanR.res.Rcode == dns.RcodeSuccess {
+			for _, rr := range cleanR.res.Answer {
+				if a, ok := rr.(*dns.A); ok && isLocalA(a, resolver.localIPs) {
+					extra = append(extra, rr)
+				}
+			}
+		}
+		resolver.fastest.apply(r.res, extra)
+	}
+
+	if trace != nil {
+		trace.Upstream = upstream
+		trace.Reason = reason
+		complete := func() {
+			if fastR == nil {
+				recv(fastCh, &fastR)
+			}
+			if cleanR == nil {
+				recv(cleanCh, &cleanR)
+			}
+			trace.Fast = UpstreamTrace{fastUpstream, fastR.traced, fastR.err, fastR.rtt}
+			trace.Clean = UpstreamTrace{cleanUpstream, cleanR.traced, cleanR.err, cleanR.rtt}
+		}
+		if trace.Done == nil {
+			complete()
+		} else {
+			go func() {
+				complete()
+				trace.Done()
+			}()
+		}
+	}
+
+	return r.res, upstream, role
 }
 
+// withdrawIfPoisoned waits for the poison probe of `q`, and if it is
+// answered, classifies q as foreign and evicts the local answer served.
+func (resolver *spoofingProofResolver) withdrawIfPoisoned(q dns.Question, probe <-chan bool) {
+	if !<-probe {
+		return
+	}
+	resolver.classes.reset(q.Name, false)
+	if resolver.evict != nil {
+		resolver.evict(q)
+	}
+	log.WithFields(logrus.Fields{
+		"op":     "probe_poison",
+		"domain": q.Name,
+	}).Warn("local answer withdrawn, the blackhole probe answered")
+}
+
+// upstreamUDPSize is the EDNS payload size of the queries to the upstreams,
+// the one of the DNS flag day 2020 avoiding the fragmentation.
+const upstreamUDPSize = 1232
+
 func naiveResolve(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error) {
 	r := &dns.Msg{
 		MsgHdr: dns.MsgHdr{
@@ -108,9 +430,14 @@ func naiveResolve(q dns.Question, recursion bool, net string, upstream string) (
 		},
 		Question: []dns.Question{q},
 	}
+	// with EDNS the upstreams tell the Extended DNS Errors
+	r.SetEdns0(upstreamUDPSize, false)
 	c := &dns.Client{Net: net}
 
 	res, _, err := c.Exchange(r, upstream)
+	if res != nil {
+		keepUpstreamEDE(res)
+	}
 
 	if err != nil {
 		log.WithFields(logrus.Fields{
@@ -141,11 +468,17 @@ func containsA(res *dns.Msg) bool {
 			return true
 		}
 	}
+	// the ipv4hints of the HTTPS records tell as much
+	for _, addr := range svcbHints(res) {
+		if addr.Is4() {
+			return true
+		}
+	}
 	return false
 }
 
 // containChinaIP check if the resoponse contains IP belonging to China.
-func containsChinaip(res *dns.Msg) bool {
+func containsChinaip(res *dns.Msg, localIPs chinaip.Classifier) bool {
 	var rrs []dns.RR
 
 	rrs = append(rrs, res.Answer...)
@@ -155,8 +488,8 @@ func containsChinaip(res *dns.Msg) bool {
 	for i := 0; i < len(rrs); i++ {
 		rr, ok := rrs[i].(*dns.A)
 		if ok {
-			ip := rr.A.String()
-			if chinaip.IsChinaIP(ip) {
+			ip, ok := netip.AddrFromSlice(rr.A.To4())
+			if ok && localIPs.Contains(ip) {
 				return true
 			}
 		}
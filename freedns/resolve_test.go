@@ -5,32 +5,78 @@ import (
 	"time"
 
 	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
 )
 
+// testWorld is an offline imitation of the network freedns works in: the
+// fast upstream is a recursive resolver in China whose queries go through
+// the GFW, and the clean upstream reaches the authoritative servers directly.
+type testWorld struct {
+	auth  *freednstest.Upstream
+	gfw   *freednstest.GFW
+	fast  *freednstest.Upstream
+	clean *freednstest.Upstream
+}
+
+func newTestWorld(t *testing.T) *testWorld {
+	t.Helper()
+	w := &testWorld{}
+	var err error
+	if w.auth, err = freednstest.NewUpstream(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(w.auth.Close)
+	if w.gfw, err = freednstest.NewGFW(w.auth.Addr); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(w.gfw.Close)
+	if w.fast, err = freednstest.NewUpstream(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(w.fast.Close)
+	if w.clean, err = freednstest.NewUpstream(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(w.clean.Close)
+	w.fast.Forward(w.gfw.Addr)
+	w.clean.Forward(w.auth.Addr)
+
+	w.auth.Handle("ustc.edu.cn", dns.TypeA, freednstest.IPs("ustc.edu.cn", "202.38.64.246"))
+	w.auth.Handle("ustc.edu.cn", dns.TypeMX, freednstest.RRs("ustc.edu.cn. 300 IN MX 10 smtp.ustc.edu.cn."))
+	w.auth.Handle("mi.cn", dns.TypeA, freednstest.IPs("mi.cn", "111.13.104.1"))
+	w.auth.Handle("google.com", dns.TypeA, freednstest.IPs("google.com", "142.250.66.78"))
+	w.auth.Handle("twitter.com", dns.TypeA, freednstest.IPs("twitter.com", "104.244.42.1"))
+	w.gfw.Poison("google.com")
+	w.gfw.Poison("twitter.com")
+	return w
+}
+
 func Test_spoofing_proof_resolver_resolve(t *testing.T) {
-	resolver := newSpoofingProofResolver(&staticUpstreamProvider{"114.114.114.114:53"}, &staticUpstreamProvider{"8.8.8.8:53"}, 1024)
+	w := newTestWorld(t)
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
 
 	tests := []struct {
 		domain           string
 		qtype            uint16
 		net              string
 		expectedUpstream string
+		reason           string
+		answer           string
 	}{
-		// expect 8.8.8.8 as the upstream b/c the resolver have
+		// expect the clean upstream b/c the resolver have
 		// no way to identify this is an China domain without A records
-		{"ustc.edu.cn.", dns.TypeMX, "udp", "8.8.8.8:53"},
-		{"ustc.edu.cn.", dns.TypeA, "udp", "114.114.114.114:53"},
+		{"ustc.edu.cn.", dns.TypeMX, "udp", w.clean.Addr, "fast answer has no A records", "smtp.ustc.edu.cn."},
+		{"ustc.edu.cn.", dns.TypeA, "udp", w.fast.Addr, "fast answer contains local IPs", "202.38.64.246"},
 		// after querying the A record of ustc.edu.cn,
 		// the resolver should know this is an China domain
-		{"ustc.edu.cn.", dns.TypeMX, "udp", "114.114.114.114:53"},
-		{"google.com.", dns.TypeA, "udp", "8.8.8.8:53"},
-		{"mi.cn.", dns.TypeA, "udp", "114.114.114.114:53"},
-		{"xiaomi.com.", dns.TypeA, "udp", "114.114.114.114:53"},
-		{"youtube.com.", dns.TypeA, "udp", "8.8.8.8:53"},
-
-		// This fails just because the GitHub CI server has slow and unstable connection with the 114 server.
-		//{"www.tsinghua.edu.cn.", dns.TypeA, "tcp", "114.114.114.114:53"},
-		{"twitter.com.", dns.TypeA, "tcp", "8.8.8.8:53"},
+		{"ustc.edu.cn.", dns.TypeMX, "udp", w.fast.Addr, "cached classification: china", "smtp.ustc.edu.cn."},
+		// the fast upstream is poisoned
+		{"google.com.", dns.TypeA, "udp", w.clean.Addr, "fast answer has no local IPs", "142.250.66.78"},
+		{"mi.cn.", dns.TypeA, "udp", w.fast.Addr, "fast answer contains local IPs", "111.13.104.1"},
+		{"twitter.com.", dns.TypeA, "tcp", w.clean.Addr, "fast answer has no local IPs", "104.244.42.1"},
+		{"google.com.", dns.TypeA, "udp", w.clean.Addr, "cached classification: foreign", "142.250.66.78"},
+		{"nx.example.com.", dns.TypeA, "udp", w.clean.Addr, "fast upstream failed", ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.domain, func(t *testing.T) {
@@ -40,17 +86,62 @@ func Test_spoofing_proof_resolver_resolve(t *testing.T) {
 				Qclass: dns.ClassINET,
 			}
 
-			start := time.Now()
-			res, upstream := resolver.resolve(q, true, tt.net)
-			end := time.Now()
-			elapsed := end.Sub(start)
+			trace := &Trace{Question: q}
+			res, upstream, _ := resolver.resolve(q, true, tt.net, trace)
 			if upstream != tt.expectedUpstream {
 				t.Errorf("spoofing_proof_resolver.resolve() got1 = %v, want %v", upstream, tt.expectedUpstream)
 			}
-			if len(res.Answer) == 0 {
-				t.Errorf("Expect returning at least one answer")
+			if trace.Upstream != upstream || trace.Reason != tt.reason {
+				t.Errorf("got trace %v: %v, want %v", trace.Upstream, trace.Reason, tt.reason)
+			}
+			if answer := firstAnswer(res); answer != tt.answer {
+				t.Errorf("got answer %q, want %q", answer, tt.answer)
 			}
-			t.Logf("spoofing_proof_resolver.resolve() domain = %v, net = %v, elapsed = %v, record = %v", tt.domain, tt.net, elapsed, res)
 		})
 	}
+
+	if w.gfw.Injected() == 0 {
+		t.Errorf("the fast upstream should be poisoned")
+	}
+}
+
+func Test_spoofing_proof_resolver_fast_upstream_misbehaving(t *testing.T) {
+	w := newTestWorld(t)
+	resolver := newSpoofingProofResolver(&staticUpstreamProvider{w.fast.Addr}, &staticUpstreamProvider{w.clean.Addr}, chinaip.ClassifierFunc(chinaip.Contains), 1024)
+
+	w.fast.Handle("ustc.edu.cn", dns.TypeA, freednstest.Answer{Truncate: true})
+	w.fast.Handle("mi.cn", dns.TypeA, freednstest.Answer{Drop: true})
+
+	tests := []struct {
+		domain string
+		reason string
+	}{
+		{"ustc.edu.cn.", "fast answer has no A records"},
+		{"mi.cn.", "fast upstream failed"},
+	}
+	for _, tt := range tests {
+		q := dns.Question{Name: tt.domain, Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		trace := &Trace{Question: q}
+		start := time.Now()
+		res, upstream, _ := resolver.resolve(q, true, "udp", trace)
+		if upstream != w.clean.Addr || trace.Reason != tt.reason || len(res.Answer) != 1 {
+			t.Errorf("%s: got %v from %v: %v", tt.domain, res, upstream, trace.Reason)
+		}
+		if elapsed := time.Since(start); elapsed > 2500*time.Millisecond {
+			t.Errorf("%s: should give up the fast upstream in time, took %v", tt.domain, elapsed)
+		}
+	}
+}
+
+func firstAnswer(res *dns.Msg) string {
+	if len(res.Answer) == 0 {
+		return ""
+	}
+	switch rr := res.Answer[0].(type) {
+	case *dns.A:
+		return rr.A.String()
+	case *dns.MX:
+		return rr.Mx
+	}
+	return res.Answer[0].String()
 }
@@ -0,0 +1,193 @@
+package freedns
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// The default domains of the self-test.
+var (
+	defaultSelfTestChinaDomains    = []string{"www.baidu.com", "www.qq.com", "www.taobao.com"}
+	defaultSelfTestPoisonedDomains = []string{"google.com"}
+)
+
+// SelfTestCheck is a check of the self-test.
+type SelfTestCheck struct {
+	Name    string
+	OK      bool
+	Message string
+}
+
+// SelfTest is the result of a self-test of the upstreams.
+type SelfTest struct {
+	Time   time.Time
+	Checks []SelfTestCheck
+}
+
+// OK returns whether all the checks passed.
+func (t SelfTest) OK() bool {
+	for _, c := range t.Checks {
+		if !c.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// RunSelfTest checks that the upstreams answer, that the fast upstream
+// answers the China domains with local IPs, and that the clean upstream
+// doesn't answer the poisoned domains with forged IPs, which catches the
+// upstreams swapped or misplaced. The result is logged and kept for the
+// readiness at /readyz.
+func (s *Server) RunSelfTest() SelfTest {
+	cnDomains := s.config.SelfTestChinaDomains
+	if len(cnDomains) == 0 {
+		cnDomains = defaultSelfTestChinaDomains
+	}
+	poisonedDomains := s.config.SelfTestPoisonedDomains
+	if len(poisonedDomains) == 0 {
+		poisonedDomains = defaultSelfTestPoisonedDomains
+	}
+	fastUpstream := s.resolver.fastUpstreamProvider.GetUpstream()
+	cleanUpstream := s.resolver.cleanUpstreamProvider.GetUpstream()
+	query := func(name string, upstream string) (*dns.Msg, error) {
+		q := dns.Question{Name: dns.Fqdn(name), Qtype: dns.TypeA, Qclass: dns.ClassINET}
+		res, err := s.resolver.exchange(q, true, "udp", upstream)
+		if err == nil && res == nil {
+			err = Error("no answer")
+		}
+		return res, err
+	}
+
+	result := SelfTest{Time: time.Now()}
+	check := func(name string, ok bool, format string, args ...interface{}) {
+		c := SelfTestCheck{Name: name, OK: ok}
+		if !ok {
+			c.Message = fmt.Sprintf(format, args...)
+		}
+		result.Checks = append(result.Checks, c)
+	}
+
+	// the fast upstream answers the China domains with local IPs
+	var notLocal []string
+	answered, local := false, false
+	for _, name := range cnDomains {
+		res, err := query(name, fastUpstream)
+		if err != nil || res.Rcode != dns.RcodeSuccess {
+			continue
+		}
+		answered = true
+		if containsChinaip(res, s.resolver.localIPs) {
+			local = true
+		} else {
+			notLocal = append(notLocal, name)
+		}
+	}
+	check("fast upstream answers", answered, "%s answers none of %s", fastUpstream, strings.Join(cnDomains, ", "))
+	if answered {
+		check("fast upstream is local", local,
+			"%s answers %s without local IPs, it may be abroad or swapped with the clean upstream", fastUpstream, strings.Join(notLocal, ", "))
+	}
+
+	// the clean upstream answers, and doesn't answer the nonexistent
+	// subdomains of the poisoned domains, which the GFW forges
+	res, err := query(cnDomains[0], cleanUpstream)
+	if err == nil && res.Rcode != dns.RcodeSuccess {
+		err = Error(dns.RcodeToString[res.Rcode])
+	}
+	check("clean upstream answers", err == nil, "%s fails to answer %s: %v", cleanUpstream, cnDomains[0], err)
+	if err == nil {
+		var forged []string
+		for _, name := range poisonedDomains {
+			label := make([]byte, 8)
+			rand.Read(label)
+			res, err := query("freedns-selftest-"+hex.EncodeToString(label)+"."+name, cleanUpstream)
+			if err == nil && res.Rcode == dns.RcodeSuccess && containsA(res) {
+				forged = append(forged, name)
+			}
+		}
+		check("clean upstream is clean", len(forged) == 0,
+			"%s answers %s with forged IPs, it may be poisoned or swapped with the fast upstream", cleanUpstream, strings.Join(forged, ", "))
+	}
+
+	for _, c := range result.Checks {
+		l := log.WithFields(logrus.Fields{
+			"op":    "self_test",
+			"check": c.Name,
+		})
+		if c.OK {
+			l.Debug()
+		} else {
+			l.Warn(c.Message)
+		}
+	}
+
+	s.selfTestMu.Lock()
+	s.selfTest = &result
+	s.selfTestMu.Unlock()
+	return result
+}
+
+// LastSelfTest returns the result of the last self-test if any.
+func (s *Server) LastSelfTest() (SelfTest, bool) {
+	s.selfTestMu.Lock()
+	defer s.selfTestMu.Unlock()
+	if s.selfTest == nil {
+		return SelfTest{}, false
+	}
+	return *s.selfTest, true
+}
+
+// selfTestPeriodically runs the self-test at startup and every
+// SelfTestInterval until the server is shut down.
+func (s *Server) selfTestPeriodically() {
+	interval := s.config.SelfTestInterval
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunSelfTest()
+
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// serveReady reports the readiness: ready unless the self-test is enabled
+// and hasn't passed the last time.
+func (s *Server) serveReady(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	if !s.config.SelfTest {
+		fmt.Fprintln(w, "ok")
+		return
+	}
+	result, ok := s.LastSelfTest()
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, "self-test pending")
+		return
+	}
+	if !result.OK() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	for _, c := range result.Checks {
+		if c.OK {
+			fmt.Fprintf(w, "ok   %s\n", c.Name)
+		} else {
+			fmt.Fprintf(w, "FAIL %s: %s\n", c.Name, c.Message)
+		}
+	}
+}
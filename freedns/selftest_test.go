@@ -0,0 +1,57 @@
+package freedns
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSelfTest(t *testing.T) {
+	w := newTestWorld(t)
+
+	tests := []struct {
+		name   string
+		fast   string
+		clean  string
+		failed string
+	}{
+		{"configured well", w.fast.Addr, w.clean.Addr, ""},
+		{"swapped", w.clean.Addr, w.fast.Addr, "clean upstream is clean"},
+		{"fast upstream down", "127.0.0.1:1", w.clean.Addr, "fast upstream answers"},
+	}
+	for _, tt := range tests {
+		s, err := NewServer(Config{
+			FastUpstream:            tt.fast,
+			CleanUpstream:           tt.clean,
+			SelfTest:                true,
+			SelfTestChinaDomains:    []string{"ustc.edu.cn", "mi.cn"},
+			SelfTestPoisonedDomains: []string{"google.com"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rec := httptest.NewRecorder()
+		s.serveReady(rec, httptest.NewRequest("GET", "/readyz", nil))
+		if rec.Code != 503 {
+			t.Errorf("%s: should not be ready before the self-test", tt.name)
+		}
+
+		result := s.RunSelfTest()
+		var failed []string
+		for _, c := range result.Checks {
+			if !c.OK {
+				failed = append(failed, c.Name)
+			}
+		}
+		if strings.Join(failed, ", ") != tt.failed {
+			t.Errorf("%s: got failed checks %v, want %v", tt.name, failed, tt.failed)
+		}
+
+		rec = httptest.NewRecorder()
+		s.serveReady(rec, httptest.NewRequest("GET", "/readyz", nil))
+		if ready := rec.Code == 200; ready != (tt.failed == "") {
+			t.Errorf("%s: got readiness %d: %s", tt.name, rec.Code, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package freedns
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// SetSink receives the IPs in the answers, e.g. to add them to an nftables
+// set or an ipset used for policy routing, like the ipset= and nftset=
+// options of dnsmasq.
+type SetSink interface {
+	// Add adds the IPs `addrs` resolved from `domain` to the set,
+	// expiring after `ttl`.
+	Add(domain string, addrs []netip.Addr, ttl time.Duration) error
+	Close() error
+}
+
+// NewSetSink creates a SetSink from its spec, which is one of:
+//
+//	nft:family/table/set    an nftables set of IPv4 addresses
+//	nft6:family/table/set   an nftables set of IPv6 addresses
+//	ipset:name              an ipset of IPv4 addresses
+//	ipset6:name             an ipset of IPv6 addresses
+//	file:path               appends "ip ttl domain" lines to the file
+//
+// The nftables sets must be created with the timeout flag, and the ipsets
+// with the timeout option.
+func NewSetSink(spec string) (SetSink, error) {
+	i := strings.IndexByte(spec, ':')
+	if i < 0 {
+		return nil, Error("invalid set sink: " + spec)
+	}
+	kind, arg := spec[:i], spec[i+1:]
+	switch kind {
+	case "nft", "nft6":
+		parts := strings.Split(arg, "/")
+		if len(parts) != 3 {
+			return nil, Error("invalid nftables set: " + arg)
+		}
+		return newNftSetSink(parts[0], parts[1], parts[2], kind == "nft6")
+	case "ipset", "ipset6":
+		return newIPSetSink(arg, kind == "ipset6")
+	case "file":
+		return newFileSetSink(arg)
+	}
+	return nil, Error("unknown set sink: " + kind)
+}
+
+// fileSetSink appends the IPs to a file, for scripts or testing.
+type fileSetSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func newFileSetSink(filename string) (SetSink, error) {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSetSink{f: f}, nil
+}
+
+func (s *fileSetSink) Add(domain string, addrs []netip.Addr, ttl time.Duration) error {
+	var b strings.Builder
+	for _, addr := range addrs {
+		fmt.Fprintf(&b, "%s %d %s\n", addr, int(ttl.Seconds()), domain)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.f.WriteString(b.String())
+	return err
+}
+
+func (s *fileSetSink) Close() error {
+	return s.f.Close()
+}
+
+// newSetSinks creates the sinks in the configuration.
+func newSetSinks(cfg Config) ([]SetSink, error) {
+	sinks := append([]SetSink(nil), cfg.SetSinks...)
+	for _, spec := range cfg.IPSets {
+		sink, err := NewSetSink(spec)
+		if err != nil {
+			for _, s := range sinks[len(cfg.SetSinks):] {
+				s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+// sinkAnswer hands the IPs in `res` from the upstream of `role` to the set
+// sinks in the background if wanted.
+func (s *Server) sinkAnswer(res *dns.Msg, role int) {
+	if len(s.setSinks) == 0 || res.Rcode != dns.RcodeSuccess || !s.wantSetSink(res.Question[0].Name, role) {
+		return
+	}
+	go s.addToSets(res.Copy())
+}
+
+// wantSetSink returns whether the IPs in the answer to `domain` from the
+// upstream of `role` should go to the set sinks: the answers from the clean
+// upstream, unless IPSetCleanUpstream is off, and the answers to IPSetDomains.
+func (s *Server) wantSetSink(domain string, role int) bool {
+	if s.config.IPSetCleanUpstream && role == roleClean {
+		return true
+	}
+	return matchDomain(domain, s.config.IPSetDomains)
+}
+
+// addToSets adds the IPs in `res` to the set sinks, expiring with the
+// smallest TTL of them.
+func (s *Server) addToSets(res *dns.Msg) {
+	var addrs []netip.Addr
+	var ttl uint32
+	for _, rr := range res.Answer {
+		var rrAddrs []netip.Addr
+		if addr, ok := addrOf(rr); ok {
+			rrAddrs = []netip.Addr{addr}
+		} else if s := parseSVCB(rr); s != nil {
+			// the clients may connect to the hints of the HTTPS records
+			rrAddrs = s.hints()
+		}
+		if len(rrAddrs) == 0 {
+			continue
+		}
+		if len(addrs) == 0 || rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+		addrs = append(addrs, rrAddrs...)
+	}
+	if len(addrs) == 0 {
+		return
+	}
+	if ttl == 0 {
+		ttl = 1
+	}
+
+	domain := res.Question[0].Name
+	for _, sink := range s.setSinks {
+		if err := sink.Add(domain, addrs, time.Duration(ttl)*time.Second); err != nil {
+			log.WithFields(logrus.Fields{
+				"op":     "set_sink",
+				"domain": domain,
+			}).Error(err)
+		}
+	}
+}
+
+// matchDomain returns whether `name` is one of `domains` or their subdomains.
+func matchDomain(name string, domains []string) bool {
+	name = strings.ToLower(dns.Fqdn(name))
+	for _, d := range domains {
+		d = strings.ToLower(dns.Fqdn(d))
+		if name == d || strings.HasSuffix(name, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package freedns
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// The ipset netlink protocol, from linux/netfilter/ipset/ip_set.h.
+const (
+	ipsetProtocol       = 6
+	ipsetCmdAdd         = 9
+	ipsetAttrProtocol   = 1
+	ipsetAttrSetName    = 2
+	ipsetAttrData       = 7
+	ipsetAttrIP         = 1
+	ipsetAttrTimeout    = 6
+	ipsetAttrIPAddrIPv4 = 1
+	ipsetAttrIPAddrIPv6 = 2
+)
+
+// nftFamilies maps the nftables family names to their values.
+var nftFamilies = map[string]uint8{
+	"ip":     unix.NFPROTO_IPV4,
+	"ip6":    unix.NFPROTO_IPV6,
+	"inet":   unix.NFPROTO_INET,
+	"arp":    unix.NFPROTO_ARP,
+	"bridge": unix.NFPROTO_BRIDGE,
+	"netdev": unix.NFPROTO_NETDEV,
+}
+
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 0 {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// netlinkConn is a NETLINK_NETFILTER socket sending requests one at a time.
+type netlinkConn struct {
+	mu  sync.Mutex
+	fd  int
+	seq uint32
+}
+
+func newNetlinkConn() (*netlinkConn, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_NETFILTER)
+	if err != nil {
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	tv := unix.NsecToTimeval(int64(time.Second))
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return &netlinkConn{fd: fd}, nil
+}
+
+// request sends the messages built by `build` numbered from `seq` up to
+// `ackSeq`+1 at most, and waits for the ack of the message `ackSeq`.
+func (c *netlinkConn) request(build func(seq uint32) ([]byte, uint32)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	msg, ackSeq := build(c.seq + 1)
+	c.seq = ackSeq + 1
+	if err := unix.Sendto(c.fd, msg, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 8192)
+	for {
+		n, _, err := unix.Recvfrom(c.fd, buf, 0)
+		if err != nil {
+			return err
+		}
+		for b := buf[:n]; len(b) >= unix.NLMSG_HDRLEN; {
+			l := int(nativeEndian.Uint32(b[0:4]))
+			if l < unix.NLMSG_HDRLEN || l > len(b) {
+				break
+			}
+			typ := nativeEndian.Uint16(b[4:6])
+			seq := nativeEndian.Uint32(b[8:12])
+			if typ == unix.NLMSG_ERROR && seq == ackSeq && l >= unix.NLMSG_HDRLEN+4 {
+				if errno := int32(nativeEndian.Uint32(b[16:20])); errno != 0 {
+					return syscall.Errno(-errno)
+				}
+				return nil
+			}
+			b = b[nlmsgAlign(l):]
+		}
+	}
+}
+
+func (c *netlinkConn) close() error {
+	return unix.Close(c.fd)
+}
+
+func nlmsgAlign(l int) int {
+	return (l + unix.NLMSG_ALIGNTO - 1) &^ (unix.NLMSG_ALIGNTO - 1)
+}
+
+// nlmsg encodes a netfilter netlink message.
+func nlmsg(typ uint16, flags uint16, seq uint32, family uint8, resID uint16, attrs ...[]byte) []byte {
+	b := make([]byte, unix.NLMSG_HDRLEN+4)
+	nativeEndian.PutUint16(b[4:6], typ)
+	nativeEndian.PutUint16(b[6:8], flags)
+	nativeEndian.PutUint32(b[8:12], seq)
+	b[16] = family
+	b[17] = unix.NFNETLINK_V0
+	binary.BigEndian.PutUint16(b[18:20], resID)
+	for _, a := range attrs {
+		b = append(b, a...)
+	}
+	nativeEndian.PutUint32(b[0:4], uint32(len(b)))
+	return b
+}
+
+// nlattr encodes a netlink attribute.
+func nlattr(typ uint16, data []byte) []byte {
+	l := unix.NLA_HDRLEN + len(data)
+	b := make([]byte, (l+unix.NLA_ALIGNTO-1)&^(unix.NLA_ALIGNTO-1))
+	nativeEndian.PutUint16(b[0:2], uint16(l))
+	nativeEndian.PutUint16(b[2:4], typ)
+	copy(b[unix.NLA_HDRLEN:], data)
+	return b
+}
+
+// nested encodes a netlink attribute of the attributes `attrs`.
+func nested(typ uint16, attrs ...[]byte) []byte {
+	var data []byte
+	for _, a := range attrs {
+		data = append(data, a...)
+	}
+	return nlattr(typ|unix.NLA_F_NESTED, data)
+}
+
+func cstring(s string) []byte {
+	return append([]byte(s), 0)
+}
+
+// selectAddrs returns the IPv4 or IPv6 addresses in `addrs`.
+func selectAddrs(addrs []netip.Addr, ipv6 bool) []netip.Addr {
+	var selected []netip.Addr
+	for _, addr := range addrs {
+		if addr.Is4() != ipv6 {
+			selected = append(selected, addr)
+		}
+	}
+	return selected
+}
+
+// nftSetSink adds the IPs to an nftables set.
+type nftSetSink struct {
+	conn   *netlinkConn
+	family uint8
+	table  string
+	set    string
+	ipv6   bool
+}
+
+func newNftSetSink(family string, table string, set string, ipv6 bool) (SetSink, error) {
+	f, ok := nftFamilies[family]
+	if !ok {
+		return nil, Error("unknown nftables family: " + family)
+	}
+	conn, err := newNetlinkConn()
+	if err != nil {
+		return nil, err
+	}
+	return &nftSetSink{conn: conn, family: f, table: table, set: set, ipv6: ipv6}, nil
+}
+
+// newSetElemMsg encodes the batch adding `addrs` to the set.
+func (s *nftSetSink) newSetElemMsg(seq uint32, addrs []netip.Addr, ttl time.Duration) ([]byte, uint32) {
+	timeout := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeout, uint64(ttl/time.Millisecond))
+
+	var elems [][]byte
+	for _, addr := range addrs {
+		elems = append(elems, nested(unix.NFTA_LIST_ELEM,
+			nested(unix.NFTA_SET_ELEM_KEY, nlattr(unix.NFTA_DATA_VALUE, addr.AsSlice())),
+			nlattr(unix.NFTA_SET_ELEM_TIMEOUT, timeout),
+		))
+	}
+
+	var b []byte
+	b = append(b, nlmsg(unix.NFNL_MSG_BATCH_BEGIN, unix.NLM_F_REQUEST, seq, unix.AF_UNSPEC, unix.NFNL_SUBSYS_NFTABLES)...)
+	b = append(b, nlmsg(unix.NFNL_SUBSYS_NFTABLES<<8|unix.NFT_MSG_NEWSETELEM, unix.NLM_F_REQUEST|unix.NLM_F_CREATE|unix.NLM_F_ACK, seq+1, s.family, 0,
+		nlattr(unix.NFTA_SET_ELEM_LIST_TABLE, cstring(s.table)),
+		nlattr(unix.NFTA_SET_ELEM_LIST_SET, cstring(s.set)),
+		nested(unix.NFTA_SET_ELEM_LIST_ELEMENTS, elems...),
+	)...)
+	b = append(b, nlmsg(unix.NFNL_MSG_BATCH_END, unix.NLM_F_REQUEST, seq+2, unix.AF_UNSPEC, unix.NFNL_SUBSYS_NFTABLES)...)
+	return b, seq + 1
+}
+
+func (s *nftSetSink) Add(domain string, addrs []netip.Addr, ttl time.Duration) error {
+	addrs = selectAddrs(addrs, s.ipv6)
+	if len(addrs) == 0 {
+		return nil
+	}
+	return s.conn.request(func(seq uint32) ([]byte, uint32) {
+		return s.newSetElemMsg(seq, addrs, ttl)
+	})
+}
+
+func (s *nftSetSink) Close() error {
+	return s.conn.close()
+}
+
+// ipsetSink adds the IPs to an ipset.
+type ipsetSink struct {
+	conn *netlinkConn
+	name string
+	ipv6 bool
+}
+
+func newIPSetSink(name string, ipv6 bool) (SetSink, error) {
+	conn, err := newNetlinkConn()
+	if err != nil {
+		return nil, err
+	}
+	return &ipsetSink{conn: conn, name: name, ipv6: ipv6}, nil
+}
+
+// addMsg encodes the request adding `addr` to the ipset. Without NLM_F_EXCL,
+// adding an existing entry updates its timeout.
+func (s *ipsetSink) addMsg(seq uint32, addr netip.Addr, ttl time.Duration) []byte {
+	family, attrIP := uint8(unix.AF_INET), uint16(ipsetAttrIPAddrIPv4)
+	if addr.Is6() {
+		family, attrIP = unix.AF_INET6, ipsetAttrIPAddrIPv6
+	}
+	timeout := make([]byte, 4)
+	binary.BigEndian.PutUint32(timeout, uint32(ttl/time.Second))
+
+	return nlmsg(unix.NFNL_SUBSYS_IPSET<<8|ipsetCmdAdd, unix.NLM_F_REQUEST|unix.NLM_F_ACK, seq, family, 0,
+		nlattr(ipsetAttrProtocol, []byte{ipsetProtocol}),
+		nlattr(ipsetAttrSetName, cstring(s.name)),
+		nested(ipsetAttrData,
+			nested(ipsetAttrIP, nlattr(attrIP|unix.NLA_F_NET_BYTEORDER, addr.AsSlice())),
+			nlattr(ipsetAttrTimeout|unix.NLA_F_NET_BYTEORDER, timeout),
+		),
+	)
+}
+
+func (s *ipsetSink) Add(domain string, addrs []netip.Addr, ttl time.Duration) error {
+	for _, addr := range selectAddrs(addrs, s.ipv6) {
+		err := s.conn.request(func(seq uint32) ([]byte, uint32) {
+			return s.addMsg(seq, addr, ttl), seq
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ipsetSink) Close() error {
+	return s.conn.close()
+}
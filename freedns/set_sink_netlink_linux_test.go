@@ -0,0 +1,53 @@
+package freedns
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestNftSetElemMsg(t *testing.T) {
+	if nativeEndian.Uint16([]byte{1, 0}) != 1 {
+		t.Skip("the expected messages are little endian")
+	}
+	s := &nftSetSink{family: 1, table: "fw4", set: "clean"}
+	msg, ack := s.newSetElemMsg(7, []netip.Addr{netip.MustParseAddr("1.2.3.4")}, 300*time.Second)
+
+	want, _ := hex.DecodeString("" +
+		// batch begin
+		"14000000" + "1000" + "0100" + "07000000" + "00000000" + "0000000a" +
+		// new set element
+		"48000000" + "0c0a" + "0504" + "08000000" + "00000000" + "01000000" +
+		"0800" + "0100" + "66773400" +
+		"0a00" + "0200" + "636c65616e000000" +
+		"2000" + "0380" +
+		/**/ "1c00" + "0180" +
+		/*  */ "0c00" + "0180" + "0800" + "0100" + "01020304" +
+		/*  */ "0c00" + "0400" + "00000000000493e0" +
+		// batch end
+		"14000000" + "1100" + "0100" + "09000000" + "00000000" + "0000000a")
+	if ack != 8 || !bytes.Equal(msg, want) {
+		t.Errorf("got ack %d, message\n%x\nwant\n%x", ack, msg, want)
+	}
+}
+
+func TestIPSetAddMsg(t *testing.T) {
+	if nativeEndian.Uint16([]byte{1, 0}) != 1 {
+		t.Skip("the expected messages are little endian")
+	}
+	s := &ipsetSink{name: "clean"}
+	msg := s.addMsg(3, netip.MustParseAddr("1.2.3.4"), 300*time.Second)
+
+	want, _ := hex.DecodeString("" +
+		"40000000" + "0906" + "0500" + "03000000" + "00000000" + "02000000" +
+		"0500" + "0100" + "06000000" +
+		"0a00" + "0200" + "636c65616e000000" +
+		"1800" + "0780" +
+		/**/ "0c00" + "0180" + "0800" + "0140" + "01020304" +
+		/**/ "0800" + "0640" + "0000012c")
+	if !bytes.Equal(msg, want) {
+		t.Errorf("got message\n%x\nwant\n%x", msg, want)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package freedns
+
+func newNftSetSink(family string, table string, set string, ipv6 bool) (SetSink, error) {
+	return nil, Error("nftables sets are only supported on linux")
+}
+
+func newIPSetSink(name string, ipv6 bool) (SetSink, error) {
+	return nil, Error("ipsets are only supported on linux")
+}
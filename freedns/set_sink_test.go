@@ -0,0 +1,161 @@
+package freedns
+
+import (
+	"io/ioutil"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+func TestSetSinks(t *testing.T) {
+	w := newTestWorld(t)
+	w.auth.Handle("google.com", dns.TypeAAAA, freednstest.IPs("google.com", "2404:6800:4005::200e"))
+	w.auth.Handle("ustc.edu.cn", dns.TypeAAAA, freednstest.IPs("ustc.edu.cn", "2001:da8:d800::1"))
+
+	tempfile, err := ioutil.TempFile("", "test_set_sink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempfile.Close()
+	defer os.Remove(tempfile.Name())
+
+	sink := freednstest.NewSetSink()
+	s, err := NewServer(Config{
+		FastUpstream:       w.fast.Addr,
+		CleanUpstream:      w.clean.Addr,
+		CacheCap:           1024,
+		IPSets:             []string{"file:" + tempfile.Name()},
+		IPSetCleanUpstream: true,
+		IPSetDomains:       []string{"mi.cn"},
+		SetSinks:           []SetSink{sink},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Shutdown()
+
+	for _, q := range []struct {
+		name  string
+		qtype uint16
+	}{
+		{"google.com.", dns.TypeA},
+		{"google.com.", dns.TypeAAAA},
+		{"ustc.edu.cn.", dns.TypeA},
+		{"mi.cn.", dns.TypeA},
+		{"ustc.edu.cn.", dns.TypeAAAA},
+	} {
+		s.Query(dns.Question{Name: q.name, Qtype: q.qtype, Qclass: dns.ClassINET}, "udp")
+	}
+
+	want := map[string]string{
+		"142.250.66.78":        "google.com.",
+		"2404:6800:4005::200e": "google.com.",
+		"111.13.104.1":         "mi.cn.",
+	}
+	entries := sink.Wait(len(want), time.Second)
+	time.Sleep(50 * time.Millisecond)
+	if entries = sink.Entries(); len(entries) != len(want) {
+		t.Fatalf("got %v, want %v", entries, want)
+	}
+	for _, e := range entries {
+		if want[e.Addr.String()] != e.Domain || e.TTL != 300*time.Second {
+			t.Errorf("unexpected entry %v", e)
+		}
+	}
+
+	data, err := ioutil.ReadFile(tempfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) == 0 {
+		t.Errorf("the file sink should get the IPs")
+	}
+
+	// the sinks of the caller are left open
+	s.Shutdown()
+	if sink.Closed() {
+		t.Errorf("the sink of the caller is closed")
+	}
+	if err := s.setSinks[1].Close(); err == nil {
+		t.Errorf("the file sink should be closed")
+	}
+}
+
+func TestWantSetSink(t *testing.T) {
+	// the same upstream as both roles, and only its answers as the clean
+	// one go to the sets
+	s := &Server{config: Config{
+		FastUpstream:       "127.0.0.1:53",
+		CleanUpstream:      "127.0.0.1:53",
+		IPSetCleanUpstream: true,
+		IPSetDomains:       []string{"mi.cn"},
+	}}
+	for _, tt := range []struct {
+		domain string
+		role   int
+		want   bool
+	}{
+		{"google.com.", roleClean, true},
+		{"ustc.edu.cn.", roleFast, false},
+		{"mi.cn.", roleFast, true},
+	} {
+		if got := s.wantSetSink(tt.domain, tt.role); got != tt.want {
+			t.Errorf("wantSetSink(%q, %s) = %v, want %v", tt.domain, roleNames[tt.role], got, tt.want)
+		}
+	}
+	s.config.IPSetCleanUpstream = false
+	if s.wantSetSink("google.com.", roleClean) {
+		t.Errorf("the clean answers should not go to the sets without IPSetCleanUpstream")
+	}
+}
+
+func TestNewSetSink(t *testing.T) {
+	for _, bad := range []string{"", "nft", "nft:inet/fw4", "nft:wtf/fw4/set", "iptables:set"} {
+		if _, err := NewSetSink(bad); err == nil {
+			t.Errorf("%q should be rejected", bad)
+		}
+	}
+}
+
+func TestMatchDomain(t *testing.T) {
+	domains := []string{"google.com", "Example.ORG."}
+	for name, want := range map[string]bool{
+		"google.com.":      true,
+		"www.google.com":   true,
+		"notgoogle.com.":   false,
+		"a.b.example.org.": true,
+		"org.":             false,
+	} {
+		if matchDomain(name, domains) != want {
+			t.Errorf("matchDomain(%q) should be %v", name, want)
+		}
+	}
+	if matchDomain("google.com.", nil) {
+		t.Errorf("nothing should match an empty list")
+	}
+}
+
+func TestAddToSetsTTL(t *testing.T) {
+	sink := freednstest.NewSetSink()
+	s := &Server{setSinks: []SetSink{sink}}
+	res := &dns.Msg{}
+	res.SetQuestion("example.com.", dns.TypeA)
+	for _, rr := range []string{
+		"example.com. 600 IN CNAME www.example.com.",
+		"www.example.com. 30 IN A 1.2.3.4",
+		"www.example.com. 0 IN A 1.2.3.5",
+	} {
+		r, _ := dns.NewRR(rr)
+		res.Answer = append(res.Answer, r)
+	}
+	s.addToSets(res)
+
+	entries := sink.Entries()
+	if len(entries) != 2 || entries[0].Addr != netip.MustParseAddr("1.2.3.4") || entries[0].TTL != time.Second {
+		t.Errorf("got %v", entries)
+	}
+}
@@ -0,0 +1,101 @@
+package freedns
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+// serverStats are the counters of a server. They are updated atomically,
+// and must be allocated separately to be 64-bit aligned on 32-bit platforms.
+type serverStats struct {
+	queries   uint64
+	cacheHits uint64
+	staleHits uint64
+	failures  uint64
+}
+
+// Stats is a snapshot of the counters of a server.
+type Stats struct {
+	Queries   uint64 // requests answered
+	CacheHits uint64 // requests answered by the cache, including StaleHits
+	StaleHits uint64 // requests answered by expired cache entries being refreshed
+	Failures  uint64 // requests answered with an rcode other than NOERROR or NXDOMAIN
+	Degraded  uint64 // answers of the other upstream served while one is down
+	Rejected  uint64 // queries to the upstreams rejected by the concurrency limits
+}
+
+// Stats returns the current counters of the server.
+func (s *Server) Stats() Stats {
+	return Stats{
+		Queries:   atomic.LoadUint64(&s.stats.queries),
+		CacheHits: atomic.LoadUint64(&s.stats.cacheHits),
+		StaleHits: atomic.LoadUint64(&s.stats.staleHits),
+		Failures:  atomic.LoadUint64(&s.stats.failures),
+		Degraded:  s.degradedAnswers(),
+		Rejected:  s.rejectedQueries(),
+	}
+}
+
+func (s *Server) degradedAnswers() uint64 {
+	if s.resolver == nil || s.resolver.health == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&s.resolver.health.degradedAnswers)
+}
+
+func (s *Server) rejectedQueries() uint64 {
+	var rejected uint64
+	for _, load := range s.UpstreamLoads() {
+		rejected += load.Rejected
+	}
+	return rejected
+}
+
+// UpstreamLoads returns the queries in flight, queued and rejected of each
+// upstream, if the concurrency to the upstreams is limited.
+func (s *Server) UpstreamLoads() []UpstreamLoad {
+	if s.resolver == nil {
+		return nil
+	}
+	return s.resolver.limits.loads()
+}
+
+// serveMetrics writes the counters in the Prometheus text format.
+func (s *Server) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	stats := s.Stats()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	for _, m := range []struct {
+		name  string
+		help  string
+		value uint64
+	}{
+		{"freedns_queries_total", "Requests answered.", stats.Queries},
+		{"freedns_cache_hits_total", "Requests answered by the cache.", stats.CacheHits},
+		{"freedns_cache_stale_hits_total", "Requests answered by expired cache entries.", stats.StaleHits},
+		{"freedns_failures_total", "Requests answered with an rcode other than NOERROR or NXDOMAIN.", stats.Failures},
+		{"freedns_degraded_answers_total", "Answers of the other upstream served while one is down.", stats.Degraded},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", m.name, m.help, m.name, m.name, m.value)
+	}
+
+	loads := s.UpstreamLoads()
+	if len(loads) == 0 {
+		return
+	}
+	for _, m := range []struct {
+		name  string
+		help  string
+		kind  string
+		value func(load UpstreamLoad) uint64
+	}{
+		{"freedns_upstream_in_flight", "Queries in flight to the upstream.", "gauge", func(load UpstreamLoad) uint64 { return uint64(load.InFlight) }},
+		{"freedns_upstream_queued", "Queries waiting for the concurrency limit of the upstream.", "gauge", func(load UpstreamLoad) uint64 { return uint64(load.Queued) }},
+		{"freedns_upstream_rejected_total", "Queries to the upstream rejected by the concurrency limit.", "counter", func(load UpstreamLoad) uint64 { return load.Rejected }},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind)
+		for _, load := range loads {
+			fmt.Fprintf(w, "%s{upstream=%q} %d\n", m.name, load.Upstream, m.value(load))
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package freedns
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeMetrics(t *testing.T) {
+	s := &Server{stats: &serverStats{queries: 10, cacheHits: 7, staleHits: 2, failures: 1}}
+
+	w := httptest.NewRecorder()
+	s.serveMetrics(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := w.Body.String()
+	for _, line := range []string{
+		"freedns_queries_total 10\n",
+		"freedns_cache_hits_total 7\n",
+		"freedns_cache_stale_hits_total 2\n",
+		"freedns_failures_total 1\n",
+		"# TYPE freedns_queries_total counter\n",
+	} {
+		if !strings.Contains(body, line) {
+			t.Errorf("metrics should contain %q, got:\n%s", line, body)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package freedns
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"net/netip"
+
+	"github.com/miekg/dns"
+)
+
+// The SVCB and HTTPS records of RFC 9460, which miekg/dns doesn't know and
+// unpacks as dns.RFC3597.
+const (
+	typeSVCB  = 64
+	typeHTTPS = 65
+
+	svcParamECH      = 5
+	svcParamIPv4Hint = 4
+	svcParamIPv6Hint = 6
+)
+
+// svcb is an SVCB or HTTPS record.
+type svcb struct {
+	priority uint16
+	target   string
+	params   []svcParam
+}
+
+type svcParam struct {
+	key   uint16
+	value []byte
+}
+
+// parseSVCB parses an SVCB or HTTPS record, or returns nil if `rr` isn't a
+// valid one.
+func parseSVCB(rr dns.RR) *svcb {
+	generic, ok := rr.(*dns.RFC3597)
+	if !ok || (rr.Header().Rrtype != typeSVCB && rr.Header().Rrtype != typeHTTPS) {
+		return nil
+	}
+	data, err := hex.DecodeString(generic.Rdata)
+	if err != nil || len(data) < 2 {
+		return nil
+	}
+	s := &svcb{priority: binary.BigEndian.Uint16(data)}
+	var off int
+	if s.target, off, err = dns.UnpackDomainName(data, 2); err != nil {
+		return nil
+	}
+	for off < len(data) {
+		if off+4 > len(data) {
+			return nil
+		}
+		key, l := binary.BigEndian.Uint16(data[off:]), int(binary.BigEndian.Uint16(data[off+2:]))
+		off += 4
+		if off+l > len(data) {
+			return nil
+		}
+		s.params = append(s.params, svcParam{key, data[off : off+l]})
+		off += l
+	}
+	return s
+}
+
+// update packs `s` into `rr`, which it is parsed from.
+func (s *svcb) update(rr dns.RR) {
+	data := make([]byte, 2+len(s.target)+1)
+	binary.BigEndian.PutUint16(data, s.priority)
+	off, err := dns.PackDomainName(s.target, data, 2, nil, false)
+	if err != nil {
+		return
+	}
+	data = data[:off]
+	for _, p := range s.params {
+		data = append(data, byte(p.key>>8), byte(p.key), byte(len(p.value)>>8), byte(len(p.value)))
+		data = append(data, p.value...)
+	}
+	rr.(*dns.RFC3597).Rdata = hex.EncodeToString(data)
+}
+
+// addrs returns the addresses of an ipv4hint or ipv6hint param.
+func (p svcParam) addrs() []netip.Addr {
+	size := 4
+	if p.key == svcParamIPv6Hint {
+		size = 16
+	}
+	var addrs []netip.Addr
+	for i := 0; i+size <= len(p.value); i += size {
+		addr, _ := netip.AddrFromSlice(p.value[i : i+size])
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+func (p svcParam) isHint() bool {
+	return p.key == svcParamIPv4Hint || p.key == svcParamIPv6Hint
+}
+
+// hints returns the addresses in the ipv4hint and ipv6hint params.
+func (s *svcb) hints() []netip.Addr {
+	var addrs []netip.Addr
+	for _, p := range s.params {
+		if p.isHint() {
+			addrs = append(addrs, p.addrs()...)
+		}
+	}
+	return addrs
+}
+
+// filterHints keeps the hint addresses `keep` returns true for, and drops
+// the hint params left empty.
+func (s *svcb) filterHints(keep func(addr netip.Addr) bool) {
+	params := s.params[:0]
+	for _, p := range s.params {
+		if p.isHint() {
+			var value []byte
+			for _, addr := range p.addrs() {
+				if keep(addr) {
+					value = append(value, addr.AsSlice()...)
+				}
+			}
+			if len(value) == 0 {
+				continue
+			}
+			p.value = value
+		}
+		params = append(params, p)
+	}
+	s.params = params
+}
+
+// sortHints moves the hint addresses `first` returns true for first,
+// keeping their order otherwise.
+func (s *svcb) sortHints(first func(addr netip.Addr) bool) {
+	for i, p := range s.params {
+		if !p.isHint() {
+			continue
+		}
+		var front, back []byte
+		for _, addr := range p.addrs() {
+			if first(addr) {
+				front = append(front, addr.AsSlice()...)
+			} else {
+				back = append(back, addr.AsSlice()...)
+			}
+		}
+		s.params[i].value = append(front, back...)
+	}
+}
+
+// removeParam removes the params of `key`.
+func (s *svcb) removeParam(key uint16) {
+	params := s.params[:0]
+	for _, p := range s.params {
+		if p.key != key {
+			params = append(params, p)
+		}
+	}
+	s.params = params
+}
+
+// svcbHints returns the hint addresses in the SVCB and HTTPS records of the
+// sections of `res`.
+func svcbHints(res *dns.Msg) []netip.Addr {
+	var addrs []netip.Addr
+	for _, rrs := range [][]dns.RR{res.Answer, res.Ns, res.Extra} {
+		for _, rr := range rrs {
+			if s := parseSVCB(rr); s != nil {
+				addrs = append(addrs, s.hints()...)
+			}
+		}
+	}
+	return addrs
+}
+
+// rewriteSVCB rewrites the SVCB and HTTPS records in the answer of `res`
+// with `f`.
+func rewriteSVCB(res *dns.Msg, f func(s *svcb)) {
+	for _, rr := range res.Answer {
+		if s := parseSVCB(rr); s != nil {
+			f(s)
+			s.update(rr)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package freedns
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tuna/freedns-go/chinaip"
+	"github.com/tuna/freedns-go/freedns/freednstest"
+)
+
+// newHTTPS returns an HTTPS record of `name` with the alpn h2, an ECH config,
+// and the hints `ips`.
+func newHTTPS(name string, ips ...string) dns.RR {
+	rr := &dns.RFC3597{Hdr: dns.RR_Header{Name: dns.Fqdn(name), Rrtype: typeHTTPS, Class: dns.ClassINET, Ttl: 300}}
+	s := &svcb{priority: 1, target: "."}
+	s.params = append(s.params, svcParam{1, []byte("\x02h2")})
+	var v4, v6 []byte
+	for _, ip := range ips {
+		addr := netip.MustParseAddr(ip)
+		if addr.Is4() {
+			v4 = append(v4, addr.AsSlice()...)
+		} else {
+			v6 = append(v6, addr.AsSlice()...)
+		}
+	}
+	s.params = append(s.params, svcParam{svcParamIPv4Hint, v4}, svcParam{svcParamECH, []byte("ech")}, svcParam{svcParamIPv6Hint, v6})
+	s.update(rr)
+	return rr
+}
+
+func paramKeys(s *svcb) []uint16 {
+	var keys []uint16
+	for _, p := range s.params {
+		keys = append(keys, p.key)
+	}
+	return keys
+}
+
+func TestSVCB(t *testing.T) {
+	rr := newHTTPS("example.com", "202.38.64.1", "31.13.64.1", "2001:db8::1")
+
+	// unpacked as a generic record
+	msg := &dns.Msg{Answer: []dns.RR{rr}}
+	data, err := msg.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msg.Unpack(data); err != nil {
+		t.Fatal(err)
+	}
+	s := parseSVCB(msg.Answer[0])
+	if s == nil || s.priority != 1 || s.target != "." {
+		t.Fatalf("got %+v", s)
+	}
+	if !reflect.DeepEqual(paramKeys(s), []uint16{1, svcParamIPv4Hint, svcParamECH, svcParamIPv6Hint}) {
+		t.Errorf("got params %v", paramKeys(s))
+	}
+	want := []netip.Addr{netip.MustParseAddr("202.38.64.1"), netip.MustParseAddr("31.13.64.1"), netip.MustParseAddr("2001:db8::1")}
+	if hints := svcbHints(msg); !reflect.DeepEqual(hints, want) {
+		t.Errorf("got hints %v", hints)
+	}
+
+	if !containsA(msg) {
+		t.Errorf("the ipv4hints should count as A records")
+	}
+	resolver := &spoofingProofResolver{localIPs: chinaip.ClassifierFunc(chinaip.Contains), localIPPolicy: LocalIPPolicyAll}
+	if resolver.isLocalAnswer(msg) {
+		t.Errorf("the foreign ipv4hint should fail the all policy")
+	}
+
+	resolver.mixedAnswers = MixedAnswersReorder
+	msg.Answer[0] = newHTTPS("example.com", "31.13.64.1", "202.38.64.1")
+	resolver.rewriteMixedAnswer(msg)
+	if hints := svcbHints(msg); hints[0] != netip.MustParseAddr("202.38.64.1") {
+		t.Errorf("got reordered hints %v", hints)
+	}
+	resolver.mixedAnswers = MixedAnswersStrip
+	msg.Answer[0] = newHTTPS("example.com", "31.13.64.1", "202.38.64.1", "2001:db8::1")
+	resolver.rewriteMixedAnswer(msg)
+	if hints := svcbHints(msg); !reflect.DeepEqual(hints, []netip.Addr{netip.MustParseAddr("202.38.64.1"), netip.MustParseAddr("2001:db8::1")}) {
+		t.Errorf("got stripped hints %v", hints)
+	}
+
+	if parseSVCB(&dns.RFC3597{Hdr: dns.RR_Header{Rrtype: typeHTTPS}, Rdata: "0001"}) != nil {
+		t.Errorf("a truncated record should be invalid")
+	}
+}
+
+func TestHTTPSRouting(t *testing.T) {
+	w := newTestWorld(t)
+	w.auth.Handle("ustc.edu.cn", typeHTTPS, freednstest.Answer{Answer: []dns.RR{newHTTPS("ustc.edu.cn", "202.38.64.246", "2001:da8:d800::1")}})
+
+	s, err := NewServer(Config{
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
+		FilterAAAA:    true,
+		NoECH:         true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, trace := s.Query(dns.Question{Name: "ustc.edu.cn.", Qtype: typeHTTPS, Qclass: dns.ClassINET}, "udp")
+	if trace.Upstream != w.fast.Addr || trace.Reason != "fast answer contains local IPs" {
+		t.Errorf("got %v: %v", trace.Upstream, trace.Reason)
+	}
+	if len(res.Answer) != 1 || parseSVCB(res.Answer[0]) == nil {
+		t.Fatalf("got %v", res)
+	}
+	// no ECH config and no ipv6hint
+	if keys := paramKeys(parseSVCB(res.Answer[0])); !reflect.DeepEqual(keys, []uint16{1, svcParamIPv4Hint}) {
+		t.Errorf("got params %v", keys)
+	}
+
+	res, trace = s.Query(dns.Question{Name: "ustc.edu.cn.", Qtype: dns.TypeAAAA, Qclass: dns.ClassINET}, "udp")
+	if res.Rcode != dns.RcodeSuccess || len(res.Answer) != 0 || trace.Upstream != "filter" {
+		t.Errorf("got %v from %v", res, trace.Upstream)
+	}
+}
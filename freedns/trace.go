@@ -0,0 +1,44 @@
+package freedns
+
+import (
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Trace records the decisions made to answer a query, for diagnostics.
+type Trace struct {
+	Question dns.Question
+
+	// Cache is "hit", "stale" (served and being refreshed) or "miss", or
+	// "policy" if a policy answers without the cache and the upstreams.
+	Cache string
+	// Classification is the cached classification of the domain before
+	// resolving: "china", "foreign" or "unknown", or the classification of
+	// the address of a PTR query.
+	Classification string
+	// LocalAnswers and ForeignAnswers count the answers observed for the
+	// classification, which is decided by the majority of the last ones.
+	LocalAnswers   int
+	ForeignAnswers int
+
+	Fast  UpstreamTrace
+	Clean UpstreamTrace
+
+	// Upstream is where the answer comes from, and Reason tells why.
+	Upstream string
+	Reason   string
+
+	// Done is called once the trace is complete if it is set, and then
+	// resolving doesn't wait for both upstreams, whose answers are traced
+	// in the background.
+	Done func()
+}
+
+// UpstreamTrace is the answer of an upstream.
+type UpstreamTrace struct {
+	Upstream string
+	Response *dns.Msg
+	Err      error
+	RTT      time.Duration
+}
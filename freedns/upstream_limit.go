@@ -0,0 +1,125 @@
+package freedns
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// errUpstreamBusy is the error of the queries rejected by upstreamLimiter.
+const errUpstreamBusy = Error("upstream busy")
+
+// upstreamLimiter limits the queries in flight to each upstream, so that a
+// burst of misses doesn't get us rate limited by the public resolvers. The
+// queries over the limit wait in a bounded queue, and are rejected if it is
+// full or they wait too long.
+type upstreamLimiter struct {
+	maxInFlight int
+	maxQueued   int
+	wait        time.Duration
+
+	mu        sync.Mutex
+	upstreams map[string]*upstreamSlots
+}
+
+type upstreamSlots struct {
+	slots    chan struct{}
+	queued   int
+	rejected uint64
+}
+
+// UpstreamLoad is a snapshot of the queries to an upstream.
+type UpstreamLoad struct {
+	Upstream string
+	InFlight int
+	Queued   int
+	Rejected uint64
+}
+
+func newUpstreamLimiter(maxInFlight int, maxQueued int) *upstreamLimiter {
+	return &upstreamLimiter{
+		maxInFlight: maxInFlight,
+		maxQueued:   maxQueued,
+		wait:        time.Second,
+		upstreams:   make(map[string]*upstreamSlots),
+	}
+}
+
+// acquire takes a slot of `upstream`, waiting in the queue if there is none
+// free, and returns the function releasing it.
+func (l *upstreamLimiter) acquire(upstream string) (func(), error) {
+	l.mu.Lock()
+	u, ok := l.upstreams[upstream]
+	if !ok {
+		u = &upstreamSlots{slots: make(chan struct{}, l.maxInFlight)}
+		l.upstreams[upstream] = u
+	}
+	select {
+	case u.slots <- struct{}{}:
+		l.mu.Unlock()
+		return func() { <-u.slots }, nil
+	default:
+	}
+	if u.queued >= l.maxQueued {
+		u.rejected++
+		l.mu.Unlock()
+		return nil, errUpstreamBusy
+	}
+	u.queued++
+	l.mu.Unlock()
+
+	timeout := time.NewTimer(l.wait)
+	defer timeout.Stop()
+	var err error
+	select {
+	case u.slots <- struct{}{}:
+	case <-timeout.C:
+		err = errUpstreamBusy
+	}
+	l.mu.Lock()
+	u.queued--
+	if err != nil {
+		u.rejected++
+	}
+	l.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return func() { <-u.slots }, nil
+}
+
+// wrap limits the queries of `exchange`.
+func (l *upstreamLimiter) wrap(exchange func(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error)) func(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error) {
+	return func(q dns.Question, recursion bool, net string, upstream string) (*dns.Msg, error) {
+		release, err := l.acquire(upstream)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return exchange(q, recursion, net, upstream)
+	}
+}
+
+// loads returns the loads of the upstreams queried, sorted by upstream.
+func (l *upstreamLimiter) loads() []UpstreamLoad {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var loads []UpstreamLoad
+	for upstream, u := range l.upstreams {
+		loads = append(loads, UpstreamLoad{
+			Upstream: upstream,
+			InFlight: len(u.slots),
+			Queued:   u.queued,
+			Rejected: u.rejected,
+		})
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		return loads[i].Upstream < loads[j].Upstream
+	})
+	return loads
+}
@@ -0,0 +1,98 @@
+package freedns
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+func TestUpstreamLimiter(t *testing.T) {
+	l := newUpstreamLimiter(1, 1)
+	l.wait = 100 * time.Millisecond
+
+	release, err := l.acquire("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the other upstreams have their own slots
+	releaseB, err := l.acquire("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseB()
+
+	queued := make(chan error)
+	go func() {
+		release, err := l.acquire("a")
+		if err == nil {
+			release()
+		}
+		queued <- err
+	}()
+	for len(l.loads()) == 0 || l.loads()[0].Queued == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := l.acquire("a"); err != errUpstreamBusy {
+		t.Errorf("got %v with the queue full", err)
+	}
+	release()
+	if err := <-queued; err != nil {
+		t.Errorf("got %v for the queued one", err)
+	}
+
+	// waiting too long
+	release, _ = l.acquire("a")
+	if _, err := l.acquire("a"); err != errUpstreamBusy {
+		t.Errorf("got %v after waiting", err)
+	}
+	release()
+
+	want := []UpstreamLoad{{Upstream: "a", Rejected: 2}, {Upstream: "b"}}
+	if loads := l.loads(); len(loads) != 2 || loads[0] != want[0] || loads[1] != want[1] {
+		t.Errorf("got %+v", loads)
+	}
+}
+
+func TestUpstreamLimits(t *testing.T) {
+	w := newTestWorld(t)
+	s, err := NewServer(Config{
+		FastUpstream:        w.fast.Addr,
+		CleanUpstream:       w.clean.Addr,
+		UpstreamMaxInFlight: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.resolver.limits.wait = 10 * time.Millisecond
+
+	// the slot of the fast upstream is taken
+	release, err := s.resolver.limits.acquire(w.fast.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	res, trace := s.Query(dns.Question{Name: "ustc.edu.cn.", Qtype: dns.TypeA, Qclass: dns.ClassINET}, "udp")
+	if res.Rcode != dns.RcodeSuccess || trace.Upstream != w.clean.Addr || trace.Fast.Err != errUpstreamBusy {
+		t.Errorf("got %v from %v: %v", dns.RcodeToString[res.Rcode], trace.Upstream, trace.Fast.Err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.serveMetrics(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE freedns_upstream_in_flight gauge\n",
+		"freedns_upstream_in_flight{upstream=\"" + w.fast.Addr + "\"} 1\n",
+		"freedns_upstream_rejected_total{upstream=\"" + w.fast.Addr + "\"} 1\n",
+		"freedns_upstream_queued{upstream=\"" + w.clean.Addr + "\"} 0\n",
+	} {
+		if !strings.Contains(body, line) {
+			t.Errorf("metrics should contain %q, got:\n%s", line, body)
+		}
+	}
+	if s.Stats().Rejected != 1 {
+		t.Errorf("got %d rejected", s.Stats().Rejected)
+	}
+}
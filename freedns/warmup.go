@@ -0,0 +1,106 @@
+package freedns
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// warmupQuestions returns the `top` most frequent questions of `entries`,
+// all of them if it is 0, from the most frequent.
+func warmupQuestions(entries []QueryLogEntry, top int) []dns.Question {
+	type counted struct {
+		q     dns.Question
+		count int
+	}
+	var list []*counted
+	seen := make(map[dns.Question]*counted)
+	for _, e := range entries {
+		qtype, ok := ParseQueryType(e.Type)
+		if !ok || e.Name == "" {
+			continue
+		}
+		q := dns.Question{Name: strings.ToLower(dns.Fqdn(e.Name)), Qtype: qtype, Qclass: dns.ClassINET}
+		if c, ok := seen[q]; ok {
+			c.count++
+			continue
+		}
+		c := &counted{q: q, count: 1}
+		seen[q] = c
+		list = append(list, c)
+	}
+	// the first seen first among the equally frequent
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].count > list[j].count
+	})
+	if top > 0 && len(list) > top {
+		list = list[:top]
+	}
+	questions := make([]dns.Question, len(list))
+	for i, c := range list {
+		questions[i] = c.q
+	}
+	return questions
+}
+
+// warmup resolves the most frequent questions of WarmupFile, a domain list
+// or the query log of the previous run, at WarmupRate per second, to fill
+// the cache and the classifications.
+func (s *Server) warmup() {
+	l := log.WithFields(logrus.Fields{
+		"op":   "warmup",
+		"file": s.config.WarmupFile,
+	})
+	f, err := os.Open(s.config.WarmupFile)
+	if err != nil {
+		l.Warn(err)
+		return
+	}
+	entries, err := ReadQueryLog(f)
+	f.Close()
+	if err != nil {
+		l.Warn(err)
+		return
+	}
+	questions := warmupQuestions(entries, s.config.WarmupTop)
+
+	rate := s.config.WarmupRate
+	if rate <= 0 {
+		rate = 20
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	defer ticker.Stop()
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i, q := range questions {
+		if i > 0 {
+			select {
+			case <-ticker.C:
+			case <-s.done:
+				return
+			}
+		}
+		req := &dns.Msg{
+			MsgHdr: dns.MsgHdr{
+				Id:               dns.Id(),
+				RecursionDesired: true,
+			},
+			Question: []dns.Question{q},
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.lookup(req, "udp", nil)
+		}()
+	}
+	wg.Wait()
+	l.WithFields(logrus.Fields{
+		"questions": len(questions),
+		"duration":  time.Since(start).String(),
+	}).Info("cache warmed up")
+}
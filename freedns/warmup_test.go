@@ -0,0 +1,70 @@
+package freedns
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestWarmupQuestions(t *testing.T) {
+	entries, err := ReadQueryLog(strings.NewReader(`example.org
+{"name":"Example.com.","type":"A"}
+example.com
+example.com AAAA
+example.com AAAA
+{"name":"example.com","type":"A"}
+bad.example TYPE99999
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := func(name string, qtype uint16) dns.Question {
+		return dns.Question{Name: name, Qtype: qtype, Qclass: dns.ClassINET}
+	}
+	want := []dns.Question{q("example.com.", dns.TypeA), q("example.com.", dns.TypeAAAA)}
+	if got := warmupQuestions(entries, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v", got)
+	}
+	if got := warmupQuestions(entries, 0); len(got) != 3 || got[2] != q("example.org.", dns.TypeA) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestWarmup(t *testing.T) {
+	w := newTestWorld(t)
+	file := filepath.Join(t.TempDir(), "domains.txt")
+	if err := os.WriteFile(file, []byte("ustc.edu.cn\ngoogle.com\nustc.edu.cn MX\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewServer(Config{
+		FastUpstream:  w.fast.Addr,
+		CleanUpstream: w.clean.Addr,
+		CacheCap:      1024,
+		WarmupFile:    file,
+		WarmupRate:    100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.warmup()
+
+	for _, q := range []dns.Question{
+		{Name: "ustc.edu.cn.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		{Name: "google.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET},
+		{Name: "ustc.edu.cn.", Qtype: dns.TypeMX, Qclass: dns.ClassINET},
+	} {
+		if res, _, _ := s.recordsCache.lookup(q, true, "udp"); res == nil {
+			t.Errorf("%v should be cached", q)
+		}
+	}
+	if class, ok, _ := s.resolver.classes.get("ustc.edu.cn."); !ok || !class.decided {
+		t.Errorf("ustc.edu.cn should be classified as china")
+	}
+	if class, ok, _ := s.resolver.classes.get("google.com."); !ok || class.decided {
+		t.Errorf("google.com should be classified as foreign")
+	}
+}
@@ -1,10 +1,18 @@
 module github.com/tuna/freedns-go
 
-go 1.13
+go 1.18
 
 require (
 	github.com/fsnotify/fsnotify v1.4.9
 	github.com/louchenyao/golang-cache v0.0.0-20190309153624-1d1c4bb01145
 	github.com/miekg/dns v1.1.27
+	github.com/oschwald/maxminddb-golang v1.10.0
 	github.com/sirupsen/logrus v1.4.2
+	golang.org/x/sys v0.0.0-20220804214406-8e32c043e418
+)
+
+require (
+	github.com/konsorten/go-windows-terminal-sequences v1.0.1 // indirect
+	golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550 // indirect
+	golang.org/x/net v0.0.0-20190923162816-aa69164e4478 // indirect
 )
@@ -4,6 +4,9 @@ import (
 	"flag"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	_ "net/http/pprof"
 
@@ -11,35 +14,33 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "chinaip":
+			chinaipMain(os.Args[2:])
+			return
+		case "query":
+			queryMain(os.Args[2:])
+			return
+		case "bench":
+			benchMain(os.Args[2:])
+			return
+		case "replay":
+			replayMain(os.Args[2:])
+			return
+		}
+	}
+
 	/*
 		go func() {
 			log.Println(http.ListenAndServe("localhost:6060", nil))
 		}()
 	*/
 
-	var (
-		fastUpstream  string
-		cleanUpstream string
-		listen        string
-		logLevel      string
-		// cache         bool
-	)
-
-	flag.StringVar(&fastUpstream, "f", "114.114.114.114:53", "The fast/local DNS upstream, ip:port or resolv.conf file")
-	flag.StringVar(&cleanUpstream, "c", "8.8.8.8:53", "The clean/remote DNS upstream., ip:port or resolv.conf file")
-	flag.StringVar(&listen, "l", "0.0.0.0:53", "Listening address.")
-	// flag.BoolVar(&cache, "cache", true, "Enable cache.")
-	flag.StringVar(&logLevel, "log-level", "", "Set log level: info/warn/error.")
-
+	config := configFlags(flag.CommandLine)
 	flag.Parse()
 
-	s, err := freedns.NewServer(freedns.Config{
-		FastUpstream:  fastUpstream,
-		CleanUpstream: cleanUpstream,
-		Listen:        listen,
-		CacheCap:      1024 * 10,
-		LogLevel:      logLevel,
-	})
+	s, err := freedns.NewServer(config())
 	if err != nil {
 		log.Fatalln(err)
 		os.Exit(-1)
@@ -48,3 +49,130 @@ func main() {
 	log.Fatalln(s.Run())
 	os.Exit(-1)
 }
+
+// configFlags defines the flags of the server configuration in `fs`,
+// and returns a function building the configuration after parsing.
+func configFlags(fs *flag.FlagSet) func() freedns.Config {
+	var (
+		cfg          freedns.Config
+		configFile   string
+		countryCodes string
+		includeCIDRs string
+		excludeCIDRs string
+		ipSets       string
+		ipSetDomains string
+		fastestPorts string
+		rebindAllow  string
+		allowClients string
+		// cache         bool
+	)
+
+	fs.StringVar(&configFile, "config", "", "JSON configuration file with the fields of freedns.Config, overridden by the flags given.")
+	fs.StringVar(&cfg.FastUpstream, "f", "114.114.114.114:53", "The fast/local DNS upstream, ip:port or resolv.conf file")
+	fs.StringVar(&cfg.CleanUpstream, "c", "8.8.8.8:53", "The clean/remote DNS upstream., ip:port or resolv.conf file")
+	fs.StringVar(&cfg.Listen, "l", "0.0.0.0:53", "Listening address.")
+	// fs.BoolVar(&cache, "cache", true, "Enable cache.")
+	fs.StringVar(&cfg.LogLevel, "log-level", "", "Set log level: info/warn/error.")
+	fs.StringVar(&cfg.CountryDB, "mmdb", "", "GeoIP2/GeoLite2 Country mmdb file to classify local IPs.")
+	fs.StringVar(&countryCodes, "mmdb-countries", "CN", "Comma separated ISO country codes considered local in the mmdb file.")
+	fs.BoolVar(&cfg.CountryDBOnly, "mmdb-only", false, "Use the mmdb file instead of the builtin China IP list.")
+	fs.StringVar(&cfg.IPListURL, "iplist-url", "", "Refresh the China IP list from this url.")
+	fs.DurationVar(&cfg.IPListInterval, "iplist-interval", 24*time.Hour, "Interval to refresh the China IP list.")
+	fs.StringVar(&cfg.IPListFile, "iplist-file", "", "File to persist the refreshed China IP list.")
+	fs.StringVar(&cfg.IPListFormat, "iplist-format", "cidr", "The format of the China IP list: cidr/apnic.")
+	fs.StringVar(&cfg.IPListCountry, "iplist-country", "CN", "The country code to extract from the apnic format list.")
+	fs.StringVar(&cfg.IPListChecksumURL, "iplist-sha256-url", "", "Url of the sha256sum of the China IP list.")
+	fs.StringVar(&cfg.IPListSignatureURL, "iplist-sig-url", "", "Url of the ed25519 signature of the China IP list.")
+	fs.StringVar(&cfg.IPListPublicKey, "iplist-pubkey", "", "Base64 ed25519 public key to verify the China IP list.")
+	fs.StringVar(&includeCIDRs, "include-cidr", "", "Comma separated CIDRs always considered local.")
+	fs.StringVar(&excludeCIDRs, "exclude-cidr", "", "Comma separated CIDRs never considered local.")
+	fs.StringVar(&cfg.IPOverlayFile, "ip-overlay", "", "File of include/exclude CIDR rules applied on the local IP classification.")
+	fs.StringVar(&cfg.HTTPListen, "http", "", "Listening address of the http server for /metrics and /readyz, disabled if empty.")
+	fs.StringVar(&cfg.QueryLog, "query-log", "", "Append the queries to this file as JSON lines.")
+	fs.BoolVar(&cfg.QueryLogAnswers, "query-log-answers", false, "Record the answers of both upstreams in the query log for replays.")
+	fs.StringVar(&ipSets, "ipset", "", "Comma separated sets to add the resolved IPs to: nft[6]:family/table/set, ipset[6]:name or file:path.")
+	fs.BoolVar(&cfg.IPSetCleanUpstream, "ipset-clean", true, "Add the IPs resolved by the clean upstream to the sets.")
+	fs.StringVar(&ipSetDomains, "ipset-domains", "", "Comma separated domains whose IPs are added to the sets.")
+
+	fs.BoolVar(&cfg.DetectHijack, "detect-hijack", false, "Probe the fast upstream for NXDOMAIN hijacking and treat the hijacked answers as NXDOMAIN.")
+	fs.DurationVar(&cfg.HijackProbeInterval, "hijack-interval", time.Hour, "Interval to probe the fast upstream for NXDOMAIN hijacking.")
+	fs.StringVar(&cfg.BlackholeProbe, "blackhole", "", "An ip:port abroad running no DNS server, to detect the poisoned domains by the forged replies.")
+	fs.IntVar(&cfg.BlackholeProbeRate, "blackhole-rate", 10, "The maximum blackhole probes per second.")
+	fs.BoolVar(&cfg.SelfTest, "self-test", false, "Check the upstreams at startup and periodically, and report the result at /readyz.")
+	fs.DurationVar(&cfg.SelfTestInterval, "self-test-interval", 10*time.Minute, "Interval to check the upstreams.")
+	fs.IntVar(&cfg.DegradeAfter, "degrade-after", 0, "Serve the answers of the other upstream after this many consecutive transport failures or timeouts of one, disabled if 0.")
+	fs.DurationVar(&cfg.ClassificationTTL, "classification-ttl", 24*time.Hour, "Time the classification of a domain is kept after the last answer.")
+	fs.DurationVar(&cfg.ClassificationVerifyInterval, "classification-verify", time.Hour, "Interval to verify the classification of a domain in use with both upstreams.")
+	fs.StringVar(&cfg.LocalIPPolicy, "local-policy", "any", "Which of the A records in a local answer are local: any, all or majority.")
+	fs.StringVar(&cfg.MixedAnswers, "mixed-answers", "keep", "Rewrite the local answers with foreign IPs: keep, strip the foreign IPs, or reorder the local IPs first.")
+	fs.StringVar(&cfg.FastestIP, "fastest-ip", "", "Probe the IPs in the answers and put the fastest first (first) or answer with it only (only), disabled if empty.")
+	fs.StringVar(&fastestPorts, "fastest-ip-ports", "80,443", "Comma separated tcp ports to probe the IPs on.")
+	fs.BoolVar(&cfg.FilterAAAA, "filter-aaaa", false, "Answer the AAAA queries with no records, and remove the ipv6hints from the HTTPS records.")
+	fs.BoolVar(&cfg.NoECH, "no-ech", false, "Remove the ECH configs from the HTTPS records.")
+	fs.StringVar(&cfg.RebindProtection, "rebind", "", "Strip (strip) the private and special-purpose addresses from the upstream answers, or refuse them (refuse), disabled if empty.")
+	fs.StringVar(&rebindAllow, "rebind-allow", "", "Comma separated domains allowed to resolve to private addresses.")
+	fs.StringVar(&allowClients, "allow-clients", "", "Comma separated CIDRs of the clients allowed to query, all if empty.")
+	fs.StringVar(&cfg.ExtendedErrorText, "ede-text", "", "Text appended to the Extended DNS Errors in the responses, e.g. a contact.")
+	fs.IntVar(&cfg.UpstreamMaxInFlight, "upstream-max-inflight", 0, "The maximum queries in flight to each upstream, unlimited if 0.")
+	fs.IntVar(&cfg.UpstreamMaxQueued, "upstream-max-queued", 256, "The maximum queries waiting for each upstream over -upstream-max-inflight, the others fail at once.")
+	fs.StringVar(&cfg.WarmupFile, "warmup", "", "Domain list or query log, like the -query-log of the previous run, whose most frequent names are resolved at startup.")
+	fs.IntVar(&cfg.WarmupTop, "warmup-top", 1000, "The most frequent names of -warmup to resolve, all if 0.")
+	fs.IntVar(&cfg.WarmupRate, "warmup-rate", 20, "The warm-up queries per second.")
+
+	lists := map[string]func(){
+		"mmdb-countries": func() { cfg.CountryCodes = splitList(countryCodes) },
+		"include-cidr":   func() { cfg.IncludeCIDRs = splitList(includeCIDRs) },
+		"exclude-cidr":   func() { cfg.ExcludeCIDRs = splitList(excludeCIDRs) },
+		"ipset":          func() { cfg.IPSets = splitList(ipSets) },
+		"ipset-domains":  func() { cfg.IPSetDomains = splitList(ipSetDomains) },
+		"rebind-allow":   func() { cfg.RebindAllowDomains = splitList(rebindAllow) },
+		"allow-clients":  func() { cfg.AllowClients = splitList(allowClients) },
+		"fastest-ip-ports": func() {
+			cfg.FastestIPPorts = nil
+			for _, port := range splitList(fastestPorts) {
+				p, err := strconv.Atoi(port)
+				if err != nil {
+					log.Fatalln("Invalid port: " + port)
+				}
+				cfg.FastestIPPorts = append(cfg.FastestIPPorts, p)
+			}
+		},
+	}
+
+	return func() freedns.Config {
+		cfg.CacheCap = 1024 * 10
+		for _, apply := range lists {
+			apply()
+		}
+		if configFile == "" {
+			return cfg
+		}
+
+		// the flags given override the file
+		given := make(map[string]string)
+		fs.Visit(func(f *flag.Flag) {
+			given[f.Name] = f.Value.String()
+		})
+		if err := freedns.LoadConfig(configFile, &cfg); err != nil {
+			log.Fatalln(err)
+		}
+		for name, value := range given {
+			fs.Set(name, value)
+			if apply, ok := lists[name]; ok {
+				apply()
+			}
+		}
+		return cfg
+	}
+}
+
+// splitList splits a comma separated flag value.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}